@@ -102,6 +102,16 @@ func (vc *Bucket) SetPower(id *crypto.PublicKey, power *big.Int) (*big.Int, erro
 	return absFlow, nil
 }
 
+// Copy returns an independent copy of the bucket that will accept or reject power changes exactly as this one would
+func (vc *Bucket) Copy() *Bucket {
+	return &Bucket{
+		Delta:    vc.Delta.Copy(),
+		Previous: vc.Previous.Copy(),
+		Next:     vc.Next.Copy(),
+		Flow:     vc.Flow.Copy(),
+	}
+}
+
 func (vc *Bucket) CurrentSet() *Set {
 	return vc.Previous
 }
@@ -65,6 +65,18 @@ func (vs *Set) ChangePower(id *crypto.PublicKey, power *big.Int) *big.Int {
 	return flow
 }
 
+// Copy returns an independent copy of the set
+func (vs *Set) Copy() *Set {
+	vsCopy := newSet()
+	vsCopy.trim = vs.trim
+	vsCopy.totalPower.Set(vs.totalPower)
+	for address, power := range vs.powers {
+		vsCopy.powers[address] = new(big.Int).Set(power)
+		vsCopy.publicKeys[address] = vs.publicKeys[address]
+	}
+	return vsCopy
+}
+
 func (vs *Set) TotalPower() *big.Int {
 	return new(big.Int).Set(vs.totalPower)
 }
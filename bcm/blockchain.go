@@ -257,6 +257,9 @@ func (bc *Blockchain) GetBlockHeader(height uint64) (*types.Header, error) {
 	blockMeta, err := bc.getBlockMeta(height)
 	if err != nil {
 		return nil, fmt.Errorf("%s could not get BlockMeta: %v", errHeader, err)
+	} else if blockMeta == nil {
+		// No block at height (yet)
+		return nil, nil
 	}
 	return &blockMeta.Header, nil
 }
@@ -67,6 +67,8 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 
 		proposalVote := cmd.BoolOpt("proposal-vote", false, "Vote for proposal, do NOT create new proposal")
 
+		proposalWithdraw := cmd.BoolOpt("proposal-withdraw", false, "Withdraw vote for proposal")
+
 		proposalCreate := cmd.BoolOpt("proposal-create", false, "Create new proposal")
 
 		timeoutSecondsOpt := cmd.IntOpt("t timeout", int(defaultChainTimeout/time.Second), "Timeout to talk to the chain in seconds")
@@ -80,7 +82,7 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 			"[--output=<output file>] [--wasm] [--set=<KEY=VALUE>]... [--bin-path=<path>] [--gas=<gas>] " +
 			"[--jobs=<concurrent playbooks>] [--address=<address>] [--fee=<fee>] [--amount=<amount>] [--local-abi] " +
 			"[--verbose] [--debug] [--timeout=<timeout>] " +
-			"[--list-proposals=<state> | --proposal-create| --proposal-verify | --proposal-vote] [FILE...]"
+			"[--list-proposals=<state> | --proposal-create| --proposal-verify | --proposal-vote | --proposal-withdraw] [FILE...]"
 
 		cmd.Action = func() {
 			args := new(def.DeployArgs)
@@ -89,6 +91,10 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 				output.Fatalf("Cannot combine --proposal-verify and --proposal-vote")
 			}
 
+			if *proposalVote && *proposalWithdraw {
+				output.Fatalf("Cannot combine --proposal-vote and --proposal-withdraw")
+			}
+
 			for _, e := range *defaultSetsOpt {
 				s := strings.Split(e, "=")
 				if len(s) != 2 || s[0] == "" {
@@ -115,6 +121,7 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 			args.Jobs = *jobsOpt
 			args.ProposeVerify = *proposalVerify
 			args.ProposeVote = *proposalVote
+			args.ProposeWithdraw = *proposalWithdraw
 			args.ProposeCreate = *proposalCreate
 			stdoutLogger, err := loggers.NewStreamLogger(os.Stdout, loggers.TerminalFormat)
 			if err != nil {
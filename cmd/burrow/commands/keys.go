@@ -10,13 +10,13 @@ import (
 	"os"
 	"time"
 
-	"github.com/hyperledger/burrow/encoding"
-
 	"github.com/howeyc/gopass"
 	"github.com/hyperledger/burrow/config"
 	"github.com/hyperledger/burrow/config/deployment"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/keys/hd"
+	"github.com/hyperledger/burrow/logging/logconfig"
 	cli "github.com/jawher/mow.cli"
 )
 
@@ -37,8 +37,38 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			EnvVar: "BURROW_KEYS_PORT",
 		})
 
+		keysToken := cmd.String(cli.StringOpt{
+			Name:   "token",
+			Desc:   "bearer token identifying this client to a keys server with a policy",
+			EnvVar: "BURROW_KEYS_TOKEN",
+		})
+
+		keysCA := cmd.String(cli.StringOpt{
+			Name:   "ca",
+			Desc:   "PEM CA certificates with which to verify a keys server served over TLS",
+			EnvVar: "BURROW_KEYS_CA",
+		})
+
+		keysClientCert := cmd.String(cli.StringOpt{
+			Name:   "client-cert",
+			Desc:   "PEM client certificate for a keys server requiring mutual TLS",
+			EnvVar: "BURROW_KEYS_CLIENT_CERT",
+		})
+
+		keysClientKey := cmd.String(cli.StringOpt{
+			Name:   "client-key",
+			Desc:   "PEM key of the client certificate",
+			EnvVar: "BURROW_KEYS_CLIENT_KEY",
+		})
+
 		grpcKeysClient := func(output Output) keys.KeysClient {
-			conn, err := encoding.GRPCDial(*keysHost + ":" + *keysPort)
+			auth := &keys.RemoteAuthConfig{
+				CAFile:      *keysCA,
+				TLSCertFile: *keysClientCert,
+				TLSKeyFile:  *keysClientKey,
+				Token:       *keysToken,
+			}
+			conn, err := auth.Dial(*keysHost + ":" + *keysPort)
 			if err != nil {
 				output.Fatalf("Failed to connect to grpc server: %v", err)
 			}
@@ -49,6 +79,12 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			keysDir := cmd.StringOpt("dir", "", "specify the location of the directory containing key files")
 			badPerm := cmd.BoolOpt("allow-bad-perm", false, "Allow unix key file permissions to be readable other than user")
 			configOpt := cmd.StringOpt("c config", "", "Use the specified burrow config file")
+			tlsCertOpt := cmd.StringOpt("tls-cert", "", "PEM server certificate with which to serve over TLS")
+			tlsKeyOpt := cmd.StringOpt("tls-key", "", "PEM key of the server certificate")
+			clientCAOpt := cmd.StringOpt("client-ca", "", "PEM CA certificates with which to verify client "+
+				"certificates, requires clients to authenticate with mutual TLS")
+			policyOpt := cmd.StringOpt("policy", "", "TOML policy file identifying clients by certificate or token "+
+				"and restricting which keys they may sign with and whether they may manage or export keys")
 
 			var conf *config.BurrowConfig
 
@@ -67,7 +103,36 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 					conf.Keys.KeysDirectory = *keysDir
 				}
 
-				server := keys.StandAloneServer(conf.Keys.KeysDirectory, conf.Keys.AllowBadFilePermissions)
+				auth := conf.Keys.ServerAuth
+				if auth == nil {
+					auth = new(keys.ServerAuthConfig)
+				}
+				if *tlsCertOpt != "" {
+					auth.TLSCertFile = *tlsCertOpt
+				}
+				if *tlsKeyOpt != "" {
+					auth.TLSKeyFile = *tlsKeyOpt
+				}
+				if *clientCAOpt != "" {
+					auth.ClientCAFile = *clientCAOpt
+				}
+				if *policyOpt != "" {
+					auth.PolicyFile = *policyOpt
+				}
+
+				logConf := conf.Logging
+				if logConf == nil {
+					logConf = logconfig.New()
+				}
+				logger, err := logConf.Logger()
+				if err != nil {
+					output.Fatalf("Could not create logger: %v", err)
+				}
+				keyStore := keys.NewFilesystemKeyStore(conf.Keys.KeysDirectory, conf.Keys.AllowBadFilePermissions)
+				server, err := keys.NewServer(keyStore, auth, logger)
+				if err != nil {
+					output.Fatalf("Could not create keys server: %v", err)
+				}
 				address := fmt.Sprintf("%s:%s", *keysHost, *keysPort)
 				listener, err := net.Listen("tcp", address)
 				if err != nil {
@@ -115,6 +180,116 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
+		cmd.Command("gen-pkcs11", "Generates a key on the PKCS#11 token configured in Keys.PKCS11", func(cmd *cli.Cmd) {
+			configOpt := cmd.StringOpt("c config", "", "Use the specified burrow config file")
+			keyType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to create. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint)")
+			keyName := cmd.StringOpt("name", "", "name of key to use")
+
+			cmd.Action = func() {
+				conf, err := obtainDefaultConfig(*configOpt, "")
+				if err != nil {
+					output.Fatalf("Could not obtain config: %v", err)
+				}
+				if conf.Keys.PKCS11 == nil {
+					output.Fatalf("Keys.PKCS11 must be set in config to generate a key on a PKCS#11 token")
+				}
+				ks, err := keys.NewPKCS11KeyStore(conf.Keys.PKCS11)
+				if err != nil {
+					output.Fatalf("Could not open PKCS#11 key store: %v", err)
+				}
+				defer ks.Close()
+				resp, err := ks.GenerateKey(context.Background(), &keys.GenRequest{CurveType: *keyType, KeyName: *keyName})
+				if err != nil {
+					output.Fatalf("failed to generate key: %v", err)
+				}
+
+				fmt.Printf("%v\n", resp.GetAddress())
+			}
+		})
+
+		cmd.Command("mnemonic", "Generates a BIP-39 mnemonic from which keys can be derived", func(cmd *cli.Cmd) {
+			bits := cmd.IntOpt("b bits", hd.DefaultEntropyBits, "bits of entropy, a multiple of 32 between 128 (12 words) and 256 (24 words)")
+
+			cmd.Action = func() {
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				resp, err := c.GenerateMnemonic(ctx, &keys.GenerateMnemonicRequest{EntropyBits: uint32(*bits)})
+				if err != nil {
+					output.Fatalf("failed to generate mnemonic: %v", err)
+				}
+
+				fmt.Printf("%s\n", resp.GetMnemonic())
+			}
+		})
+
+		cmd.Command("derive", "Derives keys from a BIP-39 mnemonic along a BIP-32 path", func(cmd *cli.Cmd) {
+			noPassword := cmd.BoolOpt("n no-password", false, "don't use a password for the derived keys")
+			keyType := cmd.StringOpt("t curvetype", "secp256k1", "specify the curve type of key to derive. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint)")
+			path := cmd.StringOpt("p path", "", "derivation path of the first key, defaults to m/44'/60'/0'/0/0 for secp256k1 (as MetaMask) and m/44'/60'/0'/0'/0' for ed25519")
+			count := cmd.IntOpt("c count", 1, "number of keys to derive by incrementing the last index of the path (at most 1000)")
+			keyName := cmd.StringOpt("name", "", "name of key, suffixed with -<index> when deriving more than one key")
+			seedPassword := cmd.BoolOpt("seed-password", false, "prompt for the optional BIP-39 password of the mnemonic")
+			mnemonic := cmd.StringArg("MNEMONIC", "", "mnemonic from which to derive keys, prompted for if not provided")
+
+			cmd.Spec = "[OPTIONS] [MNEMONIC]"
+
+			cmd.Action = func() {
+				if *count < 1 {
+					output.Fatalf("count must be at least 1")
+				}
+
+				if *mnemonic == "" {
+					fmt.Printf("Enter Mnemonic:")
+					words, err := gopass.GetPasswdMasked()
+					if err != nil {
+						os.Exit(1)
+					}
+					*mnemonic = string(words)
+				}
+
+				var seedPass string
+				if *seedPassword {
+					fmt.Printf("Enter Mnemonic Password:")
+					pwd, err := gopass.GetPasswdMasked()
+					if err != nil {
+						os.Exit(1)
+					}
+					seedPass = string(pwd)
+				}
+
+				var password string
+				if !*noPassword {
+					fmt.Printf("Enter Password:")
+					pwd, err := gopass.GetPasswdMasked()
+					if err != nil {
+						os.Exit(1)
+					}
+					password = string(pwd)
+				}
+
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				resp, err := c.ImportMnemonic(ctx, &keys.ImportMnemonicRequest{
+					Passphrase: password,
+					Mnemonic:   *mnemonic,
+					Password:   seedPass,
+					CurveType:  *keyType,
+					Path:       *path,
+					Count:      uint32(*count),
+					KeyName:    *keyName,
+				})
+				if err != nil {
+					output.Fatalf("failed to derive keys: %v", err)
+				}
+
+				for _, key := range resp.GetKeys() {
+					fmt.Printf("%s %s\n", key.GetAddress(), key.GetPath())
+				}
+			}
+		})
+
 		cmd.Command("hash", "hash <some data>", func(cmd *cli.Cmd) {
 			hashType := cmd.StringOpt("t type", keys.DefaultHashType, "specify the hash function to use")
 
@@ -151,16 +326,26 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			keyAddr := cmd.StringOpt("addr", "", "address of key to use")
 			passphrase := cmd.StringOpt("passphrase", "", "passphrase for encrypted key")
 			keyTemplate := cmd.StringOpt("t template", deployment.DefaultKeysExportFormat, "template for export key")
+			v3 := cmd.BoolOpt("v3", false, "export a secp256k1 key as an Ethereum V3 keystore encrypted with the passphrase")
 
 			cmd.Action = func() {
 				c := grpcKeysClient(output)
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
-				resp, err := c.Export(ctx, &keys.ExportRequest{Passphrase: *passphrase, Name: *keyName, Address: *keyAddr})
+				request := &keys.ExportRequest{Passphrase: *passphrase, Name: *keyName, Address: *keyAddr}
+				if *v3 {
+					request.Format = keys.ExportFormatV3
+				}
+				resp, err := c.Export(ctx, request)
 				if err != nil {
 					output.Fatalf("failed to export key: %v", err)
 				}
 
+				if *v3 {
+					fmt.Printf("%s\n", resp.GetJSON())
+					return
+				}
+
 				addr, err := crypto.AddressFromBytes(resp.GetAddress())
 				if err != nil {
 					output.Fatalf("failed to convert address: %v", err)
@@ -183,9 +368,10 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
-		cmd.Command("import", "import <priv key> | /path/to/keyfile | <key json>", func(cmd *cli.Cmd) {
+		cmd.Command("import", "import <priv key> | /path/to/keyfile | <key json> | <ethereum V3 keystore>", func(cmd *cli.Cmd) {
 			curveType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to create. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint)")
 			noPassword := cmd.BoolOpt("n no-password", false, "don't use a password for this key")
+			keyName := cmd.StringOpt("name", "", "name of key")
 			key := cmd.StringArg("KEY", "", "private key, filename, or raw json")
 
 			cmd.Action = func() {
@@ -210,7 +396,8 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 				defer cancel()
 
 				if (*key)[:1] == "{" {
-					resp, err := c.ImportJSON(ctx, &keys.ImportJSONRequest{JSON: *key})
+					// The password also decrypts V3 keystores
+					resp, err := c.ImportJSON(ctx, &keys.ImportJSONRequest{Passphrase: password, JSON: *key, Name: *keyName})
 					if err != nil {
 						output.Fatalf("failed to import json key: %v", err)
 					}
@@ -221,7 +408,8 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 					if err != nil {
 						output.Fatalf("failed to hex decode key: %s", *key)
 					}
-					resp, err := c.Import(ctx, &keys.ImportRequest{Passphrase: password, KeyBytes: privKeyBytes, CurveType: *curveType})
+					resp, err := c.Import(ctx, &keys.ImportRequest{Passphrase: password, KeyBytes: privKeyBytes, CurveType: *curveType,
+						Name: *keyName})
 					if err != nil {
 						output.Fatalf("failed to import json key: %v", err)
 					}
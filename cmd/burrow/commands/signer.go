@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/keys"
+	cli "github.com/jawher/mow.cli"
+)
+
+// Signer signs consensus messages for a remote validator node
+func Signer(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		nodeAddressOpt := cmd.StringOpt("n node", "", "Address on which the node listens for its signer "+
+			"(its Tendermint.PrivValidatorListenAddress) as tcp://host:port or unix://path")
+		chainIDOpt := cmd.StringOpt("c chain-id", "", "Chain ID of the chain for which to sign")
+		validatorOpt := cmd.StringOpt("v validator-address", "", "Address of the validator key with which to sign")
+		keysDirOpt := cmd.StringOpt("keys-dir", keys.DefaultKeysDir, "Directory of key files to sign from")
+		keysAddressOpt := cmd.StringOpt("keys-address", "", "Sign using a keys server at this address "+
+			"rather than from the keys directory")
+		keysTokenOpt := cmd.String(cli.StringOpt{
+			Name:   "keys-token",
+			Desc:   "Bearer token identifying this signer to a keys server with a policy",
+			EnvVar: "BURROW_KEYS_TOKEN",
+		})
+		keysCAOpt := cmd.String(cli.StringOpt{
+			Name:   "keys-ca",
+			Desc:   "PEM CA certificates with which to verify a keys server served over TLS",
+			EnvVar: "BURROW_KEYS_CA",
+		})
+		keysClientCertOpt := cmd.String(cli.StringOpt{
+			Name:   "keys-client-cert",
+			Desc:   "PEM client certificate for a keys server requiring mutual TLS",
+			EnvVar: "BURROW_KEYS_CLIENT_CERT",
+		})
+		keysClientKeyOpt := cmd.String(cli.StringOpt{
+			Name:   "keys-client-key",
+			Desc:   "PEM key of the client certificate",
+			EnvVar: "BURROW_KEYS_CLIENT_KEY",
+		})
+		logLevelOpt := cmd.StringOpt("log-level", string(LogLevelInfo), "Logging level (none, info, trace)")
+		stateOpt := cmd.StringOpt("s state", "priv_validator_state.json", "File in which to record the last "+
+			"message signed to protect against double signing")
+
+		cmd.Spec = "--node=<tcp://host:port or unix://path> --chain-id=<chain ID> " +
+			"--validator-address=<address> [--keys-dir=<directory>] [--keys-address=<host:port>] " +
+			"[--keys-token] [--keys-ca] [--keys-client-cert] [--keys-client-key] [--log-level] [--state=<file>]"
+
+		cmd.Action = func() {
+			logger, err := logConfig(LogLevel(*logLevelOpt)).Logger()
+			if err != nil {
+				output.Fatalf("failed to load logger: %v", err)
+			}
+			logger = logger.With("service", "signer")
+			validator, err := crypto.AddressFromHexString(*validatorOpt)
+			if err != nil {
+				output.Fatalf("could not parse validator address: %v", err)
+			}
+			var keyClient keys.KeyClient
+			if *keysAddressOpt != "" {
+				keyClient, err = keys.NewRemoteKeyClientWithAuth(*keysAddressOpt, &keys.RemoteAuthConfig{
+					CAFile:      *keysCAOpt,
+					TLSCertFile: *keysClientCertOpt,
+					TLSKeyFile:  *keysClientKeyOpt,
+					Token:       *keysTokenOpt,
+				}, logger)
+				if err != nil {
+					output.Fatalf("could not connect to keys server: %v", err)
+				}
+			} else {
+				keyClient = keys.NewLocalKeyClient(keys.NewFilesystemKeyStore(*keysDirOpt, false), logger)
+			}
+			signer, err := keys.AddressableSigner(keyClient, validator)
+			if err != nil {
+				output.Fatalf("could not get validator key: %v", err)
+			}
+			privVal, err := tendermint.NewPrivValidatorPersisted(signer, signer, *stateOpt)
+			if err != nil {
+				output.Fatalf("could not load signer state: %v", err)
+			}
+			server, err := tendermint.NewSignerServer(*nodeAddressOpt, *chainIDOpt, privVal, logger)
+			if err != nil {
+				output.Fatalf("could not create signer: %v", err)
+			}
+			err = server.Start()
+			if err != nil {
+				output.Fatalf("could not start signer: %v", err)
+			}
+			output.Logf("Signing for validator %v on chain %s via %s", validator, *chainIDOpt, *nodeAddressOpt)
+
+			ch := make(chan os.Signal, 1)
+			signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
+			<-ch
+			err = server.Stop()
+			if err != nil {
+				output.Fatalf("could not stop signer: %v", err)
+			}
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/genesis/spec"
 	cli "github.com/jawher/mow.cli"
 )
@@ -26,9 +27,10 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 		participantsOpt := cmd.IntOpt("p participant-accounts", 0, "Number of preset Participant type accounts")
 		chainNameOpt := cmd.StringOpt("n chain-name", "", "Default chain name")
 		proposalThresholdOpt := cmd.IntOpt("param-proposalthreshold", 3, "Number of votes required for a proposal to pass")
+		gasScheduleOpt := cmd.StringOpt("param-gasschedule", "", "Gas schedule by which to price execution (flat or ethereum)")
 
 		cmd.Spec = "[--name-prefix=<prefix for account names>][--full-accounts] [--validator-accounts] [--root-accounts] " +
-			"[--developer-accounts] [--participant-accounts] [--chain-name] [--toml] [BASE...]"
+			"[--developer-accounts] [--participant-accounts] [--chain-name] [--param-gasschedule] [--toml] [BASE...]"
 
 		cmd.Action = func() {
 			specs := make([]spec.GenesisSpec, 0, *participantsOpt+*fullOpt)
@@ -60,6 +62,12 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 				genesisSpec.ChainName = *chainNameOpt
 			}
 			genesisSpec.Params.ProposalThreshold = uint64(*proposalThresholdOpt)
+			if *gasScheduleOpt != "" {
+				if _, err := engine.GasScheduleByName(*gasScheduleOpt); err != nil {
+					output.Fatalf("could not set gas schedule: %v", err)
+				}
+				genesisSpec.Params.GasSchedule = *gasScheduleOpt
+			}
 			if *tomlOpt {
 				output.Printf(source.TOMLString(genesisSpec))
 			} else {
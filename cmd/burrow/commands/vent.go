@@ -63,12 +63,20 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					"The minimum duration to wait before asking for new blocks - increases exponentially when errors occur. Values like 200ms, 1s, 2m")
 				batchSizeOpt := cmd.IntOpt("batch-size", int(cfg.BlockConsumerConfig.MaxBlockBatchSize),
 					"The maximum number of blocks from which to request events in a single call - will reduce logarithmically to 1 when errors occur.")
+				confirmationDepthOpt := cmd.IntOpt("confirmation-depth", int(cfg.BlockConsumerConfig.ConfirmationDepth),
+					"The number of blocks to trail the head of an Ethereum chain by, so that we only consume blocks with that many confirmations")
+				maxReorgDepthOpt := cmd.IntOpt("max-reorg-depth", int(cfg.BlockConsumerConfig.MaxReorgDepth),
+					"The maximum number of consumed blocks to search back through for a common ancestor when an Ethereum chain reorganises")
 				abiFileOpt := cmd.StringsOpt("abi", cfg.AbiFileOrDirs, "EVM Contract ABI file or folder")
 				specFileOrDirOpt := cmd.StringsOpt("spec", cfg.SpecFileOrDirs, "SQLSol specification file or folder")
 				dbBlockOpt := cmd.BoolOpt("blocks", false, "Create block tables and persist related data")
 				dbTxOpt := cmd.BoolOpt("txs", false, "Create tx tables and persist related data")
 
 				announceEveryOpt := cmd.StringOpt("announce-every", "5s", "Announce vent status every period as a Go duration, e.g. 1ms, 3s, 1h")
+				jsonlFileOpt := cmd.StringOpt("jsonl", "", "Also append the rows projected from each block to this JSONL file")
+				webhookURLOpt := cmd.StringOpt("webhook", "", "Also POST the rows projected from each block to this URL")
+				webhookCheckpointOpt := cmd.StringOpt("webhook-checkpoint", cfg.WebhookCheckpointFile,
+					"File in which to record the last block POSTed to the webhook")
 
 				cmd.Before = func() {
 					var err error
@@ -90,6 +98,8 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 						output.Fatalf("could not parse backoff duration: %w", err)
 					}
 					cfg.BlockConsumerConfig.MaxBlockBatchSize = uint64(*batchSizeOpt)
+					cfg.BlockConsumerConfig.ConfirmationDepth = uint64(*confirmationDepthOpt)
+					cfg.BlockConsumerConfig.MaxReorgDepth = uint64(*maxReorgDepthOpt)
 					for i, wa := range *watchAddressesOpt {
 						cfg.WatchAddresses[i], err = crypto.AddressFromHexString(wa)
 						if err != nil {
@@ -109,14 +119,18 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					if err != nil {
 						output.Fatalf("could not parse announce-every duration %s: %v", *announceEveryOpt, err)
 					}
+					cfg.JSONLFile = *jsonlFileOpt
+					cfg.WebhookURL = *webhookURLOpt
+					cfg.WebhookCheckpointFile = *webhookCheckpointOpt
 				}
 
 				cmd.Spec = "--spec=<spec file or dir>... [--abi=<abi file or dir>...] " +
 					"[--watch=<contract address>...] [--minimum-height=<lowest height from which to read>] " +
 					"[--max-retries=<max block request retries>] [--backoff=<minimum backoff duration>] " +
 					"[--max-request-rate=<requests / time base>] [--batch-size=<minimum block batch size>] " +
+					"[--confirmation-depth=<blocks>] [--max-reorg-depth=<blocks>] " +
 					"[--db-adapter] [--db-url] [--db-schema] [--blocks] [--txs] [--chain-addr] [--http-addr] " +
-					"[--log-level] [--announce-every=<duration>]"
+					"[--log-level] [--announce-every=<duration>] [--jsonl=<file>] [--webhook=<url>] [--webhook-checkpoint=<file>]"
 
 				cmd.Action = func() {
 					logger, err := logConfig(LogLevel(*logLevelOpt)).Logger()
@@ -129,13 +143,13 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					if err != nil {
 						output.Fatalf("Could not create Vent Consumer: %v", err)
 					}
-					server := service.NewServer(cfg, logger, consumer)
-
 					projection, err := sqlsol.SpecLoader(cfg.SpecFileOrDirs, cfg.SpecOpt)
 					if err != nil {
 						output.Fatalf("Spec loader error: %v", err)
 					}
 
+					server := service.NewServer(cfg, logger, consumer, projection)
+
 					var wg sync.WaitGroup
 
 					// setup channel for termination signals
@@ -57,6 +57,9 @@ func burrow(output commands.Output) *cli.Cli {
 	app.Command("keys", "A tool for doing a bunch of cool stuff with keys",
 		commands.Keys(output))
 
+	app.Command("signer", "Sign votes and proposals for a validator node listening for a remote signer",
+		commands.Signer(output))
+
 	app.Command("explore", "Dump objects from an offline Burrow .burrow directory",
 		commands.Explore(output))
 
@@ -44,6 +44,9 @@ type BurrowTendermintConfig struct {
 	// "", "never" (to never create unnecessary blocks)
 	// "always" (to create empty blocks each consensus round)
 	CreateEmptyBlocks string
+	// If set (to tcp://host:port or unix://path) we listen here for a remote signer (such as 'burrow signer') to sign
+	// votes and proposals with the validator key rather than signing with a key available to this node
+	PrivValidatorListenAddress string
 }
 
 func DefaultBurrowTendermintConfig() *BurrowTendermintConfig {
@@ -25,6 +25,21 @@ func NewPrivValidatorMemory(addressable crypto.Addressable, signer crypto.Signer
 	}
 }
 
+// Create a PrivValidator like NewPrivValidatorMemory whose last signed state is persisted to (and restored from) the
+// file at lastSignedInfoPath, which must not have been written for a different validator
+func NewPrivValidatorPersisted(addressable crypto.Addressable, signer crypto.Signer,
+	lastSignedInfoPath string) (*privValidatorMemory, error) {
+	lastSignedInfo, err := LoadOrNewLastSignedInfo(lastSignedInfoPath, addressable.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	return &privValidatorMemory{
+		Addressable:    addressable,
+		signer:         asTendermintSigner(signer),
+		lastSignedInfo: lastSignedInfo,
+	}, nil
+}
+
 func asTendermintSigner(signer crypto.Signer) func(msg []byte) []byte {
 	return func(msg []byte) []byte {
 		sig, err := signer.Sign(msg)
@@ -43,7 +58,6 @@ func (pvm *privValidatorMemory) GetPubKey() (tmCrypto.PubKey, error) {
 	return pvm.GetPublicKey().TendermintPubKey(), nil
 }
 
-// TODO: consider persistence to disk/database to avoid double signing after a crash
 func (pvm *privValidatorMemory) SignVote(chainID string, vote *tmproto.Vote) error {
 	return pvm.lastSignedInfo.SignVote(pvm.signer, chainID, vote)
 }
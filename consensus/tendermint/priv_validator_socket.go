@@ -0,0 +1,63 @@
+package tendermint
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmnet "github.com/tendermint/tendermint/libs/net"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// How long to keep retrying a remote signer that is temporarily unavailable
+	signerClientRetries = 50
+	signerClientTimeout = 100 * time.Millisecond
+	// How often a signer retries a node that is not (yet) listening
+	signerDialInterval = time.Second
+)
+
+// NewPrivValidatorSocketClient listens on listenAddress (tcp://host:port or unix://path) for a remote signer, such as
+// that run by 'burrow signer', to connect and returns a PrivValidator that forwards signing requests to it. It blocks
+// until the signer has connected and provided its public key. Double-sign protection is the signer's responsibility.
+func NewPrivValidatorSocketClient(listenAddress, chainID string, logger *logging.Logger) (*privval.RetrySignerClient,
+	error) {
+	endpoint, err := privval.NewSignerListener(listenAddress, NewLogger(logger))
+	if err != nil {
+		return nil, fmt.Errorf("could not listen for remote signer on %s: %w", listenAddress, err)
+	}
+	client, err := privval.NewSignerClient(endpoint, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not start remote signer client: %w", err)
+	}
+	_, err = client.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("could not get public key from remote signer: %w", err)
+	}
+	return privval.NewRetrySignerClient(client, signerClientRetries, signerClientTimeout), nil
+}
+
+// NewSignerServer returns a SignerServer that dials the node listening on address (tcp://host:port or unix://path)
+// and answers its signing requests for chainID with privValidator. Once started it keeps trying to connect until
+// it is stopped.
+func NewSignerServer(address, chainID string, privValidator types.PrivValidator,
+	logger *logging.Logger) (*privval.SignerServer, error) {
+	var dialer privval.SocketDialer
+	protocol, addr := tmnet.ProtocolAndAddress(address)
+	switch protocol {
+	case "tcp":
+		// The node does not authenticate us (nor we it) but the connection is encrypted
+		dialer = privval.DialTCPFn(addr, signerDialInterval*3, ed25519.GenPrivKey())
+	case "unix":
+		dialer = privval.DialUnixFn(addr)
+	default:
+		return nil, fmt.Errorf("remote signer address %s should have either tcp:// or unix:// protocol", address)
+	}
+	endpoint := privval.NewSignerDialerEndpoint(NewLogger(logger), dialer,
+		privval.SignerDialerEndpointConnRetries(math.MaxInt32),
+		privval.SignerDialerEndpointRetryWaitInterval(signerDialInterval))
+	return privval.NewSignerServer(endpoint, chainID, privValidator), nil
+}
@@ -2,14 +2,19 @@ package tendermint
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/tendermint/tendermint/libs/protoio"
+	"github.com/tendermint/tendermint/libs/tempfile"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -39,11 +44,15 @@ func voteToStep(vote *tmproto.Vote) int8 {
 // data signed by a validator to help prevent double signing.
 type LastSignedInfo struct {
 	sync.Mutex
+	// The validator that signed, absent from files written before it was recorded
+	Address   *crypto.Address `json:"address,omitempty"`
 	Height    int64           `json:"height"`
 	Round     int32           `json:"round"`
 	Step      int8            `json:"step"`
 	Signature []byte          `json:"signature,omitempty"` // so we don't lose signatures
 	SignBytes binary.HexBytes `json:"signbytes,omitempty"` // so we don't lose signatures
+	// File to which we persist, if any
+	path string
 }
 
 func NewLastSignedInfo() *LastSignedInfo {
@@ -52,6 +61,35 @@ func NewLastSignedInfo() *LastSignedInfo {
 	}
 }
 
+// LoadOrNewLastSignedInfo reads LastSignedInfo for the validator with address from the file at path, or starts afresh
+// if there is no such file. Every subsequent signature is recorded in the file (and synced to disk) before it is
+// released so that a validator cannot be made to sign conflicting data after a restart. A file recorded for a different
+// validator is rejected rather than reset since it may be the only record of what that validator has signed.
+func LoadOrNewLastSignedInfo(path string, address crypto.Address) (*LastSignedInfo, error) {
+	lsi := NewLastSignedInfo()
+	lsi.path = path
+	bs, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		lsi.Address = &address
+		return lsi, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read LastSignedInfo from %s: %w", path, err)
+	}
+	err = json.Unmarshal(bs, lsi)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode LastSignedInfo from %s: %w", path, err)
+	}
+	if lsi.SignBytes != nil && lsi.Signature == nil {
+		return nil, fmt.Errorf("LastSignedInfo from %s has SignBytes but no Signature", path)
+	}
+	if lsi.Address != nil && *lsi.Address != address {
+		return nil, fmt.Errorf("LastSignedInfo from %s was recorded for validator %v not %v, move it aside to sign "+
+			"as a different validator", path, *lsi.Address, address)
+	}
+	lsi.Address = &address
+	return lsi, nil
+}
+
 type tmCryptoSigner func(msg []byte) []byte
 
 // SignVote signs a canonical representation of the vote, along with the
@@ -135,7 +173,10 @@ func (lsi *LastSignedInfo) signVote(sign tmCryptoSigner, chainID string, vote *t
 
 	// It passed the checks. Sign the vote
 	sig := sign(signBytes)
-	lsi.saveSigned(height, round, step, signBytes, sig)
+	err = lsi.saveSigned(height, round, step, signBytes, sig)
+	if err != nil {
+		return err
+	}
 	vote.Signature = sig
 	return nil
 }
@@ -172,20 +213,46 @@ func (lsi *LastSignedInfo) signProposal(sign tmCryptoSigner, chainID string, pro
 
 	// It passed the checks. Sign the proposal
 	sig := sign(signBytes)
-	lsi.saveSigned(height, round, step, signBytes, sig)
+	err = lsi.saveSigned(height, round, step, signBytes, sig)
+	if err != nil {
+		return err
+	}
 	proposal.Signature = sig
 	return nil
 }
 
-// Persist height/round/step and signature
+// Persist height/round/step and signature. If we have a file the in-memory state is only updated once it is written
+// so on error the signature must not be released.
 func (lsi *LastSignedInfo) saveSigned(height int64, round int32, step int8,
-	signBytes []byte, sig []byte) {
+	signBytes []byte, sig []byte) error {
 
+	if sig == nil {
+		return errors.New("signer did not produce a signature")
+	}
+	if lsi.path != "" {
+		bs, err := json.Marshal(&LastSignedInfo{
+			Address:   lsi.Address,
+			Height:    height,
+			Round:     round,
+			Step:      step,
+			Signature: sig,
+			SignBytes: signBytes,
+		})
+		if err != nil {
+			return err
+		}
+		// Writes to a temporary file that is synced before being moved over the old one
+		err = tempfile.WriteFileAtomic(lsi.path, bs, 0600)
+		if err != nil {
+			return fmt.Errorf("could not persist LastSignedInfo to %s: %w", lsi.path, err)
+		}
+	}
 	lsi.Height = height
 	lsi.Round = round
 	lsi.Step = step
 	lsi.Signature = sig
 	lsi.SignBytes = signBytes
+	return nil
 }
 
 // String returns a string representation of the LastSignedInfo.
@@ -0,0 +1,146 @@
+package tendermint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const (
+	testChainID   = "SignInfoChain"
+	testStateFile = "priv_validator_state.json"
+)
+
+func TestPrivValidatorPersisted_CrashRecovery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sign-info-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, testStateFile)
+	val := acm.GeneratePrivateAccountFromSecret("validator")
+
+	pv, err := NewPrivValidatorPersisted(val, val, path)
+	require.NoError(t, err)
+	vote := newTestVote(2, 1, tmproto.PrevoteType, "block A")
+	require.NoError(t, pv.SignVote(testChainID, vote))
+	require.NotEmpty(t, vote.Signature)
+	proposal := newTestProposal(3, 0, "block A")
+	require.NoError(t, pv.SignProposal(testChainID, proposal))
+
+	// Simulate a crash by dropping the validator and loading another from the same file
+	pv, err = NewPrivValidatorPersisted(val, val, path)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), pv.lastSignedInfo.Height)
+	assert.Equal(t, stepPropose, pv.lastSignedInfo.Step)
+
+	// Re-signing what we signed before the crash gives the same signature
+	again := newTestProposal(3, 0, "block A")
+	require.NoError(t, pv.SignProposal(testChainID, again))
+	assert.Equal(t, proposal.Signature, again.Signature)
+
+	// But we refuse to sign anything conflicting
+	conflicting := newTestProposal(3, 0, "block B")
+	require.Error(t, pv.SignProposal(testChainID, conflicting))
+	assert.Empty(t, conflicting.Signature)
+	regressing := newTestVote(2, 1, tmproto.PrevoteType, "block B")
+	require.Error(t, pv.SignVote(testChainID, regressing))
+	assert.Empty(t, regressing.Signature)
+
+	// A validator with only memory would have equivocated
+	memory := NewPrivValidatorMemory(val, val)
+	require.NoError(t, memory.SignProposal(testChainID, conflicting))
+
+	// And we can carry on
+	next := newTestVote(3, 0, tmproto.PrevoteType, "block A")
+	require.NoError(t, pv.SignVote(testChainID, next))
+	require.NotEmpty(t, next.Signature)
+}
+
+func TestPrivValidatorPersisted_WriteFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sign-info-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	val := acm.GeneratePrivateAccountFromSecret("validator")
+
+	pv, err := NewPrivValidatorPersisted(val, val, filepath.Join(dir, "missing", testStateFile))
+	require.NoError(t, err)
+	vote := newTestVote(1, 0, tmproto.PrecommitType, "block A")
+	require.Error(t, pv.SignVote(testChainID, vote))
+	// Nothing is released unless it has been recorded
+	assert.Empty(t, vote.Signature)
+	assert.Equal(t, int64(0), pv.lastSignedInfo.Height)
+}
+
+func TestLoadOrNewLastSignedInfo_Corrupt(t *testing.T) {
+	file, err := ioutil.TempFile("", "sign-info-")
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString("{not json")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	_, err = LoadOrNewLastSignedInfo(file.Name(), acm.GeneratePrivateAccountFromSecret("validator").GetAddress())
+	require.Error(t, err)
+}
+
+func TestPrivValidatorPersisted_DifferentValidator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sign-info-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, testStateFile)
+	val := acm.GeneratePrivateAccountFromSecret("validator")
+	other := acm.GeneratePrivateAccountFromSecret("other validator")
+
+	pv, err := NewPrivValidatorPersisted(val, val, path)
+	require.NoError(t, err)
+	require.NoError(t, pv.SignVote(testChainID, newTestVote(5, 0, tmproto.PrevoteType, "block A")))
+
+	// Another key must not inherit (or overwrite) what this one has signed
+	_, err = NewPrivValidatorPersisted(other, other, path)
+	require.Error(t, err)
+
+	// Files written before the address was recorded are adopted by whichever validator loads them
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"height":5,"round":0,"step":2}`), 0600))
+	pv, err = NewPrivValidatorPersisted(other, other, path)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), pv.lastSignedInfo.Height)
+	require.NoError(t, pv.SignVote(testChainID, newTestVote(6, 0, tmproto.PrevoteType, "block A")))
+	_, err = NewPrivValidatorPersisted(val, val, path)
+	require.Error(t, err)
+}
+
+func newTestVote(height int64, round int32, voteType tmproto.SignedMsgType, block string) *tmproto.Vote {
+	return &tmproto.Vote{
+		Type:      voteType,
+		Height:    height,
+		Round:     round,
+		BlockID:   testBlockID(block),
+		Timestamp: time.Unix(1600000000, 0).UTC(),
+	}
+}
+
+func newTestProposal(height int64, round int32, block string) *tmproto.Proposal {
+	return &tmproto.Proposal{
+		Type:      tmproto.ProposalType,
+		Height:    height,
+		Round:     round,
+		PolRound:  -1,
+		BlockID:   testBlockID(block),
+		Timestamp: time.Unix(1600000000, 0).UTC(),
+	}
+}
+
+func testBlockID(block string) tmproto.BlockID {
+	hash := make([]byte, 32)
+	copy(hash, block)
+	return tmproto.BlockID{
+		Hash:          hash,
+		PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: hash},
+	}
+}
@@ -21,8 +21,17 @@ import (
 // LoadKeysFromConfig sets the keyClient & keyStore based on the given config
 func (kern *Kernel) LoadKeysFromConfig(conf *keys.KeysConfig) (err error) {
 	kern.keyStore = keys.NewFilesystemKeyStore(conf.KeysDirectory, conf.AllowBadFilePermissions)
-	if conf.RemoteAddress != "" {
-		kern.keyClient, err = keys.NewRemoteKeyClient(conf.RemoteAddress, kern.Logger)
+	if conf.PKCS11 != nil {
+		if conf.RemoteAddress != "" {
+			return fmt.Errorf("only one of Keys.RemoteAddress and Keys.PKCS11 may be set")
+		}
+		ks, err := keys.NewPKCS11KeyStore(conf.PKCS11)
+		if err != nil {
+			return err
+		}
+		kern.keyClient = keys.NewLocalKeyClient(ks, kern.Logger)
+	} else if conf.RemoteAddress != "" {
+		kern.keyClient, err = keys.NewRemoteKeyClientWithAuth(conf.RemoteAddress, conf.RemoteAuth, kern.Logger)
 		if err != nil {
 			return err
 		}
@@ -125,9 +134,17 @@ func LoadKernelFromConfig(conf *config.BurrowConfig) (*Kernel, error) {
 		return nil, fmt.Errorf("Address must be set")
 	}
 
-	privVal, err := kern.PrivValidator(*conf.ValidatorAddress)
-	if err != nil {
-		return nil, fmt.Errorf("could not form PrivValidator from Address: %v", err)
+	var privVal tmTypes.PrivValidator
+	if conf.Tendermint != nil && conf.Tendermint.PrivValidatorListenAddress != "" {
+		privVal, err = kern.RemotePrivValidator(*conf.ValidatorAddress, conf.Tendermint.PrivValidatorListenAddress)
+		if err != nil {
+			return nil, fmt.Errorf("could not connect to remote signer: %v", err)
+		}
+	} else {
+		privVal, err = kern.PrivValidator(*conf.ValidatorAddress)
+		if err != nil {
+			return nil, fmt.Errorf("could not form PrivValidator from Address: %v", err)
+		}
 	}
 
 	err = kern.LoadTendermintFromConfig(conf, privVal)
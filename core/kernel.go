@@ -11,6 +11,7 @@ import (
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sync"
 	"syscall"
 	"time"
@@ -26,6 +27,7 @@ import (
 	_ "github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/keys"
@@ -46,6 +48,8 @@ const (
 	LoggingCallerDepth     = 5
 	AccountsRingMutexCount = 100
 	BurrowDBName           = "burrow_state"
+	// Where the validator records the last thing it signed
+	PrivValidatorStateFile = "priv_validator_state.json"
 )
 
 // Kernel is the root structure of Burrow
@@ -62,8 +66,10 @@ type Kernel struct {
 	RunID          simpleuuid.UUID // Time-based UUID randomly generated each time Burrow is started
 	Logger         *logging.Logger
 	database       dbm.DB
+	dbDir          string
 	txCodec        txs.Codec
 	exeOptions     []execution.Option
+	vmOptions      engine.Options
 	checker        execution.BatchExecutor
 	committer      execution.BatchCommitter
 	keyClient      keys.KeyClient
@@ -98,6 +104,7 @@ func NewKernel(dbDir string) (*Kernel, error) {
 		shutdownNotify: make(chan struct{}),
 		txCodec:        txs.NewProtobufCodec(),
 		database:       db,
+		dbDir:          dbDir,
 	}, err
 }
 
@@ -152,6 +159,10 @@ func (kern *Kernel) LoadState(genesisDoc *genesis.GenesisDoc) (err error) {
 	kern.Logger.InfoMsg("State loading successful")
 
 	params := execution.ParamsFromGenesis(genesisDoc)
+	kern.vmOptions, err = params.VMOptions(kern.exeOptions...)
+	if err != nil {
+		return err
+	}
 	kern.checker, err = execution.NewBatchChecker(kern.State, params, kern.Blockchain, kern.Logger)
 	if err != nil {
 		return fmt.Errorf("could not create BatchChecker: %w", err)
@@ -241,7 +252,8 @@ func (kern *Kernel) SetKeyStore(store *keys.FilesystemKeyStore) {
 	kern.keyStore = store
 }
 
-// Generates an in-memory Tendermint PrivValidator (suitable for passing to LoadTendermintFromConfig)
+// Generates a Tendermint PrivValidator (suitable for passing to LoadTendermintFromConfig) that records what it has
+// signed in the kernel's directory to avoid double signing after a restart
 func (kern *Kernel) PrivValidator(validator crypto.Address) (tmTypes.PrivValidator, error) {
 	val, err := keys.AddressableSigner(kern.keyClient, validator)
 	if err != nil {
@@ -251,7 +263,26 @@ func (kern *Kernel) PrivValidator(validator crypto.Address) (tmTypes.PrivValidat
 	if err != nil {
 		return nil, err
 	}
-	return tendermint.NewPrivValidatorMemory(val, signer), nil
+	return tendermint.NewPrivValidatorPersisted(val, signer, filepath.Join(kern.dbDir, PrivValidatorStateFile))
+}
+
+// Listens for a remote signer for validator, returning a Tendermint PrivValidator (suitable for passing to
+// LoadTendermintFromConfig) once it has connected
+func (kern *Kernel) RemotePrivValidator(validator crypto.Address, listenAddress string) (tmTypes.PrivValidator,
+	error) {
+	privVal, err := tendermint.NewPrivValidatorSocketClient(listenAddress, kern.Blockchain.ChainID(), kern.Logger)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := privVal.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(pubKey.Address(), validator.Bytes()) {
+		return nil, fmt.Errorf("remote signer has validator address %X but expected %v", pubKey.Address(),
+			validator)
+	}
+	return privVal, nil
 }
 
 // Boot the kernel starting Tendermint and RPC layers
@@ -145,7 +145,7 @@ func TendermintLauncher(kern *Kernel) process.Launcher {
 			nodeRegState := kern.State
 			validatorState := kern.State
 			kern.Service = rpc.NewService(accountState, nameRegState, nodeRegState, kern.Blockchain, validatorState, nodeView, kern.Logger)
-			kern.EthService = web3.NewEthService(accountState, eventsState, kern.Blockchain, validatorState, nodeView, kern.Transactor, kern.keyStore, kern.Logger)
+			kern.EthService = web3.NewEthService(accountState, eventsState, kern.State, kern.Blockchain, validatorState, nodeView, kern.Transactor, kern.keyStore, kern.vmOptions, kern.Logger)
 
 			if err := kern.Node.Start(); err != nil {
 				return nil, fmt.Errorf("%s error starting Tendermint node: %v", errHeader, err)
@@ -252,7 +252,7 @@ func Web3Launcher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
 				return nil, err
 			}
 
-			srv, err := server.StartHTTPServer(listener, web3.NewServer(kern.EthService), kern.Logger)
+			srv, err := server.StartHTTPServer(listener, web3.NewEthServer(kern.EthService), kern.Logger)
 			if err != nil {
 				return nil, err
 			}
@@ -312,6 +312,10 @@ func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConf
 			grpcServer.GetServiceInfo()
 
 			if keyConfig.GRPCServiceEnabled {
+				if keyConfig.ServerAuth != nil {
+					return nil, fmt.Errorf("Keys.ServerAuth only applies to the standalone keys server, " +
+						"disable Keys.GRPCServiceEnabled to avoid serving keys without authentication")
+				}
 				if kern.keyStore == nil {
 					ks = keys.NewFilesystemKeyStore(keyConfig.KeysDirectory, keyConfig.AllowBadFilePermissions)
 				}
@@ -324,7 +328,7 @@ func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConf
 			rpctransact.RegisterTransactServer(grpcServer,
 				rpctransact.NewTransactServer(func() (acmstate.Reader, error) {
 					return kern.State.AtLatestVersion()
-				}, kern.Blockchain, kern.Transactor, txCodec, kern.Logger))
+				}, kern.Blockchain, kern.Transactor, kern.vmOptions, txCodec, kern.Logger))
 
 			rpcevents.RegisterExecutionEventsServer(grpcServer, rpcevents.NewExecutionEventsServer(kern.State,
 				kern.Emitter, kern.Blockchain, kern.Logger))
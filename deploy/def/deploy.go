@@ -8,27 +8,28 @@ import (
 const DefaultOutputFile = "deploy.output.json"
 
 type DeployArgs struct {
-	Chain         string   `mapstructure:"," json:"," yaml:"," toml:","`
-	KeysService   string   `mapstructure:"," json:"," yaml:"," toml:","`
-	MempoolSign   bool     `mapstructure:"," json:"," yaml:"," toml:","`
-	LocalABI      bool     `mapstructure:"," json:"," yaml:"," toml:","`
-	Wasm          bool     `mapstructure:"," json:"," yaml:"," toml:","`
-	Timeout       int      `mapstructure:"," json:"," yaml:"," toml:","`
-	Address       string   `mapstructure:"," json:"," yaml:"," toml:","`
-	BinPath       string   `mapstructure:"," json:"," yaml:"," toml:","`
-	CurrentOutput string   `mapstructure:"," json:"," yaml:"," toml:","`
-	Debug         bool     `mapstructure:"," json:"," yaml:"," toml:","`
-	DefaultAmount string   `mapstructure:"," json:"," yaml:"," toml:","`
-	DefaultFee    string   `mapstructure:"," json:"," yaml:"," toml:","`
-	DefaultGas    string   `mapstructure:"," json:"," yaml:"," toml:","`
-	DefaultOutput string   `mapstructure:"," json:"," yaml:"," toml:","`
-	DefaultSets   []string `mapstructure:"," json:"," yaml:"," toml:","`
-	Path          string   `mapstructure:"," json:"," yaml:"," toml:","`
-	Verbose       bool     `mapstructure:"," json:"," yaml:"," toml:","`
-	Jobs          int      `mapstructure:"," json:"," yaml:"," toml:","`
-	ProposeVerify bool     `mapstructure:"," json:"," yaml:"," toml:","`
-	ProposeVote   bool     `mapstructure:"," json:"," yaml:"," toml:","`
-	ProposeCreate bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	Chain           string   `mapstructure:"," json:"," yaml:"," toml:","`
+	KeysService     string   `mapstructure:"," json:"," yaml:"," toml:","`
+	MempoolSign     bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	LocalABI        bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	Wasm            bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	Timeout         int      `mapstructure:"," json:"," yaml:"," toml:","`
+	Address         string   `mapstructure:"," json:"," yaml:"," toml:","`
+	BinPath         string   `mapstructure:"," json:"," yaml:"," toml:","`
+	CurrentOutput   string   `mapstructure:"," json:"," yaml:"," toml:","`
+	Debug           bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	DefaultAmount   string   `mapstructure:"," json:"," yaml:"," toml:","`
+	DefaultFee      string   `mapstructure:"," json:"," yaml:"," toml:","`
+	DefaultGas      string   `mapstructure:"," json:"," yaml:"," toml:","`
+	DefaultOutput   string   `mapstructure:"," json:"," yaml:"," toml:","`
+	DefaultSets     []string `mapstructure:"," json:"," yaml:"," toml:","`
+	Path            string   `mapstructure:"," json:"," yaml:"," toml:","`
+	Verbose         bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	Jobs            int      `mapstructure:"," json:"," yaml:"," toml:","`
+	ProposeVerify   bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	ProposeVote     bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	ProposeWithdraw bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	ProposeCreate   bool     `mapstructure:"," json:"," yaml:"," toml:","`
 }
 
 func (args *DeployArgs) Validate() error {
@@ -30,6 +30,8 @@ type Proposal struct {
 	Name string `mapstructure:"name" json:"name" yaml:"name" toml:"name"`
 	// (Required) the description of the proposal
 	Description string `mapstructure:"description" json:"description" yaml:"description" toml:"description"`
+	// (Optional) the block height at which the proposal expires if it has not been executed
+	ExpiryHeight string `mapstructure:"expiryheight" json:"expiryheight" yaml:"expiryheight" toml:"expiryheight"`
 	// (Required) the file path of the sub yaml to run
 	Jobs []*Job `mapstructure:"jobs" json:"jobs" yaml:"jobs" toml:"jobs"`
 }
@@ -40,6 +42,7 @@ func (job *Proposal) Validate() error {
 		validation.Field(&job.VotingPower, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Name, validation.Required),
 		validation.Field(&job.Description, validation.Required),
+		validation.Field(&job.ExpiryHeight, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Jobs, validation.Required),
 	)
 }
@@ -174,7 +174,13 @@ func ProposalJob(prop *def.Proposal, do *def.DeployArgs, parentScript *def.Playb
 		return "", err
 	}
 
-	proposal := payload.Proposal{Name: prop.Name, Description: prop.Description, BatchTx: &proposeBatch}
+	expiryHeight, err := client.ParseUint64(prop.ExpiryHeight)
+	if err != nil {
+		return "", fmt.Errorf("could not parse proposal expiry height: %w", err)
+	}
+
+	proposal := payload.Proposal{Name: prop.Name, Description: prop.Description, BatchTx: &proposeBatch,
+		ExpiryHeight: expiryHeight}
 
 	proposalInput, err := client.TxInput(prop.ProposalAddress, "", prop.ProposalSequence, false, logger)
 	if err != nil {
@@ -191,7 +197,7 @@ func ProposalJob(prop *def.Proposal, do *def.DeployArgs, parentScript *def.Playb
 			return "", err
 		}
 
-		err = proposals.ProposalExpired(ballot.Proposal, client, logger)
+		err = proposals.BallotClosed(ballot, client, logger)
 		if err != nil {
 			logger.InfoMsg("Proposal verify FAILED", "error", err)
 			return "", err
@@ -211,7 +217,7 @@ func ProposalJob(prop *def.Proposal, do *def.DeployArgs, parentScript *def.Playb
 			return "", err
 		}
 
-		err = proposals.ProposalExpired(ballot.Proposal, client, logger)
+		err = proposals.BallotClosed(ballot, client, logger)
 		if err != nil {
 			logger.InfoMsg("Proposal error", "error", err)
 			return "", err
@@ -227,6 +233,28 @@ func ProposalJob(prop *def.Proposal, do *def.DeployArgs, parentScript *def.Playb
 
 		h := binary.HexBytes(proposalHash)
 		proposalTx = &payload.ProposalTx{ProposalHash: &h, VotingWeight: 1, Input: input}
+	} else if do.ProposeWithdraw {
+		ballot, err := client.GetProposal(proposalHash, logger)
+		if err != nil {
+			logger.InfoMsg("Proposal could not be found", "error", err)
+			return "", err
+		}
+
+		err = proposals.BallotClosed(ballot, client, logger)
+		if err != nil {
+			logger.InfoMsg("Proposal error", "error", err)
+			return "", err
+		}
+
+		input, err := client.TxInput(parentScript.Account, "", prop.Sequence, true, logger)
+		if err != nil {
+			return "", err
+		}
+
+		logger.InfoMsg("Withdrawing vote for proposal", "hash", fmt.Sprintf("%X", proposalHash))
+
+		h := binary.HexBytes(proposalHash)
+		proposalTx = &payload.ProposalTx{ProposalHash: &h, Input: input, Withdraw: true}
 	} else if do.ProposeCreate {
 		input, err := client.TxInput(FirstOf(prop.Source, parentScript.Account), "", prop.Sequence, true, logger)
 		if err != nil {
@@ -238,7 +266,7 @@ func ProposalJob(prop *def.Proposal, do *def.DeployArgs, parentScript *def.Playb
 		logger.TraceMsg("Proposal json", "json", string(bs))
 		proposalTx = &payload.ProposalTx{VotingWeight: 1, Input: input, Proposal: &proposal}
 	} else {
-		logger.InfoMsg("please specify one of --proposal-create, --proposal-vote, --proposal-verify")
+		logger.InfoMsg("please specify one of --proposal-create, --proposal-vote, --proposal-withdraw, --proposal-verify")
 		return "", nil
 	}
 
@@ -73,6 +73,8 @@ func ListProposals(args *def.DeployArgs, reqState ProposalState, logger *logging
 			state = "FAILED"
 		case payload.Ballot_EXECUTED:
 			state = "EXECUTED"
+		case payload.Ballot_EXPIRED:
+			state = "EXPIRED"
 		case payload.Ballot_PROPOSED:
 			if ProposalExpired(prop.Ballot.Proposal, client, logger) != nil {
 				state = "EXPIRED"
@@ -90,12 +92,24 @@ func ListProposals(args *def.DeployArgs, reqState ProposalState, logger *logging
 			"Name", prop.Ballot.Proposal.Name,
 			"Description", prop.Ballot.Proposal.Description,
 			"State", state,
+			"ExpiryHeight", prop.Ballot.Proposal.ExpiryHeight,
 			"Votes", len(prop.Ballot.GetVotes()))
 	}
 
 	return nil
 }
 
+// Returns an error if the ballot can no longer be voted on
+func BallotClosed(ballot *payload.Ballot, client *def.Client, logger *logging.Logger) error {
+	switch ballot.ProposalState {
+	case payload.Ballot_EXECUTED, payload.Ballot_FAILED:
+		return fmt.Errorf("Proposal has already been executed")
+	case payload.Ballot_EXPIRED:
+		return fmt.Errorf("Proposal has expired at height %d", ballot.Proposal.ExpiryHeight)
+	}
+	return ProposalExpired(ballot.Proposal, client, logger)
+}
+
 func ProposalExpired(proposal *payload.Proposal, client *def.Client, logger *logging.Logger) error {
 	for _, input := range proposal.BatchTx.Inputs {
 		acc, err := client.GetAccount(input.Address)
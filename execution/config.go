@@ -6,6 +6,7 @@ import (
 	"github.com/hyperledger/burrow/execution/engine"
 
 	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/wasm"
 )
 
 type VMOption string
@@ -25,6 +26,26 @@ type ExecutionConfig struct {
 	DataStackInitialCapacity uint64
 	DataStackMaxDepth        uint64
 	VMOptions                []VMOption `json:",omitempty" toml:",omitempty"`
+	// The name of the gas schedule by which execution is priced (flat or ethereum). It must match across the chain
+	// so may be better set in genesis where it takes precedence.
+	GasSchedule string `json:",omitempty" toml:",omitempty"`
+	// Limits for WASM contracts
+	WASM *WASMConfig `json:",omitempty" toml:",omitempty"`
+}
+
+// Limits for WASM contracts. Like GasSchedule these decide whether a tx succeeds so they must match across the chain,
+// a validator with different limits will fork.
+type WASMConfig struct {
+	// The most 64KiB pages of memory a contract may declare or grow to. It must match across the chain.
+	MaxMemoryPages uint64
+	// The pages of memory given to a contract that imports its memory
+	DefaultMemoryPages uint64
+	// The largest module in bytes that may be deployed. It must match across the chain.
+	MaxModuleSize uint64
+	// The deepest the stack of function calls within a contract may grow
+	StackDepth uint64
+	// The deepest the stack of calls between contracts may grow from a WASM contract, 0 defers to CallStackMaxDepth
+	CallStackMaxDepth uint64
 }
 
 func DefaultExecutionConfig() *ExecutionConfig {
@@ -33,6 +54,17 @@ func DefaultExecutionConfig() *ExecutionConfig {
 		DataStackInitialCapacity: evm.DataStackInitialCapacity,
 		DataStackMaxDepth:        0, // Unlimited by default
 		TimeoutFactor:            0.33,
+		WASM:                     DefaultWASMConfig(),
+	}
+}
+
+func DefaultWASMConfig() *WASMConfig {
+	return &WASMConfig{
+		MaxMemoryPages:     uint64(wasm.DefaultVMConfig.MaxMemoryPages),
+		DefaultMemoryPages: uint64(wasm.DefaultVMConfig.DefaultMemoryPages),
+		MaxModuleSize:      0, // Unlimited by default
+		StackDepth:         wasm.MaxStackDepth,
+		CallStackMaxDepth:  0, // Same as the EVM by default
 	}
 }
 
@@ -52,6 +84,26 @@ func (ec *ExecutionConfig) ExecutionOptions() ([]Option, error) {
 		DataStackInitialCapacity: ec.DataStackInitialCapacity,
 		DataStackMaxDepth:        ec.DataStackMaxDepth,
 	}
+	if ec.GasSchedule != "" {
+		gasSchedule, err := engine.GasScheduleByName(ec.GasSchedule)
+		if err != nil {
+			return nil, err
+		}
+		vmOptions.GasSchedule = gasSchedule
+	}
+	if ec.WASM != nil {
+		if ec.WASM.MaxMemoryPages > 0 && ec.WASM.DefaultMemoryPages > ec.WASM.MaxMemoryPages {
+			return nil, fmt.Errorf("WASM DefaultMemoryPages (%d) cannot exceed MaxMemoryPages (%d)",
+				ec.WASM.DefaultMemoryPages, ec.WASM.MaxMemoryPages)
+		}
+		vmOptions.WASM = engine.WASMOptions{
+			MaxMemoryPages:     ec.WASM.MaxMemoryPages,
+			DefaultMemoryPages: ec.WASM.DefaultMemoryPages,
+			MaxModuleSize:      ec.WASM.MaxModuleSize,
+			StackDepth:         ec.WASM.StackDepth,
+			CallStackMaxDepth:  ec.WASM.CallStackMaxDepth,
+		}
+	}
 	for _, option := range ec.VMOptions {
 		switch option {
 		case DebugOpcodes:
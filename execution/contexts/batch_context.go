@@ -0,0 +1,121 @@
+package contexts
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Batch holds contexts that write to their own caches so that the txs they execute can be synced all together or
+// discarded all together
+type Batch struct {
+	// The accounts as seen by the txs in the batch
+	State    acmstate.ReaderWriter
+	Contexts map[payload.Type]Context
+	// Writes everything the batch has executed through to the underlying state
+	Sync func() error
+}
+
+type BatchContext struct {
+	ChainID  string
+	State    acmstate.ReaderWriter
+	NewBatch func() *Batch
+	Logger   *logging.Logger
+	tx       *payload.BatchTx
+}
+
+func (ctx *BatchContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
+	var ok bool
+	ctx.tx, ok = p.(*payload.BatchTx)
+	if !ok {
+		return fmt.Errorf("payload must be BatchTx, but is: %v", txe.Envelope.Tx.Payload)
+	}
+	if len(ctx.tx.Txs) == 0 {
+		return errors.Errorf(errors.Codes.InvalidBatch, "batch contains no txs")
+	}
+
+	// The inputs have signed the batch so only they may be inputs to the txs within it
+	signed := make(map[crypto.Address]bool)
+	for _, input := range ctx.tx.Inputs {
+		acc, err := ctx.State.GetAccount(input.Address)
+		if err != nil {
+			return err
+		}
+		if acc == nil {
+			return errors.Errorf(errors.Codes.InvalidAddress, "cannot find input account: %v", input)
+		}
+		if !hasBatchPermission(ctx.State, acc, ctx.Logger) {
+			return fmt.Errorf("account %s does not have batch permission", input.Address)
+		}
+		signed[input.Address] = true
+	}
+
+	batch := ctx.NewBatch()
+	txe.TxExecutions = make([]*exec.TxExecution, 0, len(ctx.tx.Txs))
+
+	for i, step := range ctx.tx.Txs {
+		if step == nil {
+			return errors.Errorf(errors.Codes.InvalidBatch, "step %d of batch has no tx", i+1)
+		}
+		txEnv := txs.EnvelopeFromAny(ctx.ChainID, step)
+		if txEnv == nil {
+			return errors.Errorf(errors.Codes.InvalidBatch, "step %d of batch has no tx", i+1)
+		}
+		txExecutor, ok := batch.Contexts[txEnv.Tx.Type()]
+		if !ok {
+			return errors.Errorf(errors.Codes.InvalidBatch, "%v cannot be executed in a batch", txEnv.Tx.Type())
+		}
+
+		for _, input := range txEnv.Tx.GetInputs() {
+			if !signed[input.Address] {
+				return errors.Errorf(errors.Codes.InvalidBatch, "input %v to step %d has not signed the batch",
+					input.Address, i+1)
+			}
+			acc, err := batch.State.GetAccount(input.Address)
+			if err != nil {
+				return err
+			}
+
+			acc.Sequence++
+
+			if acc.Sequence != input.Sequence {
+				return errors.Errorf(errors.Codes.InvalidSequence,
+					"sequence number %d for account %s wrong at step %d of batch, expected %d", input.Sequence,
+					input.Address, i+1, acc.Sequence)
+			}
+
+			err = batch.State.UpdateAccount(acc)
+			if err != nil {
+				return err
+			}
+		}
+
+		containedTxe := exec.NewTxExecution(txEnv)
+		containedTxe.Height = txe.Height
+		err := txExecutor.Execute(containedTxe, txEnv.Tx.Payload)
+		if err != nil {
+			ctx.Logger.InfoMsg("Batch transaction execution failed", structure.ErrorKey, err)
+			return errors.Wrapf(err, "step %d of batch failed: %v", i+1, err)
+		}
+
+		txe.TxExecutions = append(txe.TxExecutions, containedTxe)
+
+		if containedTxe.Exception != nil {
+			// The batch has executed but none of it takes effect
+			ctx.Logger.InfoMsg("Batch transaction failed so discarding batch",
+				structure.ErrorKey, containedTxe.Exception)
+			txe.PushError(errors.Wrapf(containedTxe.Exception, "step %d of batch failed: %v", i+1,
+				containedTxe.Exception))
+			return nil
+		}
+	}
+
+	return batch.Sync()
+}
@@ -196,8 +196,17 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 	}
 
 	if len(wcode) != 0 {
-		// TODO: accept options
-		ret, err = ctx.VMS.WVM.Execute(txCache, ctx.Blockchain, ctx.txe, params, wcode)
+		if createContract {
+			// Reject constructors that could never run before running them
+			err = ctx.VMS.WVM.ValidateCode(wcode)
+		}
+		if err == nil {
+			ret, err = ctx.VMS.WVM.Execute(txCache, ctx.Blockchain, ctx.txe, params, wcode)
+		}
+		if err == nil && createContract {
+			// As well as the code they deploy
+			err = ctx.VMS.WVM.ValidateCode(ret)
+		}
 		if err != nil {
 			// Failure. Charge the gas fee. The 'value' was otherwise not transferred.
 			ctx.Logger.InfoMsg("Error on WASM execution",
@@ -11,6 +11,7 @@ import (
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/proposal"
@@ -23,6 +24,7 @@ import (
 type ProposalContext struct {
 	ChainID           string
 	ProposalThreshold uint64
+	Blockchain        engine.Blockchain
 	State             acmstate.ReaderWriter
 	ValidatorSet      validator.Writer
 	ProposalReg       proposal.ReaderWriter
@@ -67,6 +69,8 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 
 	var ballot *payload.Ballot
 	var proposalHash []byte
+	// The height of the block we are executing in
+	height := ctx.Blockchain.LastBlockHeight() + 1
 
 	if ctx.tx.Proposal == nil {
 		// voting for existing proposal
@@ -79,8 +83,11 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 		if err != nil {
 			return err
 		}
+		if ballot == nil {
+			return errors.Errorf(errors.Codes.InvalidProposal, "proposal %X not found", proposalHash)
+		}
 	} else {
-		if ctx.tx.ProposalHash != nil || ctx.tx.Proposal.BatchTx == nil ||
+		if ctx.tx.Withdraw || ctx.tx.ProposalHash != nil || ctx.tx.Proposal.BatchTx == nil ||
 			len(ctx.tx.Proposal.BatchTx.Txs) == 0 || len(ctx.tx.Proposal.BatchTx.GetInputs()) == 0 {
 			return errors.Codes.InvalidProposal
 		}
@@ -107,6 +114,22 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 		// else vote for existing proposal
 	}
 
+	switch ballot.ProposalState {
+	case payload.Ballot_EXECUTED, payload.Ballot_FAILED:
+		return errors.Codes.ProposalExecuted
+	case payload.Ballot_EXPIRED:
+		return errors.Codes.ExpiredProposal
+	}
+
+	if ballot.Proposal.Expired(height) {
+		return errors.Errorf(errors.Codes.ExpiredProposal, "proposal expired at height %d",
+			ballot.Proposal.ExpiryHeight)
+	}
+
+	if ctx.tx.Withdraw {
+		return ctx.withdrawVote(proposalHash, ballot)
+	}
+
 	// Check that we have not voted this already
 	for _, vote := range ballot.Votes {
 		for _, i := range ctx.tx.GetInputs() {
@@ -256,6 +279,27 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 	return ctx.ProposalReg.UpdateProposal(proposalHash, ballot)
 }
 
+// Votes can be withdrawn until the proposal is executed, which happens as soon as the threshold is reached
+func (ctx *ProposalContext) withdrawVote(proposalHash []byte, ballot *payload.Ballot) error {
+	votes := make([]*payload.Vote, 0, len(ballot.Votes))
+	for _, vote := range ballot.Votes {
+		if vote.Address != ctx.tx.Input.Address {
+			votes = append(votes, vote)
+		}
+	}
+	if len(votes) == len(ballot.Votes) {
+		return errors.Errorf(errors.Codes.NotVoted, "account %v has not voted for proposal %X",
+			ctx.tx.Input.Address, proposalHash)
+	}
+
+	ctx.Logger.InfoMsg("Vote withdrawn",
+		"proposal_hash", fmt.Sprintf("%X", proposalHash),
+		"address", ctx.tx.Input.Address)
+
+	ballot.Votes = votes
+	return ctx.ProposalReg.UpdateProposal(proposalHash, ballot)
+}
+
 func validateProposalStrings(proposal *payload.Proposal) error {
 	if len(proposal.Name) == 0 {
 		return errors.Errorf(errors.Codes.InvalidString, "name must not be empty")
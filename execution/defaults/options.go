@@ -17,5 +17,8 @@ func CompleteOptions(options engine.Options) engine.Options {
 	if options.Natives == nil {
 		options.Natives = native.MustDefaultNatives()
 	}
+	if options.GasSchedule == nil {
+		options.GasSchedule = engine.FlatGasSchedule
+	}
 	return options
 }
@@ -49,7 +49,7 @@ func CallFromSite(st State, dispatcher Dispatcher, site CallParams, target CallP
 	}
 	// Get the arguments from the memory
 	// EVM contract
-	err = UseGasNegative(site.Gas, GasGetAccount)
+	err = UseGasNegative(site.Gas, st.CallFrame.GasSchedule().GetAccount)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/permission"
@@ -18,6 +19,10 @@ type CallFrame struct {
 	callStackDepth uint64
 	// Max call stack depth
 	maxCallStackDepth uint64
+	// Prices for metered operations
+	gasSchedule *GasSchedule
+	// Transient storage (EIP-1153) written in this frame
+	transient *transientStorage
 }
 
 // Create a new CallFrame to hold state updates at a particular level in the call stack
@@ -32,12 +37,15 @@ func newCallFrame(st acmstate.ReaderWriter, stackDepth uint64, maxCallStackDepth
 		cacheOptions:      cacheOptions,
 		callStackDepth:    stackDepth,
 		maxCallStackDepth: maxCallStackDepth,
+		gasSchedule:       FlatGasSchedule,
+		transient:         newTransientStorage(nil),
 	}
 }
 
 // Put this CallFrame in permanent read-only mode
 func (st *CallFrame) ReadOnly() *CallFrame {
 	acmstate.ReadOnly(st.Cache)
+	st.transient.readonly = true
 	return st
 }
 
@@ -46,12 +54,22 @@ func (st *CallFrame) WithMaxCallStackDepth(max uint64) *CallFrame {
 	return st
 }
 
+func (st *CallFrame) WithGasSchedule(schedule *GasSchedule) *CallFrame {
+	if schedule != nil {
+		st.gasSchedule = schedule
+	}
+	return st
+}
+
 func (st *CallFrame) NewFrame(cacheOptions ...acmstate.CacheOption) (*CallFrame, error) {
 	if st.maxCallStackDepth > 0 && st.maxCallStackDepth == st.callStackDepth {
 		return nil, errors.Codes.CallStackOverflow
 	}
-	return newCallFrame(st.Cache, st.callStackDepth+1, st.maxCallStackDepth,
-		append(st.cacheOptions, cacheOptions...)...), nil
+	frame := newCallFrame(st.Cache, st.callStackDepth+1, st.maxCallStackDepth,
+		append(st.cacheOptions, cacheOptions...)...)
+	frame.gasSchedule = st.gasSchedule
+	frame.transient = newTransientStorage(st.transient)
+	return frame, nil
 }
 
 func (st *CallFrame) Sync() error {
@@ -59,6 +77,28 @@ func (st *CallFrame) Sync() error {
 	if err != nil {
 		return errors.AsException(err)
 	}
+	st.transient.sync()
+	return nil
+}
+
+// Get the value of key in the transient storage of address. Transient storage lives as long as the outermost
+// CallFrame, which is to say for the duration of a transaction, and writes are discarded along with those of any
+// CallFrame that is not synced.
+func (st *CallFrame) GetTransientStorage(address crypto.Address, key binary.Word256) binary.Word256 {
+	for ts := st.transient; ts != nil; ts = ts.parent {
+		if value, ok := ts.values[address][key]; ok {
+			return value
+		}
+	}
+	return binary.Zero256
+}
+
+func (st *CallFrame) SetTransientStorage(address crypto.Address, key, value binary.Word256) error {
+	if st.transient.readonly {
+		return errors.Errorf(errors.Codes.IllegalWrite,
+			"SetTransientStorage called in a read-only context on account %v", address)
+	}
+	st.transient.set(address, key, value)
 	return nil
 }
 
@@ -66,6 +106,14 @@ func (st *CallFrame) CallStackDepth() uint64 {
 	return st.callStackDepth
 }
 
+// The schedule by which to charge gas, FlatGasSchedule for a nil CallFrame
+func (st *CallFrame) GasSchedule() *GasSchedule {
+	if st == nil {
+		return FlatGasSchedule
+	}
+	return st.gasSchedule
+}
+
 func (st *CallFrame) CreateAccount(creator, address crypto.Address) error {
 	err := EnsurePermission(st, creator, permission.CreateAccount)
 	if err != nil {
@@ -73,3 +121,40 @@ func (st *CallFrame) CreateAccount(creator, address crypto.Address) error {
 	}
 	return CreateAccount(st, address)
 }
+
+type transientStorage struct {
+	// Storage of the enclosing CallFrame
+	parent   *transientStorage
+	values   map[crypto.Address]map[binary.Word256]binary.Word256
+	readonly bool
+}
+
+func newTransientStorage(parent *transientStorage) *transientStorage {
+	return &transientStorage{
+		parent:   parent,
+		values:   make(map[crypto.Address]map[binary.Word256]binary.Word256),
+		readonly: parent != nil && parent.readonly,
+	}
+}
+
+func (ts *transientStorage) set(address crypto.Address, key, value binary.Word256) {
+	storage, ok := ts.values[address]
+	if !ok {
+		storage = make(map[binary.Word256]binary.Word256)
+		ts.values[address] = storage
+	}
+	storage[key] = value
+}
+
+// Write through to the enclosing frame's storage (a no-op for the outermost frame)
+func (ts *transientStorage) sync() {
+	if ts.parent == nil {
+		return
+	}
+	for address, storage := range ts.values {
+		for key, value := range storage {
+			ts.parent.set(address, key, value)
+		}
+	}
+	ts.values = make(map[crypto.Address]map[binary.Word256]binary.Word256)
+}
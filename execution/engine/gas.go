@@ -4,31 +4,244 @@
 package engine
 
 import (
+	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/asm"
 )
 
 const (
-	GasSha3          uint64 = 1
-	GasGetAccount    uint64 = 1
-	GasStorageUpdate uint64 = 1
-	GasCreateAccount uint64 = 1
-
-	GasBaseOp  uint64 = 0 // TODO: make this 1
-	GasStackOp uint64 = 1
-
-	GasEcRecover     uint64 = 1
-	GasSha256Word    uint64 = 1
-	GasSha256Base    uint64 = 1
-	GasRipemd160Word uint64 = 1
-	GasRipemd160Base uint64 = 1
-	GasExpModWord    uint64 = 1
-	GasExpModBase    uint64 = 1
-	GasIdentityWord  uint64 = 1
-	GasIdentityBase  uint64 = 1
+	FlatGasScheduleName     = "flat"
+	EthereumGasScheduleName = "ethereum"
 )
 
+// GasSchedule prices the operations metered by the virtual machines and natives. Since it determines which
+// transactions succeed it must be the same on every node of a chain.
+type GasSchedule struct {
+	// Charged for each EVM instruction executed not priced in OpCodes
+	BaseOp uint64
+	// Charged for each EVM instruction executed instead of BaseOp (in addition to any of the costs below)
+	OpCodes map[asm.OpCode]uint64
+	// Charged for each push to and pop from the EVM data stack
+	StackOp uint64
+	// Charged for expanding EVM memory to a given number of words as MemoryWord*words + words*words/MemoryQuadDivisor
+	// where a zero MemoryQuadDivisor means there is no quadratic term
+	MemoryWord        uint64
+	MemoryQuadDivisor uint64
+	// Charged per word copied by CALLDATACOPY, CODECOPY, EXTCODECOPY, RETURNDATACOPY, and MCOPY
+	CopyWord uint64
+	// Charged per word hashed by SHA3
+	Sha3Word uint64
+	// Charged per byte of the exponent of EXP
+	ExpByte uint64
+	// Charged per topic and per byte of data of a log
+	LogTopic    uint64
+	LogDataByte uint64
+	// Charged for loading an account other than the callee's
+	GetAccount uint64
+	// Charged by SSTORE for setting a zero slot to a non-zero value and for any other write respectively
+	StorageSet    uint64
+	StorageUpdate uint64
+	// Charged for creating an account
+	CreateAccount uint64
+	// Charged for each WASM instruction executed. The interpreter adds one more per basic block so that loops always
+	// consume gas.
+	WASMInstruction uint64
+
+	// Precompiles
+	EcRecover           uint64
+	Sha256Word          uint64
+	Sha256Base          uint64
+	Ripemd160Word       uint64
+	Ripemd160Base       uint64
+	ExpModWord          uint64
+	ExpModBase          uint64
+	IdentityWord        uint64
+	IdentityBase        uint64
+	Bn256Add            uint64
+	Bn256ScalarMul      uint64
+	Bn256PairingBase    uint64
+	Bn256PairingPerPair uint64
+	Blake2FRound        uint64
+}
+
+// FlatGasSchedule is Burrow's original schedule under which most operations cost 1 (or nothing). It is the default.
+var FlatGasSchedule = &GasSchedule{
+	BaseOp: 0,
+	OpCodes: map[asm.OpCode]uint64{
+		asm.SHA3:   1,
+		asm.TSTORE: 1,
+	},
+	StackOp:       1,
+	GetAccount:    1,
+	StorageSet:    1,
+	StorageUpdate: 1,
+	CreateAccount: 1,
+
+	WASMInstruction: 1,
+
+	EcRecover:     1,
+	Sha256Word:    1,
+	Sha256Base:    1,
+	Ripemd160Word: 1,
+	Ripemd160Base: 1,
+	ExpModWord:    1,
+	ExpModBase:    1,
+	IdentityWord:  1,
+	IdentityBase:  1,
+	// Elliptic curve and BLAKE2 precompiles are priced as in Ethereum (EIP-1108 and EIP-152) relative to which the
+	// above are negligible
+	Bn256Add:            150,
+	Bn256ScalarMul:      6000,
+	Bn256PairingBase:    45000,
+	Bn256PairingPerPair: 34000,
+	Blake2FRound:        1,
+}
+
+// EthereumGasSchedule approximates the Ethereum mainnet schedule so that gas limits sized for public networks carry
+// over. It follows the Istanbul prices (EIP-1884 and EIP-2200) without access lists, refunds, or the call stipend.
+var EthereumGasSchedule = &GasSchedule{
+	BaseOp: 3,
+	OpCodes: map[asm.OpCode]uint64{
+		asm.STOP:       0,
+		asm.MUL:        5,
+		asm.DIV:        5,
+		asm.SDIV:       5,
+		asm.MOD:        5,
+		asm.SMOD:       5,
+		asm.ADDMOD:     8,
+		asm.MULMOD:     8,
+		asm.EXP:        10,
+		asm.SIGNEXTEND: 5,
+
+		asm.SHA3: 30,
+
+		asm.ADDRESS:             2,
+		asm.BALANCE:             700,
+		asm.ORIGIN:              2,
+		asm.CALLER:              2,
+		asm.CALLVALUE:           2,
+		asm.CALLDATASIZE:        2,
+		asm.CODESIZE:            2,
+		asm.GASPRICE_DEPRECATED: 2,
+		asm.EXTCODESIZE:         700,
+		asm.EXTCODECOPY:         700,
+		asm.RETURNDATASIZE:      2,
+		asm.EXTCODEHASH:         700,
+
+		asm.BLOCKHASH:   20,
+		asm.COINBASE:    2,
+		asm.TIMESTAMP:   2,
+		asm.BLOCKHEIGHT: 2,
+		asm.DIFFICULTY:  2,
+		asm.GASLIMIT:    2,
+		asm.CHAINID:     2,
+		asm.SELFBALANCE: 5,
+		asm.BASEFEE:     2,
+
+		asm.POP:      2,
+		asm.SLOAD:    800,
+		asm.SSTORE:   0,
+		asm.JUMP:     8,
+		asm.JUMPI:    10,
+		asm.PC:       2,
+		asm.MSIZE:    2,
+		asm.GAS:      2,
+		asm.JUMPDEST: 1,
+		asm.TLOAD:    100,
+		asm.TSTORE:   100,
+		asm.PUSH0:    2,
+
+		asm.LOG0: 375,
+		asm.LOG1: 375,
+		asm.LOG2: 375,
+		asm.LOG3: 375,
+		asm.LOG4: 375,
+
+		// Together with CreateAccount these make up the 32000 charged by Ethereum
+		asm.CREATE:       7000,
+		asm.CREATE2:      7000,
+		asm.CALL:         700,
+		asm.CALLCODE:     700,
+		asm.RETURN:       0,
+		asm.DELEGATECALL: 700,
+		asm.STATICCALL:   700,
+		asm.REVERT:       0,
+		asm.INVALID:      0,
+		asm.SELFDESTRUCT: 5000,
+	},
+	StackOp:           0,
+	MemoryWord:        3,
+	MemoryQuadDivisor: 512,
+	CopyWord:          3,
+	Sha3Word:          6,
+	ExpByte:           50,
+	LogTopic:          375,
+	LogDataByte:       8,
+	GetAccount:        0,
+	StorageSet:        20000,
+	StorageUpdate:     5000,
+	CreateAccount:     25000,
+	WASMInstruction:   1,
+
+	EcRecover:     3000,
+	Sha256Word:    12,
+	Sha256Base:    60,
+	Ripemd160Word: 120,
+	Ripemd160Base: 600,
+	// Burrow's expMod charges base plus the product of the word lengths of its operands rather than EIP-2565's formula
+	ExpModWord:          1,
+	ExpModBase:          200,
+	IdentityWord:        3,
+	IdentityBase:        15,
+	Bn256Add:            150,
+	Bn256ScalarMul:      6000,
+	Bn256PairingBase:    45000,
+	Bn256PairingPerPair: 34000,
+	Blake2FRound:        1,
+}
+
+// GasScheduleByName returns the named gas schedule, the empty name gives the default flat schedule
+func GasScheduleByName(name string) (*GasSchedule, error) {
+	switch strings.ToLower(name) {
+	case "", FlatGasScheduleName:
+		return FlatGasSchedule, nil
+	case EthereumGasScheduleName:
+		return EthereumGasSchedule, nil
+	default:
+		return nil, fmt.Errorf("unknown gas schedule '%s', expected one of: %s, %s", name,
+			FlatGasScheduleName, EthereumGasScheduleName)
+	}
+}
+
+// The gas charged for executing op
+func (gs *GasSchedule) OpCode(op asm.OpCode) uint64 {
+	if gas, ok := gs.OpCodes[op]; ok {
+		return gas
+	}
+	return gs.BaseOp
+}
+
+// The gas charged for growing memory to words (which should be subtracted from that already charged for the current
+// memory size). Returns false if the cost does not fit in a uint64.
+func (gs *GasSchedule) Memory(words uint64) (uint64, bool) {
+	gas := new(big.Int).SetUint64(words)
+	gas.Mul(gas, new(big.Int).SetUint64(gs.MemoryWord))
+	if gs.MemoryQuadDivisor > 0 {
+		quad := new(big.Int).SetUint64(words)
+		quad.Mul(quad, quad)
+		gas.Add(gas, quad.Div(quad, new(big.Int).SetUint64(gs.MemoryQuadDivisor)))
+	}
+	return gas.Uint64(), gas.IsUint64()
+}
+
+// Whether expanding memory costs anything
+func (gs *GasSchedule) MetersMemory() bool {
+	return gs.MemoryWord > 0 || gs.MemoryQuadDivisor > 0
+}
+
 // Try to deduct gasToUse from gasLeft.  If ok return false, otherwise
 // set err and return true.
 func UseGasNegative(gasLeft *big.Int, gasToUse uint64) errors.CodedError {
@@ -0,0 +1,46 @@
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasScheduleByName(t *testing.T) {
+	gs, err := GasScheduleByName("")
+	require.NoError(t, err)
+	assert.Equal(t, FlatGasSchedule, gs)
+
+	gs, err = GasScheduleByName("Ethereum")
+	require.NoError(t, err)
+	assert.Equal(t, EthereumGasSchedule, gs)
+
+	_, err = GasScheduleByName("frontier")
+	require.Error(t, err)
+}
+
+func TestGasSchedule_OpCode(t *testing.T) {
+	assert.Equal(t, uint64(0), FlatGasSchedule.OpCode(asm.ADD))
+	assert.Equal(t, uint64(1), FlatGasSchedule.OpCode(asm.SHA3))
+	assert.Equal(t, uint64(3), EthereumGasSchedule.OpCode(asm.ADD))
+	assert.Equal(t, uint64(800), EthereumGasSchedule.OpCode(asm.SLOAD))
+}
+
+func TestGasSchedule_Memory(t *testing.T) {
+	assert.False(t, FlatGasSchedule.MetersMemory())
+	assert.True(t, EthereumGasSchedule.MetersMemory())
+
+	gas, ok := EthereumGasSchedule.Memory(1)
+	require.True(t, ok)
+	assert.Equal(t, uint64(3), gas)
+	// 3*1024 + 1024*1024/512
+	gas, ok = EthereumGasSchedule.Memory(1024)
+	require.True(t, ok)
+	assert.Equal(t, uint64(5120), gas)
+
+	_, ok = EthereumGasSchedule.Memory(math.MaxUint64)
+	assert.False(t, ok)
+}
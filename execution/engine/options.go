@@ -16,5 +16,24 @@ type Options struct {
 	CallStackMaxDepth        uint64
 	DataStackInitialCapacity uint64
 	DataStackMaxDepth        uint64
-	Logger                   *logging.Logger
+	// Prices for metered operations, defaults to FlatGasSchedule
+	GasSchedule *GasSchedule
+	// Limits for the WASM interpreter
+	WASM   WASMOptions
+	Logger *logging.Logger
+}
+
+// WASMOptions limit WASM contracts independently of the EVM. As with Options defaults will be used for any zero values.
+type WASMOptions struct {
+	// The most 64KiB pages of memory a module may declare or grow to
+	MaxMemoryPages uint64
+	// The pages of memory given to a module that imports its memory rather than declaring it
+	DefaultMemoryPages uint64
+	// The largest module in bytes that may be deployed, unlimited by default
+	MaxModuleSize uint64
+	// The deepest the interpreter's stack of function calls within a module may grow
+	StackDepth uint64
+	// The deepest the stack of calls between contracts may grow when a transaction calls a WASM contract, defaults
+	// to CallStackMaxDepth
+	CallStackMaxDepth uint64
 }
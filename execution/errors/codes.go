@@ -52,6 +52,8 @@ type codes struct {
 	InvalidContractCode    *Code
 	NonExistentAccount     *Code
 	NotCallable            *Code
+	NotVoted               *Code
+	InvalidBatch           *Code
 
 	// For lookup
 	codes []*Code
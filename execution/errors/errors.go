@@ -55,7 +55,7 @@ var Codes = &codes{
 	IllegalWrite:           code("callee attempted to illegally modify state"),
 	IntegerOverflow:        code("integer overflow"),
 	InvalidProposal:        code("proposal is invalid"),
-	ExpiredProposal:        code("proposal is expired since sequence number does not match"),
+	ExpiredProposal:        code("proposal has expired"),
 	ProposalExecuted:       code("proposal has already been executed"),
 	NoInputPermission:      code("account has no input permission"),
 	InvalidBlockNumber:     code("invalid block number"),
@@ -65,6 +65,8 @@ var Codes = &codes{
 	InvalidContractCode:    code("contract being created with unexpected code"),
 	NonExistentAccount:     code("account does not exist"),
 	NotCallable:            code("cannot dispatch call"),
+	NotVoted:               code("no vote registered for this address"),
+	InvalidBatch:           code("invalid batch"),
 }
 
 func init() {
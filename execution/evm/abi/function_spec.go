@@ -3,6 +3,7 @@ package abi
 import (
 	"fmt"
 
+	"github.com/tmthrgd/go-hex"
 	"golang.org/x/crypto/sha3"
 )
 
@@ -56,6 +57,10 @@ func (fs FunctionID) Bytes() []byte {
 	return fs[:]
 }
 
+func (fs FunctionID) String() string {
+	return hex.EncodeUpperToString(fs[:])
+}
+
 func argsToSignature(args []Argument, addIndexedName bool) (str string) {
 	str = "("
 	for i, a := range args {
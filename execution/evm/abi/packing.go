@@ -2,6 +2,7 @@ package abi
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"strings"
 
@@ -72,6 +73,11 @@ func init() {
 	}
 }
 
+var (
+	bigIntType = reflect.TypeOf(big.Int{})
+	bigRatType = reflect.TypeOf(big.Rat{})
+)
+
 func argGetter(argSpec []Argument, args []interface{}, ptr bool) (func(int) interface{}, error) {
 	if len(args) == 1 {
 		rv := reflect.ValueOf(args[0])
@@ -80,7 +86,8 @@ func argGetter(argSpec []Argument, args []interface{}, ptr bool) (func(int) inte
 		} else if ptr {
 			return nil, fmt.Errorf("struct pointer required in order to set values, but got %v", rv.Kind())
 		}
-		if rv.Kind() != reflect.Struct {
+		// big.Int and big.Rat are values in their own right rather than structs of arguments
+		if rv.Kind() != reflect.Struct || rv.Type() == bigIntType || rv.Type() == bigRatType {
 			if len(args) == 1 {
 				// Treat s single arg
 				return func(i int) interface{} { return args[i] }, nil
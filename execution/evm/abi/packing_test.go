@@ -97,3 +97,17 @@ func splat(v interface{}) []interface{} {
 
 	return vals
 }
+
+func TestUnpackSingleBigInt(t *testing.T) {
+	arg, err := NewArgument("value", "uint256")
+	require.NoError(t, err)
+	args := []Argument{arg}
+
+	data, err := Pack(args, uint64(256))
+	require.NoError(t, err)
+
+	out := new(big.Int)
+	err = Unpack(args, data, out)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(256), out)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"strconv"
 	"strings"
 	"unsafe" // just for Sizeof
 
@@ -724,14 +725,19 @@ func (e EVMString) ImplicitCast(o EVMType) bool {
 
 var _ EVMType = (*EVMFixed)(nil)
 
+// EVMFixed is a fixed-point decimal of M bits with N decimal places. As in solc a value v is encoded as the intM (or
+// uintM) v * 10**N so, for example, 1.5 as a ufixed128x18 is 1500000000000000000.
 type EVMFixed struct {
 	N, M   uint64
 	signed bool
 }
 
+func (e EVMFixed) String() string {
+	return fmt.Sprintf("EVMFixed{%v, %v, %v}", e.M, e.N, e.signed)
+}
+
 func (e EVMFixed) getGoType() interface{} {
-	// This is not right, obviously
-	return new(big.Float)
+	return new(big.Rat)
 }
 
 func (e EVMFixed) GetSignature() string {
@@ -743,15 +749,91 @@ func (e EVMFixed) GetSignature() string {
 }
 
 func (e EVMFixed) pack(v interface{}) ([]byte, error) {
-	// The ABI spec does not describe how this should be packed; go-ethereum abi does not implement this
-	// need to dig in solidity to find out how this is packed
-	return nil, fmt.Errorf("packing of %s not implemented, patches welcome", e.GetSignature())
+	r := new(big.Rat)
+
+	switch arg := v.(type) {
+	case *big.Rat:
+		r.Set(arg)
+	case big.Rat:
+		r.Set(&arg)
+	case *big.Int:
+		r.SetInt(arg)
+	case *big.Float:
+		arg.Rat(r)
+	case string:
+		_, ok := r.SetString(arg)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse `%s' as %s", arg, e.GetSignature())
+		}
+	case float64:
+		// Use the shortest decimal that identifies the float (so 0.1 is 0.1) rather than its exact binary value
+		r.SetString(strconv.FormatFloat(arg, 'f', -1, 64))
+	case float32:
+		r.SetString(strconv.FormatFloat(float64(arg), 'f', -1, 32))
+	default:
+		arg2 := reflect.ValueOf(v)
+		switch arg2.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			r.SetInt64(arg2.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			r.SetInt(new(big.Int).SetUint64(arg2.Uint()))
+		default:
+			return nil, fmt.Errorf("cannot convert type %T to %s", v, e.GetSignature())
+		}
+	}
+
+	n := new(big.Rat).Mul(r, new(big.Rat).SetInt(e.scale()))
+	if !n.IsInt() {
+		return nil, fmt.Errorf("value %v has more than the %d decimal places allowed by %s", v, e.N,
+			e.GetSignature())
+	}
+	x := n.Num()
+	if e.signed {
+		if x.BitLen() >= int(e.M) && !(x.Sign() < 0 && isNegativePowerOf2(x, e.M-1)) {
+			return nil, fmt.Errorf("value too large for %s", e.GetSignature())
+		}
+	} else {
+		if x.Sign() < 0 {
+			return nil, fmt.Errorf("negative value not allowed for %s", e.GetSignature())
+		}
+		if x.BitLen() > int(e.M) {
+			return nil, fmt.Errorf("value too large for %s", e.GetSignature())
+		}
+	}
+	if x.Sign() < 0 {
+		// Two's complement
+		x.Add(x, new(big.Int).Lsh(big.NewInt(1), ElementSize*8))
+	}
+	return pad(x.Bytes(), ElementSize, true), nil
 }
 
 func (e EVMFixed) unpack(data []byte, offset int, v interface{}) (int, error) {
-	// The ABI spec does not describe how this should be packed; go-ethereum abi does not implement this
-	// need to dig in solidity to find out how this is packed
-	return 0, fmt.Errorf("unpacking of %s not implemented, patches welcome", e.GetSignature())
+	if len(data)-offset < ElementSize {
+		return 0, fmt.Errorf("%v: not enough data", e)
+	}
+
+	x := new(big.Int).SetBytes(data[offset : offset+ElementSize])
+	if e.signed && data[offset]&0x80 != 0 {
+		x.Sub(x, new(big.Int).Lsh(big.NewInt(1), ElementSize*8))
+	}
+	r := new(big.Rat).SetFrac(x, e.scale())
+
+	switch v := v.(type) {
+	case *string:
+		*v = r.FloatString(int(e.N))
+	case *big.Rat:
+		v.Set(r)
+	case **big.Rat:
+		*v = r
+	case *big.Float:
+		v.SetRat(r)
+	case *float64:
+		*v, _ = r.Float64()
+	default:
+		return 0, fmt.Errorf("unable to convert %s to %T", e.GetSignature(), v)
+	}
+
+	return ElementSize, nil
 }
 
 func (e EVMFixed) Dynamic() bool {
@@ -762,6 +844,16 @@ func (e EVMFixed) ImplicitCast(o EVMType) bool {
 	return false
 }
 
+// 10**N by which values are multiplied for encoding
+func (e EVMFixed) scale() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(e.N), nil)
+}
+
+// Whether x == -2**n, the least value of a signed integer of n+1 bits
+func isNegativePowerOf2(x *big.Int, n uint64) bool {
+	return new(big.Int).Neg(x).Cmp(new(big.Int).Lsh(big.NewInt(1), uint(n))) == 0
+}
+
 // quick helper padding
 func pad(input []byte, size int, left bool) []byte {
 	if len(input) >= size {
@@ -1,6 +1,7 @@
 package abi
 
 import (
+	"encoding/hex"
 	"math/big"
 	"testing"
 
@@ -20,3 +21,60 @@ func TestEVMInt(t *testing.T) {
 		assert.Equal(t, bOut, b)
 	})
 }
+
+func TestEVMFixed(t *testing.T) {
+	// Encodings are those of the scaled integers v * 10**N as produced by solc
+	for _, tc := range []struct {
+		evm     EVMFixed
+		value   interface{}
+		packed  string
+		decimal string
+	}{
+		{EVMFixed{M: 128, N: 18}, "1.5", "00000000000000000000000000000000000000000000000014d1120d7b160000",
+			"1.500000000000000000"},
+		{EVMFixed{M: 128, N: 18, signed: true}, "-1.5", "ffffffffffffffffffffffffffffffffffffffffffffffffeb2eedf284ea0000",
+			"-1.500000000000000000"},
+		{EVMFixed{M: 8, N: 1, signed: true}, -0.1, "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			"-0.1"},
+		{EVMFixed{M: 8, N: 1, signed: true}, big.NewRat(-64, 5), "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff80",
+			"-12.8"},
+		{EVMFixed{M: 16, N: 2}, 3, "000000000000000000000000000000000000000000000000000000000000012c",
+			"3.00"},
+	} {
+		t.Run(tc.evm.GetSignature(), func(t *testing.T) {
+			data, err := tc.evm.pack(tc.value)
+			require.NoError(t, err)
+			assert.Equal(t, tc.packed, hex.EncodeToString(data))
+
+			var decimal string
+			_, err = tc.evm.unpack(data, 0, &decimal)
+			require.NoError(t, err)
+			assert.Equal(t, tc.decimal, decimal)
+
+			r := new(big.Rat)
+			_, err = tc.evm.unpack(data, 0, r)
+			require.NoError(t, err)
+			again, err := tc.evm.pack(r)
+			require.NoError(t, err)
+			assert.Equal(t, data, again)
+		})
+	}
+
+	t.Run("OutOfRange", func(t *testing.T) {
+		_, err := EVMFixed{M: 8, N: 1, signed: true}.pack("12.8")
+		require.Error(t, err)
+		_, err = EVMFixed{M: 8, N: 1}.pack("25.6")
+		require.Error(t, err)
+		_, err = EVMFixed{M: 8, N: 1}.pack("-0.1")
+		require.Error(t, err)
+		_, err = EVMFixed{M: 8, N: 1}.pack("0.05")
+		require.Error(t, err)
+	})
+
+	t.Run("Alias", func(t *testing.T) {
+		spec, err := ReadSpec([]byte(`[{"type":"function","name":"scale","inputs":[{"name":"x","type":"fixed"},` +
+			`{"name":"y","type":"ufixed"}],"outputs":[]}]`))
+		require.NoError(t, err)
+		assert.Equal(t, "scale(fixed128x18,ufixed128x18)", Signature("scale", spec.Functions["scale"].Inputs))
+	})
+}
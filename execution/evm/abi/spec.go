@@ -126,6 +126,16 @@ func (spec *Spec) GetEventAbi(id EventID, addresses crypto.Address) (*EventSpec,
 	return eventSpec, nil
 }
 
+// GetFunctionAbi returns the FunctionSpec for the function with the selector id
+func (spec *Spec) GetFunctionAbi(id FunctionID, address crypto.Address) (*FunctionSpec, error) {
+	for _, fspec := range spec.Functions {
+		if fspec.FunctionID == id {
+			return fspec, nil
+		}
+	}
+	return nil, fmt.Errorf("could not find ABI for function with ID %v", id)
+}
+
 // Pack ABI encodes a function call. The fname specifies which function should called, if
 // it doesn't exist exist the fallback function will be called. If fname is the empty
 // string, the constructor is called. The arguments must be specified in args. The count
@@ -210,6 +220,15 @@ func (spec *Spec) UnpackWithID(data []byte, args ...interface{}) error {
 	})
 }
 
+// NewArgument returns the Argument for a parameter of the named Solidity type, e.g. uint256 or bytes32[]
+func NewArgument(name, solidityType string) (Argument, error) {
+	args, err := readArgSpec([]argumentJSON{{Name: name, Type: solidityType}})
+	if err != nil {
+		return Argument{}, err
+	}
+	return args[0], nil
+}
+
 func readArgSpec(argsJ []argumentJSON) ([]Argument, error) {
 	args := make([]Argument, len(argsJ))
 	var err error
@@ -296,9 +315,9 @@ func readArgSpec(argsJ []argumentJSON) ([]Argument, error) {
 		case "bool":
 			args[i].EVM = EVMBool{}
 		case "fixed":
-			args[i].EVM = EVMFixed{M: 128, N: 8, signed: true}
+			args[i].EVM = EVMFixed{M: 128, N: 18, signed: true}
 		case "ufixed":
-			args[i].EVM = EVMFixed{M: 128, N: 8, signed: false}
+			args[i].EVM = EVMFixed{M: 128, N: 18, signed: false}
 		case "bytes":
 			args[i].EVM = EVMBytes{M: 0}
 		case "string":
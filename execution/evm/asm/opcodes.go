@@ -74,6 +74,8 @@ const (
 	DIFFICULTY
 	GASLIMIT
 	CHAINID
+	SELFBALANCE // https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1884.md
+	BASEFEE     // https://github.com/ethereum/EIPs/blob/master/EIPS/eip-3198.md
 )
 
 const (
@@ -90,6 +92,10 @@ const (
 	MSIZE
 	GAS
 	JUMPDEST
+	TLOAD  // https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1153.md
+	TSTORE // https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1153.md
+	MCOPY  // https://github.com/ethereum/EIPs/blob/master/EIPS/eip-5656.md
+	PUSH0  // https://github.com/ethereum/EIPs/blob/master/EIPS/eip-3855.md
 )
 
 const (
@@ -244,6 +250,8 @@ var opCodeNames = map[OpCode]string{
 	DIFFICULTY:  "DIFFICULTY",
 	GASLIMIT:    "GASLIMIT",
 	CHAINID:     "CHAINID",
+	SELFBALANCE: "SELFBALANCE",
+	BASEFEE:     "BASEFEE",
 
 	// 0x50 range - 'storage' and execution
 	POP:      "POP",
@@ -258,6 +266,10 @@ var opCodeNames = map[OpCode]string{
 	MSIZE:    "MSIZE",
 	GAS:      "GAS",
 	JUMPDEST: "JUMPDEST",
+	TLOAD:    "TLOAD",
+	TSTORE:   "TSTORE",
+	MCOPY:    "MCOPY",
+	PUSH0:    "PUSH0",
 
 	// 0x60 range - push
 	PUSH1:  "PUSH1",
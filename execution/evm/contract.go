@@ -52,10 +52,44 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 	// particular for 1, 3. acts a shared error sink for stack, memory, and the main execute loop
 	maybe := new(errors.Maybe)
 
+	gasSchedule := st.CallFrame.GasSchedule()
+
 	// Provide stack and memory storage - passing in the callState as an error provider
-	stack := NewStack(maybe, c.options.DataStackInitialCapacity, c.options.DataStackMaxDepth, params.Gas)
+	stack := NewStack(maybe, c.options.DataStackInitialCapacity, c.options.DataStackMaxDepth, params.Gas,
+		gasSchedule.StackOp)
 	memory := c.options.MemoryProvider(maybe)
 
+	// The number of words of memory charged for so far
+	var memoryWords uint64
+	// Charge for any expansion of memory needed to access size bytes from offset
+	useMemory := func(offset, size *big.Int) {
+		if size.Sign() == 0 || !gasSchedule.MetersMemory() {
+			return
+		}
+		words := wordsIn(new(big.Int).Add(offset, size))
+		if !words.IsUint64() {
+			maybe.PushError(errors.Codes.InsufficientGas)
+			return
+		}
+		if words.Uint64() <= memoryWords {
+			return
+		}
+		charged, _ := gasSchedule.Memory(memoryWords)
+		gas, ok := gasSchedule.Memory(words.Uint64())
+		if !ok {
+			maybe.PushError(errors.Codes.InsufficientGas)
+			return
+		}
+		maybe.PushError(engine.UseGasNegative(params.Gas, gas-charged))
+		memoryWords = words.Uint64()
+	}
+	// Charge for copying length bytes into memory at offset
+	useCopy := func(offset *big.Int, length uint64) {
+		size := new(big.Int).SetUint64(length)
+		useMemory(offset, size)
+		maybe.PushError(useGasPer(params.Gas, gasSchedule.CopyWord, wordsIn(size)))
+	}
+
 	for {
 		// Check for any error in this frame.
 		if maybe.Error() != nil {
@@ -64,8 +98,8 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		var op = c.GetSymbol(pc)
 		c.debugf("(pc) %-3d (op) %-14s (st) %-4d (gas) %d", pc, op.String(), stack.Len(), params.Gas)
-		// Use BaseOp gas.
-		maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasBaseOp))
+		// Charge for the instruction itself
+		maybe.PushError(engine.UseGasNegative(params.Gas, gasSchedule.OpCode(op)))
 
 		switch op {
 
@@ -157,6 +191,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case EXP: // 0x0A
 			x, y := stack.PopBigInt(), stack.PopBigInt()
+			maybe.PushError(useGasPer(params.Gas, gasSchedule.ExpByte, big.NewInt(int64((y.BitLen()+7)/8))))
 			pow := new(big.Int).Exp(x, y, nil)
 			res := stack.PushBigInt(pow)
 			c.debugf(" %v ** %v = %v (%v)\n", x, y, pow, res)
@@ -319,8 +354,9 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			}
 
 		case SHA3: // 0x20
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasSha3))
 			offset, size := stack.PopBigInt(), stack.PopBigInt()
+			useMemory(offset, size)
+			maybe.PushError(useGasPer(params.Gas, gasSchedule.Sha3Word, wordsIn(size)))
 			data := memory.Read(offset, size)
 			data = crypto.Keccak256(data)
 			stack.PushBytes(data)
@@ -332,7 +368,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case BALANCE: // 0x31
 			address := stack.PopAddress()
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasGetAccount))
+			maybe.PushError(engine.UseGasNegative(params.Gas, gasSchedule.GetAccount))
 			balance := engine.MustGetAccount(st.CallFrame, maybe, address).Balance
 			stack.Push64(balance)
 			c.debugf(" => %v (%v)\n", balance, address)
@@ -364,6 +400,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			memOff := stack.PopBigInt()
 			inputOff := stack.Pop64()
 			length := stack.Pop64()
+			useCopy(memOff, length)
 			data := maybe.Bytes(subslice(params.Input, inputOff, length))
 			memory.Write(memOff, data)
 			c.debugf(" => [%v, %v, %v] %X\n", memOff, inputOff, length, data)
@@ -377,6 +414,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			memOff := stack.PopBigInt()
 			codeOff := stack.Pop64()
 			length := stack.Pop64()
+			useCopy(memOff, length)
 			data := maybe.Bytes(subslice(c.GetBytecode(), codeOff, length))
 			memory.Write(memOff, data)
 			c.debugf(" => [%v, %v, %v] %X\n", memOff, codeOff, length, data)
@@ -387,7 +425,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case EXTCODESIZE: // 0x3B
 			address := stack.PopAddress()
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasGetAccount))
+			maybe.PushError(engine.UseGasNegative(params.Gas, gasSchedule.GetAccount))
 			acc := engine.MustGetAccount(st.CallFrame, maybe, address)
 			if acc == nil {
 				stack.Push(Zero256)
@@ -399,7 +437,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			}
 		case EXTCODECOPY: // 0x3C
 			address := stack.PopAddress()
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasGetAccount))
+			maybe.PushError(engine.UseGasNegative(params.Gas, gasSchedule.GetAccount))
 			acc := engine.MustGetAccount(st.CallFrame, maybe, address)
 			if acc == nil {
 				maybe.PushError(errors.Codes.UnknownAddress)
@@ -408,6 +446,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 				memOff := stack.PopBigInt()
 				codeOff := stack.Pop64()
 				length := stack.Pop64()
+				useCopy(memOff, length)
 				data := maybe.Bytes(subslice(code, codeOff, length))
 				memory.Write(memOff, data)
 				c.debugf(" => [%v, %v, %v] %X\n", memOff, codeOff, length, data)
@@ -419,6 +458,8 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case RETURNDATACOPY: // 0x3E
 			memOff, outputOff, length := stack.PopBigInt(), stack.PopBigInt(), stack.PopBigInt()
+			useMemory(memOff, length)
+			maybe.PushError(useGasPer(params.Gas, gasSchedule.CopyWord, wordsIn(length)))
 			end := new(big.Int).Add(outputOff, length)
 
 			if end.BitLen() > 64 || uint64(len(returnData)) < end.Uint64() {
@@ -493,18 +534,30 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			stack.PushBigInt(id)
 			c.debugf(" => %X\n", id)
 
+		case SELFBALANCE: // 0x47
+			balance := engine.MustGetAccount(st.CallFrame, maybe, params.Callee).Balance
+			stack.Push64(balance)
+			c.debugf(" => %v\n", balance)
+
+		case BASEFEE: // 0x48
+			// There is no fee market
+			stack.Push(Zero256)
+			c.debugf(" => %v\n", Zero256)
+
 		case POP: // 0x50
 			popped := stack.Pop()
 			c.debugf(" => 0x%v\n", popped)
 
 		case MLOAD: // 0x51
 			offset := stack.PopBigInt()
+			useMemory(offset, BigWord256Bytes)
 			data := memory.Read(offset, BigWord256Bytes)
 			stack.Push(LeftPadWord256(data))
 			c.debugf(" => 0x%X @ 0x%v\n", data, offset)
 
 		case MSTORE: // 0x52
 			offset, data := stack.PopBigInt(), stack.Pop()
+			useMemory(offset, BigWord256Bytes)
 			memory.Write(offset, data.Bytes())
 			c.debugf(" => 0x%v @ 0x%v\n", data, offset)
 
@@ -512,6 +565,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			offset := stack.PopBigInt()
 			val64 := stack.PopBigInt().Uint64()
 			val := byte(val64 & 0xFF)
+			useMemory(offset, big.NewInt(1))
 			memory.Write(offset, []byte{val})
 			c.debugf(" => [%v] 0x%X\n", offset, val)
 
@@ -523,7 +577,12 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case SSTORE: // 0x55
 			loc, data := stack.Pop(), stack.Pop()
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasStorageUpdate))
+			storageGas := gasSchedule.StorageUpdate
+			if gasSchedule.StorageSet != gasSchedule.StorageUpdate && !data.IsZero() &&
+				IsZeros(maybe.Bytes(st.CallFrame.GetStorage(params.Callee, loc))) {
+				storageGas = gasSchedule.StorageSet
+			}
+			maybe.PushError(engine.UseGasNegative(params.Gas, storageGas))
 			maybe.PushError(st.CallFrame.SetStorage(params.Callee, loc, data.Bytes()))
 			c.debugf("%v {%v := %v}\n", params.Callee, loc, data)
 
@@ -561,6 +620,31 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			c.debugf("\n")
 			// Do nothing
 
+		case TLOAD: // 0x5C
+			loc := stack.Pop()
+			data := st.CallFrame.GetTransientStorage(params.Callee, loc)
+			stack.Push(data)
+			c.debugf("%v {0x%v = 0x%v}\n", params.Callee, loc, data)
+
+		case TSTORE: // 0x5D
+			loc, data := stack.Pop(), stack.Pop()
+			maybe.PushError(st.CallFrame.SetTransientStorage(params.Callee, loc, data))
+			c.debugf("%v {%v := %v}\n", params.Callee, loc, data)
+
+		case MCOPY: // 0x5E
+			memOff, srcOff, length := stack.PopBigInt(), stack.PopBigInt(), stack.PopBigInt()
+			useMemory(srcOff, length)
+			useMemory(memOff, length)
+			maybe.PushError(useGasPer(params.Gas, gasSchedule.CopyWord, wordsIn(length)))
+			// Read returns a copy so overlapping regions are handled
+			data := memory.Read(srcOff, length)
+			memory.Write(memOff, data)
+			c.debugf(" => [%v, %v, %v] %X\n", memOff, srcOff, length, data)
+
+		case PUSH0: // 0x5F
+			stack.Push(Zero256)
+			c.debugf(" => 0x%v\n", Zero256)
+
 		case PUSH1, PUSH2, PUSH3, PUSH4, PUSH5, PUSH6, PUSH7, PUSH8, PUSH9, PUSH10, PUSH11, PUSH12, PUSH13, PUSH14, PUSH15, PUSH16, PUSH17, PUSH18, PUSH19, PUSH20, PUSH21, PUSH22, PUSH23, PUSH24, PUSH25, PUSH26, PUSH27, PUSH28, PUSH29, PUSH30, PUSH31, PUSH32:
 			a := uint64(op - PUSH1 + 1)
 			codeSegment := maybe.Bytes(subslice(c.GetBytecode(), pc+1, a))
@@ -586,6 +670,9 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			for i := 0; i < n; i++ {
 				topics[i] = stack.Pop()
 			}
+			useMemory(offset, size)
+			maybe.PushError(engine.UseGasNegative(params.Gas, uint64(n)*gasSchedule.LogTopic))
+			maybe.PushError(useGasPer(params.Gas, gasSchedule.LogDataByte, size))
 			data := memory.Read(offset, size)
 			maybe.PushError(st.EventSink.Log(&exec.LogEvent{
 				Address: params.Callee,
@@ -598,10 +685,11 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			returnData = nil
 			contractValue := stack.PopBigInt()
 			offset, size := stack.PopBigInt(), stack.PopBigInt()
+			useMemory(offset, size)
 			input := memory.Read(offset, size)
 
 			// TODO charge for gas to create account _ the code length * GasCreateByte
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasCreateAccount))
+			maybe.PushError(engine.UseGasNegative(params.Gas, gasSchedule.CreateAccount))
 
 			var newAccountAddress crypto.Address
 			if op == CREATE {
@@ -677,6 +765,8 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			// outputs
 			retOffset := stack.PopBigInt()
 			retSize := stack.Pop64()
+			useMemory(inOffset, inSize)
+			useMemory(retOffset, new(big.Int).SetUint64(retSize))
 			c.debugf(" => %v\n", target)
 
 			var err error
@@ -712,12 +802,14 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case RETURN: // 0xF3
 			offset, size := stack.PopBigInt(), stack.PopBigInt()
+			useMemory(offset, size)
 			output := memory.Read(offset, size)
 			c.debugf(" => [%v, %v] (%d) 0x%X\n", offset, size, len(output), output)
 			return output, maybe.Error()
 
 		case REVERT: // 0xFD
 			offset, size := stack.PopBigInt(), stack.PopBigInt()
+			useMemory(offset, size)
 			output := memory.Read(offset, size)
 			c.debugf(" => [%v, %v] (%d) 0x%X\n", offset, size, len(output), output)
 			maybe.PushError(newRevertException(output))
@@ -729,10 +821,10 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case SELFDESTRUCT: // 0xFF
 			receiver := stack.PopAddress()
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasGetAccount))
+			maybe.PushError(engine.UseGasNegative(params.Gas, gasSchedule.GetAccount))
 			if engine.GetAccount(st.CallFrame, maybe, receiver) == nil {
 				// If receiver address doesn't exist, try to create it
-				maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasCreateAccount))
+				maybe.PushError(engine.UseGasNegative(params.Gas, gasSchedule.CreateAccount))
 				if maybe.PushError(st.CallFrame.CreateAccount(params.Callee, receiver)) {
 					continue
 				}
@@ -759,6 +851,26 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 	return nil, maybe.Error()
 }
 
+// Deduct perUnit*units from gasLeft
+func useGasPer(gasLeft *big.Int, perUnit uint64, units *big.Int) errors.CodedError {
+	if perUnit == 0 {
+		return nil
+	}
+	gas := new(big.Int).SetUint64(perUnit)
+	gas.Mul(gas, units)
+	if gasLeft.Cmp(gas) < 0 {
+		return errors.Codes.InsufficientGas
+	}
+	gasLeft.Sub(gasLeft, gas)
+	return nil
+}
+
+// The number of words needed to hold numBytes
+func wordsIn(numBytes *big.Int) *big.Int {
+	words := new(big.Int).Add(numBytes, big.NewInt(Word256Bytes-1))
+	return words.Div(words, BigWord256Bytes)
+}
+
 func (c *Contract) jump(to uint64, pc *uint64) error {
 	dest := c.GetSymbol(to)
 	if dest != JUMPDEST || c.IsPushData(to) {
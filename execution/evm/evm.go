@@ -55,7 +55,9 @@ func (vm *EVM) Execute(st acmstate.ReaderWriter, blockchain engine.Blockchain, e
 	st = native.NewState(vm.options.Natives, st)
 
 	state := engine.State{
-		CallFrame:  engine.NewCallFrame(st).WithMaxCallStackDepth(vm.options.CallStackMaxDepth),
+		CallFrame: engine.NewCallFrame(st).
+			WithMaxCallStackDepth(vm.options.CallStackMaxDepth).
+			WithGasSchedule(vm.options.GasSchedule),
 		Blockchain: blockchain,
 		EventSink:  eventSink,
 	}
@@ -676,6 +676,7 @@ func TestEVM(t *testing.T) {
 			MustSplice(logDefault, PUSH1, 0x1, PUSH1, 0x1, PUSH1, 0x1, PUSH1, 0x1, LOG4),
 			MustSplice(PUSH1, 0x0, PUSH1, 0x0, PUSH1, 0x69, CREATE),
 			MustSplice(PUSH20, testRecipient, SELFDESTRUCT),
+			MustSplice(PUSH1, 0x1, PUSH1, 0x0, TSTORE),
 		} {
 			// TODO: CREATE2
 
@@ -800,11 +801,11 @@ func TestEVM(t *testing.T) {
 		callee := makeAccountWithCode(t, st, "callee", MustSplice(PUSH1, calleeReturnValue, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN))
 
 		// 6 op codes total
-		baseOpsCost := engine.GasBaseOp * 6
+		baseOpsCost := engine.FlatGasSchedule.BaseOp * 6
 		// 4 pushes
-		pushCost := engine.GasStackOp * 4
+		pushCost := engine.FlatGasSchedule.StackOp * 4
 		// 2 pushes 2 pops
-		returnCost := engine.GasStackOp * 4
+		returnCost := engine.FlatGasSchedule.StackOp * 4
 
 		delegateCallCost := baseOpsCost + pushCost + returnCost
 
@@ -1665,6 +1666,139 @@ func TestEVM(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("SELFBALANCE", func(t *testing.T) {
+		st := acmstate.NewMemoryState()
+		account1 := newAccount(t, st, "1")
+		bytecode := MustSplice(SELFBALANCE, return1())
+		account2 := makeAccountWithCode(t, st, "2", bytecode)
+
+		output, err := call(vm, st, account1, account2, bytecode, nil, big.NewInt(1000))
+		require.NoError(t, err)
+		assert.Equal(t, Uint64ToWord256(9999999).Bytes(), output)
+	})
+
+	t.Run("BASEFEE", func(t *testing.T) {
+		st := acmstate.NewMemoryState()
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "101")
+
+		output, err := call(vm, st, account1, account2, MustSplice(BASEFEE, return1()), nil, big.NewInt(1000))
+		require.NoError(t, err)
+		assert.Equal(t, Zero256.Bytes(), output)
+	})
+
+	t.Run("PUSH0", func(t *testing.T) {
+		st := acmstate.NewMemoryState()
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "101")
+
+		output, err := call(vm, st, account1, account2, MustSplice(PUSH1, 0x05, PUSH0, ADD, return1()), nil,
+			big.NewInt(1000))
+		require.NoError(t, err)
+		assert.Equal(t, Int64ToWord256(5).Bytes(), output)
+
+		// PUSH0 has no immediate data so a following JUMPDEST is a valid destination
+		output, err = call(vm, st, account1, account2, MustSplice(PUSH1, 0x04, JUMP, PUSH0, JUMPDEST, PUSH0,
+			return1()), nil, big.NewInt(1000))
+		require.NoError(t, err)
+		assert.Equal(t, Zero256.Bytes(), output)
+	})
+
+	t.Run("MCOPY", func(t *testing.T) {
+		st := acmstate.NewMemoryState()
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "101")
+		word := make([]byte, 32)
+		for i := range word {
+			word[i] = byte(i + 1)
+		}
+
+		// Copy forward over an overlapping region
+		output, err := call(vm, st, account1, account2, MustSplice(PUSH32, word, PUSH1, 0, MSTORE,
+			PUSH1, 32, PUSH1, 0, PUSH1, 16, MCOPY, PUSH1, 16, MLOAD, return1()), nil, big.NewInt(1000))
+		require.NoError(t, err)
+		assert.Equal(t, word, output)
+
+		// Copy backward over an overlapping region
+		output, err = call(vm, st, account1, account2, MustSplice(PUSH32, word, PUSH1, 0, MSTORE,
+			PUSH1, 32, PUSH1, 16, PUSH1, 0, MCOPY, PUSH1, 0, MLOAD, return1()), nil, big.NewInt(1000))
+		require.NoError(t, err)
+		assert.Equal(t, RightPadBytes(word[16:], 32), output)
+	})
+
+	t.Run("TransientStorage", func(t *testing.T) {
+		st := acmstate.NewMemoryState()
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "101")
+		gas := big.NewInt(100000)
+		tload := MustSplice(PUSH1, 0x01, TLOAD, return1())
+
+		output, err := call(vm, st, account1, account2, MustSplice(PUSH1, 0x2a, PUSH1, 0x01, TSTORE, tload), nil, gas)
+		require.NoError(t, err)
+		assert.Equal(t, Int64ToWord256(0x2a).Bytes(), output)
+
+		// Transient storage does not outlive the transaction
+		output, err = call(vm, st, account1, account2, tload, nil, gas)
+		require.NoError(t, err)
+		assert.Equal(t, Zero256.Bytes(), output)
+		value, err := st.GetStorage(account2, One256)
+		require.NoError(t, err)
+		assert.Nil(t, value)
+
+		// Store 0x2a then DELEGATECALL code that stores 0x07 into our transient storage and then return what we see
+		delegateCall := func(callee crypto.Address) []byte {
+			return MustSplice(PUSH1, 0x2a, PUSH1, 0x01, TSTORE,
+				PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH20, callee, PUSH2, 0xff, 0xff, DELEGATECALL, POP, tload)
+		}
+
+		storer := makeAccountWithCode(t, st, "storer", MustSplice(PUSH1, 0x07, PUSH1, 0x01, TSTORE, STOP))
+		output, err = call(vm, st, account1, account2, delegateCall(storer), nil, gas)
+		require.NoError(t, err)
+		assert.Equal(t, Int64ToWord256(0x07).Bytes(), output)
+
+		// Writes from a reverted frame are discarded
+		reverter := makeAccountWithCode(t, st, "reverter", MustSplice(PUSH1, 0x07, PUSH1, 0x01, TSTORE,
+			PUSH1, 0, PUSH1, 0, REVERT))
+		output, err = call(vm, st, account1, account2, delegateCall(reverter), nil, gas)
+		require.NoError(t, err)
+		assert.Equal(t, Int64ToWord256(0x2a).Bytes(), output)
+	})
+
+	t.Run("EthereumGasSchedule", func(t *testing.T) {
+		vm := New(engine.Options{
+			GasSchedule: engine.EthereumGasSchedule,
+		})
+		st := acmstate.NewMemoryState()
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "101")
+
+		for _, tc := range []struct {
+			name string
+			code []byte
+			gas  int64
+		}{
+			// Three instructions at 3 each
+			{"Add", MustSplice(PUSH1, 1, PUSH1, 2, ADD), 9},
+			// Expanding memory to one word costs 3
+			{"MStore", MustSplice(PUSH1, 1, PUSH1, 0, MSTORE), 12},
+			// Setting a zero slot then updating it
+			{"SStore", MustSplice(PUSH1, 1, PUSH1, 0, SSTORE, PUSH1, 2, PUSH1, 0, SSTORE), 25012},
+			// Hashing one word of memory including its expansion
+			{"Sha3", MustSplice(PUSH1, 32, PUSH1, 0, SHA3), 45},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				gas := big.NewInt(100000)
+				_, err := call(vm, st, account1, account2, tc.code, nil, gas)
+				require.NoError(t, err)
+				assert.Equal(t, tc.gas, 100000-gas.Int64())
+			})
+		}
+
+		// Insufficient gas for a fresh storage slot
+		_, err := call(vm, st, account1, account2, MustSplice(PUSH1, 1, PUSH1, 1, SSTORE), nil, big.NewInt(20000))
+		assert.Equal(t, errors.Codes.InsufficientGas, errors.GetCode(err))
+	})
 }
 
 // helpers
@@ -21,22 +21,25 @@ type Stack struct {
 	maxCapacity uint64
 	ptr         int
 
-	gas     *big.Int
-	errSink errors.Sink
+	gas      *big.Int
+	gasPerOp uint64
+	errSink  errors.Sink
 }
 
-func NewStack(errSink errors.Sink, initialCapacity uint64, maxCapacity uint64, gas *big.Int) *Stack {
+// NewStack returns a stack that charges gasPerOp to gas for each push and pop
+func NewStack(errSink errors.Sink, initialCapacity uint64, maxCapacity uint64, gas *big.Int, gasPerOp uint64) *Stack {
 	return &Stack{
 		slice:       make([]Word256, initialCapacity),
 		ptr:         0,
 		maxCapacity: maxCapacity,
 		gas:         gas,
+		gasPerOp:    gasPerOp,
 		errSink:     errSink,
 	}
 }
 
 func (st *Stack) Push(d Word256) {
-	st.useGas(engine.GasStackOp)
+	st.useGas(st.gasPerOp)
 	err := st.ensureCapacity(uint64(st.ptr) + 1)
 	if err != nil {
 		st.pushErr(errors.Codes.DataStackOverflow)
@@ -47,7 +50,7 @@ func (st *Stack) Push(d Word256) {
 }
 
 func (st *Stack) Pop() Word256 {
-	st.useGas(engine.GasStackOp)
+	st.useGas(st.gasPerOp)
 	if st.ptr == 0 {
 		st.pushErr(errors.Codes.DataStackUnderflow)
 		return Zero256
@@ -110,7 +113,7 @@ func (st *Stack) Len() int {
 }
 
 func (st *Stack) Swap(n int) {
-	st.useGas(engine.GasStackOp)
+	st.useGas(st.gasPerOp)
 	if st.ptr < n {
 		st.pushErr(errors.Codes.DataStackUnderflow)
 		return
@@ -119,7 +122,7 @@ func (st *Stack) Swap(n int) {
 }
 
 func (st *Stack) Dup(n int) {
-	st.useGas(engine.GasStackOp)
+	st.useGas(st.gasPerOp)
 	if st.ptr < n {
 		st.pushErr(errors.Codes.DataStackUnderflow)
 		return
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,7 +15,7 @@ import (
 var maxUint64 = big.NewInt(math.MaxInt64)
 
 func TestStack_MaxDepthInt32(t *testing.T) {
-	st := NewStack(new(errors.Maybe), 0, 0, maxUint64)
+	st := NewStack(new(errors.Maybe), 0, 0, maxUint64, engine.FlatGasSchedule.StackOp)
 
 	err := st.ensureCapacity(math.MaxInt32 + 1)
 	assert.Error(t, err)
@@ -23,7 +24,7 @@ func TestStack_MaxDepthInt32(t *testing.T) {
 // Test static memory allocation with unlimited depth - memory should grow
 func TestStack_UnlimitedAllocation(t *testing.T) {
 	err := new(errors.Maybe)
-	st := NewStack(err, 0, 0, maxUint64)
+	st := NewStack(err, 0, 0, maxUint64, engine.FlatGasSchedule.StackOp)
 
 	st.Push64(math.MaxInt64)
 	require.NoError(t, err.Error())
@@ -35,7 +36,7 @@ func TestStack_UnlimitedAllocation(t *testing.T) {
 func TestStack_StaticAllocation(t *testing.T) {
 	err := new(errors.Maybe)
 
-	st := NewStack(err, 4, 4, maxUint64)
+	st := NewStack(err, 4, 4, maxUint64, engine.FlatGasSchedule.StackOp)
 
 	for i := 0; i < 4; i++ {
 		st.Push64(math.MaxInt64)
@@ -49,7 +50,7 @@ func TestStack_StaticAllocation(t *testing.T) {
 func TestDynamicMemory_PushAhead(t *testing.T) {
 	err := new(errors.Maybe)
 
-	st := NewStack(err, 2, 4, maxUint64)
+	st := NewStack(err, 2, 4, maxUint64, engine.FlatGasSchedule.StackOp)
 
 	for i := 0; i < 4; i++ {
 		st.Push64(math.MaxInt64)
@@ -63,7 +64,7 @@ func TestDynamicMemory_PushAhead(t *testing.T) {
 func TestStack_ZeroInitialCapacity(t *testing.T) {
 	err := new(errors.Maybe)
 
-	st := NewStack(err, 0, 16, maxUint64)
+	st := NewStack(err, 0, 16, maxUint64, engine.FlatGasSchedule.StackOp)
 	require.NoError(t, err.Error())
 	st.Push64(math.MaxInt64)
 	assert.Equal(t, []binary.Word256{binary.Int64ToWord256(math.MaxInt64)}, st.slice)
@@ -71,7 +72,7 @@ func TestStack_ZeroInitialCapacity(t *testing.T) {
 
 func TestStack_ensureCapacity(t *testing.T) {
 
-	st := NewStack(new(errors.Maybe), 4, 16, maxUint64)
+	st := NewStack(new(errors.Maybe), 4, 16, maxUint64, engine.FlatGasSchedule.StackOp)
 	// Check we can grow within bounds
 	err := st.ensureCapacity(8)
 	assert.NoError(t, err)
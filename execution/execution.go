@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"runtime/debug"
 	"sync"
+	"time"
 
 	"github.com/hyperledger/burrow/execution/vms"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/hyperledger/burrow/execution/registry"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/instrument"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/permission"
@@ -51,7 +53,8 @@ type ExecutorState interface {
 	acmstate.MetadataReader
 	names.Reader
 	registry.Reader
-	proposal.Reader
+	proposal.IterableReader
+	proposal.ExpiryIterable
 	validator.IterableReader
 }
 
@@ -85,6 +88,7 @@ type executor struct {
 	proposalRegCache *proposal.Cache
 	validatorCache   *validator.Cache
 	emitter          *event.Emitter
+	blockchain       engine.Blockchain
 	block            *exec.BlockExecution
 	logger           *logging.Logger
 	vmOptions        engine.Options
@@ -94,15 +98,44 @@ type executor struct {
 type Params struct {
 	ChainID           string
 	ProposalThreshold uint64
+	GasSchedule       string
 }
 
 func ParamsFromGenesis(genesisDoc *genesis.GenesisDoc) Params {
 	return Params{
 		ChainID:           genesisDoc.GetChainID(),
 		ProposalThreshold: genesisDoc.Params.ProposalThreshold,
+		GasSchedule:       genesisDoc.Params.GasSchedule,
 	}
 }
 
+// VMOptions returns the options with which the VMs execute on this chain given the executor options, for example to
+// simulate calls. A gas schedule named in params takes precedence over none being set in options but it is an error
+// for them to conflict.
+func (p Params) VMOptions(options ...Option) (engine.Options, error) {
+	exe := new(executor)
+	for _, option := range options {
+		option(exe)
+	}
+	return p.withGasSchedule(exe.vmOptions)
+}
+
+func (p Params) withGasSchedule(vmOptions engine.Options) (engine.Options, error) {
+	if p.GasSchedule == "" {
+		return vmOptions, nil
+	}
+	gasSchedule, err := engine.GasScheduleByName(p.GasSchedule)
+	if err != nil {
+		return engine.Options{}, err
+	}
+	if vmOptions.GasSchedule != nil && vmOptions.GasSchedule != gasSchedule {
+		return engine.Options{}, fmt.Errorf("gas schedule configured for execution conflicts with '%s' gas "+
+			"schedule set in genesis", p.GasSchedule)
+	}
+	vmOptions.GasSchedule = gasSchedule
+	return vmOptions, nil
+}
+
 var _ BatchExecutor = (*executor)(nil)
 
 // Wraps a cache of what is variously known as the 'check cache' and 'mempool'
@@ -139,6 +172,7 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		proposalRegCache: proposal.NewCache(backend),
 		validatorCache:   validator.NewCache(backend),
 		emitter:          emitter,
+		blockchain:       blockchain,
 		block: &exec.BlockExecution{
 			Height:            blockchain.LastBlockHeight() + 1,
 			PredecessorHeight: predecessor,
@@ -148,70 +182,126 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 	for _, option := range options {
 		option(exe)
 	}
+	exe.vmOptions, err = params.withGasSchedule(exe.vmOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	baseContexts := exe.baseContexts(exe.stateCache, exe.metadataCache, exe.nameRegCache, exe.nodeRegCache,
+		exe.validatorCache)
+
+	exe.contexts = map[payload.Type]contexts.Context{
+		payload.TypeProposal: &contexts.ProposalContext{
+			ChainID:           params.ChainID,
+			ProposalThreshold: params.ProposalThreshold,
+			Blockchain:        blockchain,
+			State:             exe.stateCache,
+			ProposalReg:       exe.proposalRegCache,
+			Logger:            exe.logger,
+			Contexts:          baseContexts,
+		},
+		payload.TypeBatch: &contexts.BatchContext{
+			ChainID:  params.ChainID,
+			State:    exe.stateCache,
+			NewBatch: exe.newBatch,
+			Logger:   exe.logger,
+		},
+	}
+
+	// Copy over base contexts
+	for k, v := range baseContexts {
+		exe.contexts[k] = v
+	}
 
-	baseContexts := map[payload.Type]contexts.Context{
+	return exe, nil
+}
+
+// The contexts for the txs that can be proposed or batched, which execute against the caches given
+func (exe *executor) baseContexts(stateCache *acmstate.Cache, metadataCache *acmstate.MetadataCache,
+	nameRegCache *names.Cache, nodeRegCache *registry.Cache,
+	validatorCache *validator.Cache) map[payload.Type]contexts.Context {
+
+	return map[payload.Type]contexts.Context{
 		payload.TypeCall: &contexts.CallContext{
-			// TODO: expose WASM options to config
 			VMS:           vms.NewConnectedVirtualMachines(exe.vmOptions),
-			Blockchain:    blockchain,
-			State:         exe.stateCache,
-			MetadataState: exe.metadataCache,
-			RunCall:       runCall,
+			Blockchain:    exe.blockchain,
+			State:         stateCache,
+			MetadataState: metadataCache,
+			RunCall:       exe.runCall,
 			Logger:        exe.logger,
 		},
 		payload.TypeSend: &contexts.SendContext{
-			State:  exe.stateCache,
+			State:  stateCache,
 			Logger: exe.logger,
 		},
 		payload.TypeName: &contexts.NameContext{
-			Blockchain: blockchain,
-			State:      exe.stateCache,
-			NameReg:    exe.nameRegCache,
+			Blockchain: exe.blockchain,
+			State:      stateCache,
+			NameReg:    nameRegCache,
 			Logger:     exe.logger,
 		},
 		payload.TypePermissions: &contexts.PermissionsContext{
-			State:  exe.stateCache,
+			State:  stateCache,
 			Logger: exe.logger,
 		},
 		payload.TypeGovernance: &contexts.GovernanceContext{
-			ValidatorSet: exe.validatorCache,
-			State:        exe.stateCache,
+			ValidatorSet: validatorCache,
+			State:        stateCache,
 			Logger:       exe.logger,
 		},
 		payload.TypeBond: &contexts.BondContext{
-			ValidatorSet: exe.validatorCache,
-			State:        exe.stateCache,
+			ValidatorSet: validatorCache,
+			State:        stateCache,
 			Logger:       exe.logger,
 		},
 		payload.TypeUnbond: &contexts.UnbondContext{
-			ValidatorSet: exe.validatorCache,
-			State:        exe.stateCache,
+			ValidatorSet: validatorCache,
+			State:        stateCache,
 			Logger:       exe.logger,
 		},
 		payload.TypeIdentify: &contexts.IdentifyContext{
-			NodeWriter:  exe.nodeRegCache,
-			StateReader: exe.stateCache,
+			NodeWriter:  nodeRegCache,
+			StateReader: stateCache,
 			Logger:      exe.logger,
 		},
 	}
+}
 
-	exe.contexts = map[payload.Type]contexts.Context{
-		payload.TypeProposal: &contexts.ProposalContext{
-			ChainID:           params.ChainID,
-			ProposalThreshold: params.ProposalThreshold,
-			State:             exe.stateCache,
-			ProposalReg:       exe.proposalRegCache,
-			Logger:            exe.logger,
-			Contexts:          baseContexts,
+// Layers caches over the executor's own so that the txs in a BatchTx are applied all at once or not at all
+func (exe *executor) newBatch() *contexts.Batch {
+	stateCache := acmstate.NewCache(exe.stateCache, acmstate.Named("BatchCache"))
+	metadataCache := acmstate.NewMetadataCache(exe.metadataCache)
+	nameRegCache := names.NewCache(exe.nameRegCache)
+	nodeRegCache := registry.NewCache(exe.nodeRegCache)
+	// Work on a copy of the block's bucket so that power changes within the batch are subject to the same flow limits
+	// as the rest of the block - any change that would be rejected is rejected before the batch writes anything
+	validatorCache := &validator.Cache{Bucket: exe.validatorCache.Copy()}
+	return &contexts.Batch{
+		State:    stateCache,
+		Contexts: exe.baseContexts(stateCache, metadataCache, nameRegCache, nodeRegCache, validatorCache),
+		Sync: func() error {
+			err := stateCache.Sync(exe.stateCache)
+			if err != nil {
+				return err
+			}
+			err = metadataCache.Sync(exe.metadataCache)
+			if err != nil {
+				return err
+			}
+			err = nameRegCache.Sync(exe.nameRegCache)
+			if err != nil {
+				return err
+			}
+			err = nodeRegCache.Sync(exe.nodeRegCache)
+			if err != nil {
+				return err
+			}
+			// The copy has already checked every change so adopting it cannot fail, but only do so once everything else
+			// has been written
+			exe.validatorCache.Bucket = validatorCache.Bucket
+			return nil
 		},
 	}
-
-	// Copy over base contexts
-	for k, v := range baseContexts {
-		exe.contexts[k] = v
-	}
-
-	return exe, nil
 }
 
 func (exe *executor) AddContext(ty payload.Type, ctx contexts.Context) *executor {
@@ -244,6 +334,7 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 	}
 
 	if txExecutor, ok := exe.contexts[txEnv.Tx.Type()]; ok {
+		began := time.Now()
 		// Establish new TxExecution
 		txe := exe.block.Tx(txEnv)
 		defer func() {
@@ -274,6 +365,9 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 			txe.PushError(err)
 			return nil, err
 		}
+		if exe.runCall {
+			exe.observeTx(txe, began)
+		}
 		// Return execution for this tx
 		return txe, nil
 	}
@@ -355,6 +449,10 @@ func (exe *executor) Commit(header *types.Header) (stateHash []byte, err error)
 	// Capture height
 	height := exe.block.Height
 	exe.logger.InfoMsg("Executor committing", "height", exe.block.Height)
+	err = exe.expireProposals(height)
+	if err != nil {
+		return nil, err
+	}
 	// Form BlockExecution for this block from TxExecutions and Tendermint block header
 	blockExecution, err := exe.finaliseBlockExecution(header)
 	if err != nil {
@@ -362,6 +460,7 @@ func (exe *executor) Commit(header *types.Header) (stateHash []byte, err error)
 	}
 	// First commit the app state, this app hash will not get checkpointed until the next block when we are sure
 	// that nothing in the downstream commit process could have failed. At worst we go back one block.
+	began := time.Now()
 	hash, version, err := exe.state.Update(func(ws state.Updatable) error {
 		// flush the caches
 		err := exe.stateCache.Sync(ws)
@@ -397,6 +496,8 @@ func (exe *executor) Commit(header *types.Header) (stateHash []byte, err error)
 	if err != nil {
 		return nil, err
 	}
+	instrument.CommitTime.Observe(time.Since(began).Seconds())
+	instrument.GasPerBlock.Observe(float64(blockGasUsed(blockExecution)))
 	// Complete flushing of caches by resetting them to the state we have just committed
 	err = exe.Reset()
 	if err != nil {
@@ -424,6 +525,38 @@ func (exe *executor) Reset() error {
 	return nil
 }
 
+// Marks any proposals still waiting for votes as expired once their expiry height is reached. Proposals added in this
+// block are not yet in state but cannot expire until a later block.
+func (exe *executor) expireProposals(height uint64) error {
+	var expired [][]byte
+	err := exe.state.IterateExpiredProposals(height, func(proposalHash []byte) error {
+		expired = append(expired, proposalHash)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, proposalHash := range expired {
+		// Read through the cache in case the proposal was executed in this block
+		ballot, err := exe.proposalRegCache.GetProposal(proposalHash)
+		if err != nil {
+			return err
+		}
+		if ballot == nil || ballot.ProposalState != payload.Ballot_PROPOSED {
+			continue
+		}
+		exe.logger.InfoMsg("Proposal expired",
+			"proposal_hash", fmt.Sprintf("%X", proposalHash),
+			"expiry_height", ballot.Proposal.ExpiryHeight)
+		ballot.ProposalState = payload.Ballot_EXPIRED
+		err = exe.proposalRegCache.UpdateProposal(proposalHash, ballot)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // executor exposes access to the underlying state cache protected by a RWMutex that prevents access while locked
 // (during an ABCI commit). while access can occur (and needs to continue for CheckTx/DeliverTx to make progress)
 // through calls to Execute() external readers will be blocked until the executor is unlocked that allows the Transactor
@@ -472,6 +605,31 @@ func (exe *executor) finaliseBlockExecution(header *types.Header) (*exec.BlockEx
 	return be, nil
 }
 
+// Records the execution time and any exception of a tx we have run for real (as opposed to checked)
+func (exe *executor) observeTx(txe *exec.TxExecution, began time.Time) {
+	payloadType := txe.TxType.String()
+	instrument.TxExecutionTime.WithLabelValues(payloadType).Observe(time.Since(began).Seconds())
+	if txe.Exception != nil {
+		instrument.TxExceptions.WithLabelValues(payloadType, errors.GetCode(txe.Exception).Name).Inc()
+	}
+}
+
+func blockGasUsed(be *exec.BlockExecution) uint64 {
+	return txsGasUsed(be.TxExecutions)
+}
+
+// Sums gas used including that of the txs contained in batches and executed proposals
+func txsGasUsed(txes []*exec.TxExecution) uint64 {
+	var gasUsed uint64
+	for _, txe := range txes {
+		if txe.Result != nil {
+			gasUsed += txe.Result.GasUsed
+		}
+		gasUsed += txsGasUsed(txe.TxExecutions)
+	}
+	return gasUsed
+}
+
 // update sequence numbers
 func (exe *executor) updateSequenceNumbers(txEnv *txs.Envelope) error {
 	for _, sig := range txEnv.Signatories {
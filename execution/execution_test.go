@@ -6,6 +6,7 @@ package execution
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 	"runtime/debug"
 	"strconv"
 	"testing"
@@ -20,6 +21,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/contexts"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	. "github.com/hyperledger/burrow/execution/evm/asm"
@@ -29,10 +31,14 @@ import (
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/instrument"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -1508,6 +1514,244 @@ func TestPredecessorTracking(t *testing.T) {
 	require.Equal(t, uint64(5), exe.block.Height)
 }
 
+func TestProposalExpiry(t *testing.T) {
+	st, signers := makeGenesisState(5, 1)
+	exe := makeProposalExecutor(st, 3)
+	proposal := makeProposal(t, exe, signers, exe.block.Height+1)
+	proposalHash := contexts.HashProposal(proposal)
+
+	// Cannot create a proposal that has already expired
+	expired := makeProposal(t, exe, signers, exe.block.Height)
+	err := exe.signExecuteCommit(voteTx(t, exe, signers[2], expired, nil), signers[2])
+	assertErrorCode(t, errors.Codes.ExpiredProposal, err)
+
+	err = exe.signExecuteCommit(voteTx(t, exe, signers[2], proposal, nil), signers[2])
+	require.NoError(t, err)
+	ballot := getBallot(t, exe, proposalHash)
+	assert.Equal(t, payload.Ballot_PROPOSED, ballot.ProposalState)
+
+	// No votes accepted from the expiry height
+	require.Equal(t, proposal.ExpiryHeight, exe.block.Height)
+	err = exe.signExecuteCommit(voteTx(t, exe, signers[3], nil, proposalHash), signers[3])
+	assertErrorCode(t, errors.Codes.ExpiredProposal, err)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	ballot = getBallot(t, exe, proposalHash)
+	assert.Equal(t, payload.Ballot_EXPIRED, ballot.ProposalState)
+	assert.Len(t, ballot.Votes, 1)
+
+	err = exe.signExecuteCommit(voteTx(t, exe, signers[3], nil, proposalHash), signers[3])
+	assertErrorCode(t, errors.Codes.ExpiredProposal, err)
+}
+
+func TestProposalWithdrawVote(t *testing.T) {
+	st, signers := makeGenesisState(5, 1)
+	exe := makeProposalExecutor(st, 2)
+	proposal := makeProposal(t, exe, signers, 0)
+	proposalHash := contexts.HashProposal(proposal)
+
+	err := exe.signExecuteCommit(voteTx(t, exe, signers[2], proposal, nil), signers[2])
+	require.NoError(t, err)
+
+	// Cannot withdraw a vote that was never cast
+	tx := voteTx(t, exe, signers[3], nil, proposalHash)
+	tx.Withdraw = true
+	err = exe.signExecuteCommit(tx, signers[3])
+	assertErrorCode(t, errors.Codes.NotVoted, err)
+
+	tx = voteTx(t, exe, signers[2], nil, proposalHash)
+	tx.Withdraw = true
+	err = exe.signExecuteCommit(tx, signers[2])
+	require.NoError(t, err)
+	ballot := getBallot(t, exe, proposalHash)
+	assert.Equal(t, payload.Ballot_PROPOSED, ballot.ProposalState)
+	assert.Empty(t, ballot.Votes)
+
+	// Withdrawn vote no longer counts towards the threshold
+	err = exe.signExecuteCommit(voteTx(t, exe, signers[3], nil, proposalHash), signers[3])
+	require.NoError(t, err)
+	assert.Equal(t, payload.Ballot_PROPOSED, getBallot(t, exe, proposalHash).ProposalState)
+
+	err = exe.signExecuteCommit(voteTx(t, exe, signers[2], nil, proposalHash), signers[2])
+	require.NoError(t, err)
+	ballot = getBallot(t, exe, proposalHash)
+	assert.Equal(t, payload.Ballot_EXECUTED, ballot.ProposalState)
+
+	// Too late once executed
+	tx = voteTx(t, exe, signers[2], nil, proposalHash)
+	tx.Withdraw = true
+	err = exe.signExecuteCommit(tx, signers[2])
+	assertErrorCode(t, errors.Codes.ProposalExecuted, err)
+}
+
+func TestBatchTx(t *testing.T) {
+	st, signers := makeGenesisState(4, 1)
+	exe := makeExecutor(st)
+	batcher := exe.getAccount(t, signers[0].GetAddress())
+	sender := exe.getAccount(t, signers[1].GetAddress())
+	recipient := exe.getAccount(t, signers[2].GetAddress())
+
+	first := payload.NewSendTx()
+	first.AddInputWithSequence(signers[1].GetPublicKey(), 10, sender.Sequence+1)
+	first.AddOutput(recipient.Address, 10)
+	second := payload.NewSendTx()
+	second.AddInputWithSequence(signers[0].GetPublicKey(), 20, batcher.Sequence+1)
+	second.AddOutput(recipient.Address, 20)
+	batchTx := &payload.BatchTx{
+		Inputs: []*payload.TxInput{
+			{Address: batcher.Address, Sequence: batcher.Sequence + 1},
+			{Address: sender.Address, Sequence: sender.Sequence + 1},
+		},
+		Txs: []*payload.Any{first.Any(), second.Any()},
+	}
+	txEnv := txs.Enclose(testChainID, batchTx)
+	require.NoError(t, txEnv.Sign(signers[0], signers[1]))
+	txe, err := exe.Execute(txEnv)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	require.Len(t, txe.TxExecutions, 2)
+	assert.Equal(t, getTxHash(first), txe.TxExecutions[0].TxHash.Bytes())
+	assert.Equal(t, payload.TypeSend, txe.TxExecutions[1].TxType)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assert.Equal(t, recipient.Balance+30, exe.getAccount(t, recipient.Address).Balance)
+	assert.Equal(t, batcher.Sequence+2, exe.getAccount(t, batcher.Address).Sequence)
+
+	// A failing step discards the whole batch
+	recipient = exe.getAccount(t, recipient.Address)
+	sender = exe.getAccount(t, signers[1].GetAddress())
+	first = payload.NewSendTx()
+	first.AddInputWithSequence(signers[1].GetPublicKey(), 10, sender.Sequence+1)
+	first.AddOutput(recipient.Address, 10)
+	second = payload.NewSendTx()
+	second.AddInputWithSequence(signers[1].GetPublicKey(), sender.Balance, sender.Sequence+2)
+	second.AddOutput(recipient.Address, sender.Balance)
+	err = exe.signExecuteCommit(&payload.BatchTx{
+		Inputs: []*payload.TxInput{{Address: sender.Address, Sequence: sender.Sequence + 1}},
+		Txs:    []*payload.Any{first.Any(), second.Any()},
+	}, signers[1])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "step 2 of batch failed")
+	assert.Equal(t, recipient.Balance, exe.getAccount(t, recipient.Address).Balance)
+	assert.Equal(t, sender.Sequence, exe.getAccount(t, sender.Address).Sequence)
+
+	// Only the signers of the batch may be inputs to its txs
+	batcher = exe.getAccount(t, signers[0].GetAddress())
+	first = payload.NewSendTx()
+	first.AddInputWithSequence(signers[1].GetPublicKey(), 10, sender.Sequence+1)
+	first.AddOutput(recipient.Address, 10)
+	err = exe.signExecuteCommit(&payload.BatchTx{
+		Inputs: []*payload.TxInput{{Address: batcher.Address, Sequence: batcher.Sequence + 1}},
+		Txs:    []*payload.Any{first.Any()},
+	}, signers[0])
+	assertErrorCode(t, errors.Codes.InvalidBatch, err)
+
+	err = exe.signExecuteCommit(&payload.BatchTx{
+		Inputs: []*payload.TxInput{{Address: batcher.Address, Sequence: batcher.Sequence + 1}},
+	}, signers[0])
+	assertErrorCode(t, errors.Codes.InvalidBatch, err)
+}
+
+func TestBatchTxValidatorFlow(t *testing.T) {
+	st, signers := makeGenesisState(4, 1)
+	exe := makeExecutor(st)
+	governor := exe.getAccount(t, signers[0].GetAddress())
+	recipient := exe.getAccount(t, signers[1].GetAddress())
+
+	totalPower := new(big.Int)
+	require.NoError(t, st.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		totalPower.Add(totalPower, power)
+		return nil
+	}))
+	// Each change alone is within the flow permitted per block but the two together are not
+	maxFlow := new(big.Int).Sub(totalPower.Div(totalPower, big.NewInt(3)), big.NewInt(1))
+	flow := maxFlow.Div(maxFlow.Mul(maxFlow, big.NewInt(2)), big.NewInt(3))
+
+	govTx := payload.AlterPowerTx(governor.Address, signers[2], flow.Uint64())
+	govTx.Inputs[0].Sequence = governor.Sequence + 1
+	txEnv := txs.Enclose(testChainID, govTx)
+	require.NoError(t, txEnv.Sign(signers[0]))
+	txe, err := exe.Execute(txEnv)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+
+	send := payload.NewSendTx()
+	send.AddInputWithSequence(signers[0].GetPublicKey(), 10, governor.Sequence+2)
+	send.AddOutput(recipient.Address, 10)
+	govTx = payload.AlterPowerTx(governor.Address, signers[3], flow.Uint64())
+	govTx.Inputs[0].Sequence = governor.Sequence + 3
+	txEnv = txs.Enclose(testChainID, &payload.BatchTx{
+		Inputs: []*payload.TxInput{{Address: governor.Address, Sequence: governor.Sequence + 2}},
+		Txs:    []*payload.Any{send.Any(), govTx.Any()},
+	})
+	require.NoError(t, txEnv.Sign(signers[0]))
+	txe, err = exe.Execute(txEnv)
+	if err == nil {
+		err = txe.Exception.AsError()
+	}
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "step 2 of batch failed")
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+
+	// Neither the send nor the second power change were written
+	assert.Equal(t, recipient.Balance, exe.getAccount(t, recipient.Address).Balance)
+	assert.Equal(t, governor.Sequence+1, exe.getAccount(t, governor.Address).Sequence)
+	power, err := st.Power(signers[2].GetAddress())
+	require.NoError(t, err)
+	assert.Equal(t, flow, power)
+	power, err = st.Power(signers[3].GetAddress())
+	require.NoError(t, err)
+	assert.Equal(t, 0, power.Sign())
+}
+
+func TestInstrumentation(t *testing.T) {
+	st, signers := makeGenesisState(3, 1)
+	exe := makeExecutor(st)
+	contractAddress := newAddress("invalid")
+	exe.updateAccounts(t, &acm.Account{
+		Address: contractAddress,
+		EVMCode: bc.MustSplice(INVALID),
+	})
+
+	sendTime := sampleCount(t, instrument.TxExecutionTime.WithLabelValues(payload.TypeSend.String()))
+	commitTime := sampleCount(t, instrument.CommitTime)
+	gasPerBlock := sampleCount(t, instrument.GasPerBlock)
+	send := payload.NewSendTx()
+	require.NoError(t, send.AddInput(exe.stateCache, signers[0].GetPublicKey(), 10))
+	send.AddOutput(signers[1].GetAddress(), 10)
+	require.NoError(t, exe.signExecuteCommit(send, signers[0]))
+	assert.Equal(t, sendTime+1, sampleCount(t, instrument.TxExecutionTime.WithLabelValues(payload.TypeSend.String())))
+	assert.Equal(t, commitTime+1, sampleCount(t, instrument.CommitTime))
+	assert.Equal(t, gasPerBlock+1, sampleCount(t, instrument.GasPerBlock))
+
+	call, err := payload.NewCallTx(exe.stateCache, signers[0].GetPublicKey(), &contractAddress, nil, 0, 1000, 0)
+	require.NoError(t, err)
+	txEnv := txs.Enclose(testChainID, call)
+	require.NoError(t, txEnv.Sign(signers[0]))
+	exceptions := instrument.TxExceptions.WithLabelValues(payload.TypeCall.String(), errors.Codes.ExecutionAborted.Name)
+	count := testutil.ToFloat64(exceptions)
+	txe, err := exe.Execute(txEnv)
+	require.NoError(t, err)
+	assertErrorCode(t, errors.Codes.ExecutionAborted, txe.Exception)
+	assert.Equal(t, count+1, testutil.ToFloat64(exceptions))
+}
+
+func TestBlockGasUsed(t *testing.T) {
+	be := &exec.BlockExecution{
+		TxExecutions: []*exec.TxExecution{
+			{Result: &exec.Result{GasUsed: 3}},
+			{
+				TxExecutions: []*exec.TxExecution{
+					{Result: &exec.Result{GasUsed: 5}},
+					{TxExecutions: []*exec.TxExecution{{Result: &exec.Result{GasUsed: 7}}}},
+				},
+			},
+		},
+	}
+	assert.Equal(t, uint64(15), blockGasUsed(be))
+}
+
 // Helpers
 
 func makeUsers(n int) []acm.AddressableSigner {
@@ -1605,6 +1849,52 @@ func makeExecutor(state *state.State) *testExecutor {
 	}
 }
 
+func makeProposalExecutor(st *state.State, threshold uint64) *testExecutor {
+	exe := makeExecutor(st)
+	exe.contexts[payload.TypeProposal].(*contexts.ProposalContext).ProposalThreshold = threshold
+	return exe
+}
+
+// Proposes a send from the second signer batched by the first, leaving the remaining signers to vote
+func makeProposal(t *testing.T, exe *testExecutor, signers []*acm.PrivateAccount, expiryHeight uint64) *payload.Proposal {
+	batchInput := exe.getAccount(t, signers[0].GetAddress())
+	sender := exe.getAccount(t, signers[1].GetAddress())
+	send := payload.NewSendTx()
+	send.AddInputWithSequence(signers[1].GetPublicKey(), 10, sender.Sequence+1)
+	send.AddOutput(signers[0].GetAddress(), 10)
+	return &payload.Proposal{
+		Name:        "send",
+		Description: "Send some tokens",
+		BatchTx: &payload.BatchTx{
+			Inputs: []*payload.TxInput{{Address: batchInput.Address, Sequence: batchInput.Sequence + 1}},
+			Txs:    []*payload.Any{send.Any()},
+		},
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+func voteTx(t *testing.T, exe *testExecutor, voter *acm.PrivateAccount, proposal *payload.Proposal,
+	proposalHash []byte) *payload.ProposalTx {
+	acc := exe.getAccount(t, voter.GetAddress())
+	tx := &payload.ProposalTx{
+		Input:        &payload.TxInput{Address: acc.Address, Sequence: acc.Sequence + 1},
+		VotingWeight: 1,
+		Proposal:     proposal,
+	}
+	if proposalHash != nil {
+		hash := HexBytes(proposalHash)
+		tx.ProposalHash = &hash
+	}
+	return tx
+}
+
+func getBallot(t *testing.T, exe *testExecutor, proposalHash []byte) *payload.Ballot {
+	ballot, err := exe.state.GetProposal(proposalHash)
+	require.NoError(t, err)
+	require.NotNil(t, ballot)
+	return ballot
+}
+
 func copyState(t testing.TB, st *state.State) *state.State {
 	cpy, err := st.Copy(dbm.NewMemDB())
 	require.NoError(t, err)
@@ -1891,6 +2181,12 @@ func wrapContractForCreate(contractCode []byte) []byte {
 	return code
 }
 
+func sampleCount(t *testing.T, observer prometheus.Observer) uint64 {
+	metric := new(dto.Metric)
+	require.NoError(t, observer.(prometheus.Metric).Write(metric))
+	return metric.GetHistogram().GetSampleCount()
+}
+
 func assertErrorCode(t *testing.T, expectedCode *errors.Code, err error, msgAndArgs ...interface{}) {
 	if assert.Error(t, err, msgAndArgs...) {
 		actualCode := errors.AsException(err).ErrorCode()
@@ -1905,3 +2201,54 @@ func newAddress(name string) crypto.Address {
 	hasher.Write([]byte(name))
 	return crypto.MustAddressFromBytes(hasher.Sum(nil))
 }
+
+func TestParams_VMOptions(t *testing.T) {
+	vmOptions, err := Params{}.VMOptions()
+	require.NoError(t, err)
+	assert.Nil(t, vmOptions.GasSchedule)
+
+	params := Params{GasSchedule: engine.EthereumGasScheduleName}
+	vmOptions, err = params.VMOptions()
+	require.NoError(t, err)
+	assert.Equal(t, engine.EthereumGasSchedule, vmOptions.GasSchedule)
+
+	// Configuration can agree with genesis but not contradict it
+	config := &ExecutionConfig{GasSchedule: engine.EthereumGasScheduleName}
+	options, err := config.ExecutionOptions()
+	require.NoError(t, err)
+	vmOptions, err = params.VMOptions(options...)
+	require.NoError(t, err)
+	assert.Equal(t, engine.EthereumGasSchedule, vmOptions.GasSchedule)
+
+	config.GasSchedule = engine.FlatGasScheduleName
+	options, err = config.ExecutionOptions()
+	require.NoError(t, err)
+	_, err = params.VMOptions(options...)
+	require.Error(t, err)
+
+	_, err = Params{GasSchedule: "frontier"}.VMOptions()
+	require.Error(t, err)
+}
+
+func TestExecutionConfig_WASM(t *testing.T) {
+	config := DefaultExecutionConfig()
+	config.WASM.MaxModuleSize = 1 << 16
+	config.WASM.CallStackMaxDepth = 8
+	options, err := config.ExecutionOptions()
+	require.NoError(t, err)
+	exe := new(executor)
+	for _, option := range options {
+		option(exe)
+	}
+	assert.Equal(t, engine.WASMOptions{
+		MaxMemoryPages:     16,
+		DefaultMemoryPages: 16,
+		MaxModuleSize:      1 << 16,
+		StackDepth:         512,
+		CallStackMaxDepth:  8,
+	}, exe.vmOptions.WASM)
+
+	config.WASM.DefaultMemoryPages = 32
+	_, err = config.ExecutionOptions()
+	require.Error(t, err)
+}
@@ -2,12 +2,15 @@ package native
 
 import (
 	cryptoSha256 "crypto/sha256"
+	bin "encoding/binary"
 	"fmt"
 	"math/big"
 
 	"github.com/hyperledger/burrow/execution/engine"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/ethereum/go-ethereum/crypto/blake2b"
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
@@ -37,6 +40,22 @@ var Precompiles = New().
 		leftPadAddress(5),
 		permission.None,
 		expMod).
+	MustFunction(`Add two points on the alt_bn128 curve (EIP-196)`,
+		leftPadAddress(6),
+		permission.None,
+		bn256Add).
+	MustFunction(`Multiply a point on the alt_bn128 curve by a scalar (EIP-196)`,
+		leftPadAddress(7),
+		permission.None,
+		bn256ScalarMul).
+	MustFunction(`Check that the product of the optimal ate pairings of pairs of alt_bn128 points is one (EIP-197)`,
+		leftPadAddress(8),
+		permission.None,
+		bn256Pairing).
+	MustFunction(`Compute the BLAKE2b compression function F (EIP-152)`,
+		leftPadAddress(9),
+		permission.None,
+		blake2F).
 	MustFunction(`Compute the keccak256 hash of input`,
 		leftPadAddress(20),
 		permission.None,
@@ -49,7 +68,7 @@ func leftPadAddress(bs ...byte) crypto.Address {
 // SECP256K1 Recovery
 func ecrecover(ctx Context) ([]byte, error) {
 	// Deduct gas
-	gasRequired := engine.GasEcRecover
+	gasRequired := ctx.State.GasSchedule().EcRecover
 	var err error = engine.UseGasNegative(ctx.Gas, gasRequired)
 	if err != nil {
 		return nil, err
@@ -100,7 +119,8 @@ func ecrecover(ctx Context) ([]byte, error) {
 
 func sha256(ctx Context) (output []byte, err error) {
 	// Deduct gas
-	gasRequired := wordsIn(uint64(len(ctx.Input)))*engine.GasSha256Word + engine.GasSha256Base
+	gasSchedule := ctx.State.GasSchedule()
+	gasRequired := wordsIn(uint64(len(ctx.Input)))*gasSchedule.Sha256Word + gasSchedule.Sha256Base
 	err = engine.UseGasNegative(ctx.Gas, gasRequired)
 	if err != nil {
 		return nil, err
@@ -114,7 +134,8 @@ func sha256(ctx Context) (output []byte, err error) {
 
 func ripemd160Func(ctx Context) (output []byte, err error) {
 	// Deduct gas
-	gasRequired := wordsIn(uint64(len(ctx.Input)))*engine.GasRipemd160Word + engine.GasRipemd160Base
+	gasSchedule := ctx.State.GasSchedule()
+	gasRequired := wordsIn(uint64(len(ctx.Input)))*gasSchedule.Ripemd160Word + gasSchedule.Ripemd160Base
 	err = engine.UseGasNegative(ctx.Gas, gasRequired)
 	if err != nil {
 		return nil, err
@@ -128,7 +149,8 @@ func ripemd160Func(ctx Context) (output []byte, err error) {
 
 func keccak256Func(ctx Context) (output []byte, err error) {
 	// Deduct gas
-	gasRequired := wordsIn(uint64(len(ctx.Input)))*engine.GasRipemd160Word + engine.GasRipemd160Base
+	gasSchedule := ctx.State.GasSchedule()
+	gasRequired := wordsIn(uint64(len(ctx.Input)))*gasSchedule.Ripemd160Word + gasSchedule.Ripemd160Base
 	err = engine.UseGasNegative(ctx.Gas, gasRequired)
 	if err != nil {
 		return nil, err
@@ -142,7 +164,8 @@ func keccak256Func(ctx Context) (output []byte, err error) {
 
 func identity(ctx Context) (output []byte, err error) {
 	// Deduct gas
-	gasRequired := wordsIn(uint64(len(ctx.Input)))*engine.GasIdentityWord + engine.GasIdentityBase
+	gasSchedule := ctx.State.GasSchedule()
+	gasRequired := wordsIn(uint64(len(ctx.Input)))*gasSchedule.IdentityWord + gasSchedule.IdentityBase
 	err = engine.UseGasNegative(ctx.Gas, gasRequired)
 	if err != nil {
 		return nil, err
@@ -168,7 +191,8 @@ func expMod(ctx Context) (output []byte, err error) {
 
 	// TODO: implement non-trivial gas schedule for this operation. Probably a parameterised version of the one
 	// described in EIP though that one seems like a bit of a complicated fudge
-	gasRequired := engine.GasExpModBase + engine.GasExpModWord*(wordsIn(baseLength)*wordsIn(expLength)*wordsIn(modLength))
+	gasSchedule := ctx.State.GasSchedule()
+	gasRequired := gasSchedule.ExpModBase + gasSchedule.ExpModWord*(wordsIn(baseLength)*wordsIn(expLength)*wordsIn(modLength))
 
 	err = engine.UseGasNegative(ctx.Gas, gasRequired)
 	if err != nil {
@@ -194,6 +218,145 @@ func expMod(ctx Context) (output []byte, err error) {
 	return binary.LeftPadBytes(new(big.Int).Exp(base, exp, mod).Bytes(), int(modLength)), nil
 }
 
+const (
+	bn256PointLength        = 2 * binary.Word256Bytes
+	bn256TwistPointLength   = 4 * binary.Word256Bytes
+	bn256PairingInputLength = bn256PointLength + bn256TwistPointLength
+)
+
+// bn256Add implements EIP-196 point addition on the alt_bn128 curve
+func bn256Add(ctx Context) (output []byte, err error) {
+	err = engine.UseGasNegative(ctx.Gas, ctx.State.GasSchedule().Bn256Add)
+	if err != nil {
+		return nil, err
+	}
+	x, err := bn256Point(getData(ctx.Input, 0, bn256PointLength))
+	if err != nil {
+		return nil, fmt.Errorf("bn256Add: %v", err)
+	}
+	y, err := bn256Point(getData(ctx.Input, bn256PointLength, bn256PointLength))
+	if err != nil {
+		return nil, fmt.Errorf("bn256Add: %v", err)
+	}
+	return new(bn256.G1).Add(x, y).Marshal(), nil
+}
+
+// bn256ScalarMul implements EIP-196 scalar multiplication on the alt_bn128 curve
+func bn256ScalarMul(ctx Context) (output []byte, err error) {
+	err = engine.UseGasNegative(ctx.Gas, ctx.State.GasSchedule().Bn256ScalarMul)
+	if err != nil {
+		return nil, err
+	}
+	p, err := bn256Point(getData(ctx.Input, 0, bn256PointLength))
+	if err != nil {
+		return nil, fmt.Errorf("bn256ScalarMul: %v", err)
+	}
+	scalar := new(big.Int).SetBytes(getData(ctx.Input, bn256PointLength, binary.Word256Bytes))
+	return new(bn256.G1).ScalarMult(p, scalar).Marshal(), nil
+}
+
+// bn256Pairing implements the EIP-197 pairing check on the alt_bn128 curve returning one as a word if the product of
+// the pairings of each (G1, G2) pair in input is one and zero otherwise
+func bn256Pairing(ctx Context) (output []byte, err error) {
+	const errHeader = "bn256Pairing"
+	numPairs := uint64(len(ctx.Input) / bn256PairingInputLength)
+	gasSchedule := ctx.State.GasSchedule()
+	err = engine.UseGasNegative(ctx.Gas, gasSchedule.Bn256PairingBase+numPairs*gasSchedule.Bn256PairingPerPair)
+	if err != nil {
+		return nil, err
+	}
+	if len(ctx.Input)%bn256PairingInputLength != 0 {
+		return nil, fmt.Errorf("%s: input length %d is not a multiple of %d", errHeader, len(ctx.Input),
+			bn256PairingInputLength)
+	}
+	g1s := make([]*bn256.G1, numPairs)
+	g2s := make([]*bn256.G2, numPairs)
+	for i := range g1s {
+		pair := ctx.Input[i*bn256PairingInputLength : (i+1)*bn256PairingInputLength]
+		g1s[i], err = bn256Point(pair[:bn256PointLength])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", errHeader, err)
+		}
+		g2s[i] = new(bn256.G2)
+		_, err = g2s[i].Unmarshal(pair[bn256PointLength:])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", errHeader, err)
+		}
+	}
+	if bn256.PairingCheck(g1s, g2s) {
+		return binary.One256.Bytes(), nil
+	}
+	return binary.Zero256.Bytes(), nil
+}
+
+func bn256Point(bs []byte) (*bn256.G1, error) {
+	p := new(bn256.G1)
+	_, err := p.Unmarshal(bs)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+const (
+	blake2FInputLength = 213
+	blake2FFinalFlag   = 1
+)
+
+// blake2F implements EIP-152, exposing the BLAKE2b compression function F with a configurable number of rounds
+func blake2F(ctx Context) (output []byte, err error) {
+	const errHeader = "blake2F"
+	// layout is:
+	// input:  [ rounds |  h   |  m    |  t   |  f  ]
+	// bytes:  [   4    |  64  |  128  |  16  |  1  ]
+	// Where rounds is big-endian and all other integers are little-endian
+	input := ctx.Input
+	if len(input) != blake2FInputLength {
+		return nil, fmt.Errorf("%s: input length %d should be %d", errHeader, len(input), blake2FInputLength)
+	}
+	rounds := bin.BigEndian.Uint32(input)
+	err = engine.UseGasNegative(ctx.Gas, uint64(rounds)*ctx.State.GasSchedule().Blake2FRound)
+	if err != nil {
+		return nil, err
+	}
+	final := input[blake2FInputLength-1]
+	if final > blake2FFinalFlag {
+		return nil, fmt.Errorf("%s: final block flag must be 0 or 1 but is %d", errHeader, final)
+	}
+	var h [8]uint64
+	var m [16]uint64
+	var t [2]uint64
+	input = input[4:]
+	for i := range h {
+		h[i] = bin.LittleEndian.Uint64(input[i*8:])
+	}
+	input = input[len(h)*8:]
+	for i := range m {
+		m[i] = bin.LittleEndian.Uint64(input[i*8:])
+	}
+	input = input[len(m)*8:]
+	for i := range t {
+		t[i] = bin.LittleEndian.Uint64(input[i*8:])
+	}
+
+	blake2b.F(&h, m, t, final == blake2FFinalFlag, rounds)
+
+	output = make([]byte, len(h)*8)
+	for i, word := range h {
+		bin.LittleEndian.PutUint64(output[i*8:], word)
+	}
+	return output, nil
+}
+
+// Returns the size bytes of input from start right padding with zeros where input is too short
+func getData(input []byte, start, size uint64) []byte {
+	data := make([]byte, size)
+	if start < uint64(len(input)) {
+		copy(data, input[start:])
+	}
+	return data
+}
+
 // Partition the head of input into segments for each length in lengths. The first return value is the unconsumed tail
 // of input and the seconds is the segments. Returns an error if input is of insufficient length to establish each segment.
 func cut(input []byte, lengths ...uint64) ([]byte, [][]byte, error) {
@@ -0,0 +1,72 @@
+package native
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Ethereum consensus test vectors for precompiles
+type precompileVector struct {
+	Name          string
+	Input         string
+	Expected      string
+	ExpectedError string
+	Gas           uint64
+}
+
+func TestPrecompileVectors(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		function string
+		vectors  string
+	}{
+		{"Bn256Add", "bn256Add", "bn256Add.json"},
+		{"Bn256ScalarMul", "bn256ScalarMul", "bn256ScalarMul.json"},
+		{"Bn256Pairing", "bn256Pairing", "bn256Pairing.json"},
+		{"Blake2F", "blake2F", "blake2F.json"},
+		{"Blake2FFail", "blake2F", "fail-blake2f.json"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			function, ok := Precompiles.GetByName(tc.function).(*Function)
+			require.True(t, ok, "could not get precompile %s", tc.function)
+			f := function.F.(func(Context) ([]byte, error))
+			for _, vector := range readPrecompileVectors(t, tc.vectors) {
+				input, err := hex.DecodeString(vector.Input)
+				require.NoError(t, err)
+				gas := new(big.Int).SetUint64(math.MaxUint64)
+				output, err := f(Context{CallParams: engine.CallParams{Input: input, Gas: gas}})
+				if vector.ExpectedError != "" {
+					assert.Error(t, err, vector.Name)
+					continue
+				}
+				require.NoError(t, err, vector.Name)
+				assert.Equal(t, vector.Expected, hex.EncodeToString(output), vector.Name)
+				assert.Equal(t, vector.Gas, math.MaxUint64-gas.Uint64(), vector.Name)
+			}
+		})
+	}
+
+	t.Run("InsufficientGas", func(t *testing.T) {
+		input := make([]byte, bn256PairingInputLength)
+		_, err := bn256Pairing(Context{CallParams: engine.CallParams{Input: input, Gas: big.NewInt(45000)}})
+		require.Error(t, err)
+	})
+}
+
+func readPrecompileVectors(t *testing.T, name string) []precompileVector {
+	bs, err := ioutil.ReadFile(filepath.Join("testdata", "precompiles", name))
+	require.NoError(t, err)
+	var vectors []precompileVector
+	require.NoError(t, json.Unmarshal(bs, &vectors))
+	require.NotEmpty(t, vectors)
+	return vectors
+}
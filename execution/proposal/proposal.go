@@ -24,6 +24,11 @@ type Iterable interface {
 	IterateProposals(consumer func(proposalHash []byte, proposal *payload.Ballot) error) (err error)
 }
 
+type ExpiryIterable interface {
+	// Iterates over the hashes of the proposals open for votes that expire at or below height
+	IterateExpiredProposals(height uint64, consumer func(proposalHash []byte) error) (err error)
+}
+
 type IterableReader interface {
 	Iterable
 	Reader
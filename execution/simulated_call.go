@@ -16,11 +16,24 @@ import (
 
 // Run a contract's code on an isolated and unpersisted state
 // Cannot be used to create new contracts
-func CallSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress, address crypto.Address, data []byte,
+func CallSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, options engine.Options, fromAddress,
+	address crypto.Address, data []byte, logger *logging.Logger) (*exec.TxExecution, error) {
+	return CallTxSim(reader, blockchain, options, &payload.CallTx{
+		Input: &payload.TxInput{
+			Address: fromAddress,
+		},
+		Address:  &address,
+		Data:     data,
+		GasLimit: contexts.GasLimit,
+	}, logger)
+}
+
+// Run a CallTx on an isolated and unpersisted state, a nil address will simulate contract creation
+func CallTxSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, options engine.Options, tx *payload.CallTx,
 	logger *logging.Logger) (*exec.TxExecution, error) {
 	cache := acmstate.NewCache(reader)
 	exe := contexts.CallContext{
-		VMS:           vms.NewConnectedVirtualMachines(engine.Options{}),
+		VMS:           vms.NewConnectedVirtualMachines(options),
 		RunCall:       true,
 		State:         cache,
 		MetadataState: acmstate.NewMemoryState(),
@@ -28,15 +41,7 @@ func CallSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress,
 		Logger:        logger,
 	}
 
-	txe := exec.NewTxExecution(txs.Enclose(blockchain.ChainID(),
-		&payload.CallTx{
-			Input: &payload.TxInput{
-				Address: fromAddress,
-			},
-			Address:  &address,
-			Data:     data,
-			GasLimit: contexts.GasLimit,
-		}))
+	txe := exec.NewTxExecution(txs.Enclose(blockchain.ChainID(), tx))
 
 	// Set height for downstream synchronisation purposes
 	txe.Height = blockchain.LastBlockHeight()
@@ -47,10 +52,61 @@ func CallSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress,
 	return txe, nil
 }
 
+// Find the lowest gas limit with which a CallTx executes without exception on an isolated and unpersisted state.
+// The tx's own GasLimit (or the default GasLimit if not set) is taken as the upper bound, if the tx fails with that
+// much gas then its exception is returned as the error.
+func EstimateGas(reader acmstate.Reader, blockchain bcm.BlockchainInfo, options engine.Options, tx *payload.CallTx,
+	logger *logging.Logger) (uint64, error) {
+	simulate := func(gasLimit uint64) (*exec.TxExecution, error) {
+		sim := *tx
+		sim.GasLimit = gasLimit
+		return CallTxSim(reader, blockchain, options, &sim, logger)
+	}
+
+	hi := tx.GasLimit
+	if hi == 0 {
+		hi = contexts.GasLimit
+	}
+	txe, err := simulate(hi)
+	if err != nil {
+		return 0, err
+	} else if txe.Exception != nil {
+		return 0, txe.Exception.AsError()
+	}
+
+	// Gas used is a lower bound but execution may require more gas to be available than it ends up using, for
+	// example when gas is forwarded to a nested call, so search (lo, hi] for the lowest limit that succeeds
+	used := txe.Result.GetGasUsed()
+	var lo uint64
+	if used > 0 && used < hi {
+		// Usually the gas used is enough so try that first
+		txe, err = simulate(used)
+		if err != nil {
+			return 0, err
+		} else if txe.Exception == nil {
+			return used, nil
+		}
+		lo = used
+	}
+	for lo+1 < hi {
+		mid := lo + (hi-lo)/2
+		txe, err = simulate(mid)
+		if err != nil {
+			return 0, err
+		}
+		if txe.Exception == nil {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi, nil
+}
+
 // Run the given code on an isolated and unpersisted state
 // Cannot be used to create new contracts.
-func CallCodeSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress, address crypto.Address, code, data []byte,
-	logger *logging.Logger) (*exec.TxExecution, error) {
+func CallCodeSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, options engine.Options, fromAddress,
+	address crypto.Address, code, data []byte, logger *logging.Logger) (*exec.TxExecution, error) {
 
 	// Attach code to target account (overwriting target)
 	cache := acmstate.NewCache(reader)
@@ -62,5 +118,5 @@ func CallCodeSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddr
 	if err != nil {
 		return nil, err
 	}
-	return CallSim(cache, blockchain, fromAddress, address, data, logger)
+	return CallSim(cache, blockchain, options, fromAddress, address, data, logger)
 }
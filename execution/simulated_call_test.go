@@ -11,6 +11,7 @@ import (
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/exec"
@@ -18,6 +19,7 @@ import (
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
 	"golang.org/x/sync/errgroup"
@@ -106,7 +108,7 @@ func TestCallSimDelegate(t *testing.T) {
 
 	for i := 0; i < n; i++ {
 		g.Go(func() error {
-			txe, err := CallSim(st, blockchain, from.GetAddress(), contractAddress, getIntCall, logger)
+			txe, err := CallSim(st, blockchain, engine.Options{}, from.GetAddress(), contractAddress, getIntCall, logger)
 			if err != nil {
 				return err
 			}
@@ -129,3 +131,68 @@ func TestCallSimDelegate(t *testing.T) {
 
 	require.NoError(t, g.Wait())
 }
+
+func TestEstimateGas(t *testing.T) {
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), genesisDoc)
+	require.NoError(t, err)
+
+	from := crypto.PrivateKeyFromSecret("raaah", crypto.CurveTypeEd25519)
+	contractAddress := crypto.Address{1, 2, 3, 4, 5}
+	blockchain := &bcm.Blockchain{}
+
+	_, _, err = st.Update(func(up state.Updatable) error {
+		err = up.UpdateAccount(&acm.Account{
+			Address:     from.GetAddress(),
+			PublicKey:   from.GetPublicKey(),
+			Balance:     9999999,
+			Permissions: permission.DefaultAccountPermissions,
+		})
+		if err != nil {
+			return err
+		}
+		return up.UpdateAccount(&acm.Account{
+			Address:     contractAddress,
+			EVMCode:     solidity.DeployedBytecode_Revert,
+			Permissions: permission.DefaultAccountPermissions,
+		})
+	})
+	require.NoError(t, err)
+
+	t.Run("Create", func(t *testing.T) {
+		tx := &payload.CallTx{
+			Input: &payload.TxInput{Address: from.GetAddress()},
+			Data:  solidity.Bytecode_Revert,
+		}
+		gas, err := EstimateGas(st, blockchain, engine.Options{}, tx, logger)
+		require.NoError(t, err)
+		require.NotZero(t, gas)
+
+		tx.GasLimit = gas
+		txe, err := CallTxSim(st, blockchain, engine.Options{}, tx, logger)
+		require.NoError(t, err)
+		require.Nil(t, txe.Exception)
+
+		tx.GasLimit = gas - 1
+		txe, err = CallTxSim(st, blockchain, engine.Options{}, tx, logger)
+		require.NoError(t, err)
+		require.Equal(t, errors.Codes.InsufficientGas, txe.Exception.ErrorCode())
+
+		tx.GasLimit = 0
+		ethereumGas, err := EstimateGas(st, blockchain, engine.Options{GasSchedule: engine.EthereumGasSchedule}, tx,
+			logger)
+		require.NoError(t, err)
+		require.Greater(t, ethereumGas, gas)
+	})
+
+	t.Run("Revert", func(t *testing.T) {
+		call, _, err := abi.EncodeFunctionCall(string(solidity.Abi_Revert), "RevertAt", logger, 2)
+		require.NoError(t, err)
+		_, err = EstimateGas(st, blockchain, engine.Options{}, &payload.CallTx{
+			Input:   &payload.TxInput{Address: from.GetAddress()},
+			Address: &contractAddress,
+			Data:    call,
+		}, logger)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "I have reverted")
+	})
+}
@@ -0,0 +1,73 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/storage"
+)
+
+// Merkle proofs of state against the state hash - the AppHash committed in the block following the state's height
+
+// Returns nil account if account does not exist with given address along with a proof of its absence
+func (s *ImmutableState) GetAccountWithProof(address crypto.Address) (*acm.Account, *storage.ForestProof, error) {
+	proof, err := s.Forest.GetWithProof(keys.Account.Prefix(), keys.Account.KeyNoPrefix(address))
+	if err != nil {
+		return nil, nil, err
+	}
+	account, err := decodeProvenAccount(proof)
+	if err != nil {
+		return nil, nil, err
+	}
+	return account, proof, nil
+}
+
+// Returns nil value if the storage key is unset along with a proof of its absence
+func (s *ImmutableState) GetStorageWithProof(address crypto.Address, key binary.Word256) ([]byte, *storage.ForestProof, error) {
+	keyFormat := keys.Storage.Fix(address)
+	proof, err := s.Forest.GetWithProof(keyFormat.Prefix(), keyFormat.KeyNoPrefix(key))
+	if err != nil {
+		return nil, nil, err
+	}
+	return proof.Value, proof, nil
+}
+
+// VerifyAccountProof checks that proof establishes the account at address in the state with hash, returning the
+// account or nil if the proof establishes its absence
+func VerifyAccountProof(hash []byte, address crypto.Address, proof *storage.ForestProof) (*acm.Account, error) {
+	proof.Prefix = keys.Account.Prefix()
+	proof.Key = keys.Account.KeyNoPrefix(address)
+	err := proof.Verify(hash)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify account %v: %w", address, err)
+	}
+	return decodeProvenAccount(proof)
+}
+
+// VerifyStorageProof checks that proof establishes the value of key in the storage of address in the state with
+// hash, returning the value or nil if the proof establishes that key is unset
+func VerifyStorageProof(hash []byte, address crypto.Address, key binary.Word256, proof *storage.ForestProof) ([]byte, error) {
+	keyFormat := keys.Storage.Fix(address)
+	proof.Prefix = keyFormat.Prefix()
+	proof.Key = keyFormat.KeyNoPrefix(key)
+	err := proof.Verify(hash)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify storage key %v of account %v: %w", key, address, err)
+	}
+	return proof.Value, nil
+}
+
+func decodeProvenAccount(proof *storage.ForestProof) (*acm.Account, error) {
+	if proof.Value == nil {
+		return nil, nil
+	}
+	account := new(acm.Account)
+	err := encoding.Decode(proof.Value, account)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode Account: %v", err)
+	}
+	return account, nil
+}
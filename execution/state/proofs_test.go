@@ -0,0 +1,57 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestState_Proofs(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	account := acm.NewAccountFromSecret("Foo")
+	account.Balance = 42
+	key := binary.LeftPadWord256([]byte{1})
+	value := binary.LeftPadWord256([]byte{0xff}).Bytes()
+	hash, _, err := s.Update(func(ws Updatable) error {
+		err := ws.UpdateAccount(account)
+		if err != nil {
+			return err
+		}
+		return ws.SetStorage(account.Address, key, value)
+	})
+	require.NoError(t, err)
+
+	st, err := s.AtLatestVersion()
+	require.NoError(t, err)
+
+	_, proof, err := st.GetAccountWithProof(account.Address)
+	require.NoError(t, err)
+	accountOut, err := VerifyAccountProof(hash, account.Address, proof)
+	require.NoError(t, err)
+	assert.Equal(t, account.Balance, accountOut.Balance)
+	_, err = VerifyAccountProof(hash, crypto.Address{1}, proof)
+	require.Error(t, err)
+
+	_, proof, err = st.GetAccountWithProof(crypto.Address{1})
+	require.NoError(t, err)
+	accountOut, err = VerifyAccountProof(hash, crypto.Address{1}, proof)
+	require.NoError(t, err)
+	assert.Nil(t, accountOut)
+
+	_, proof, err = st.GetStorageWithProof(account.Address, key)
+	require.NoError(t, err)
+	valueOut, err := VerifyStorageProof(hash, account.Address, key, proof)
+	require.NoError(t, err)
+	assert.Equal(t, value, valueOut)
+
+	_, proof, err = st.GetStorageWithProof(crypto.Address{1}, key)
+	require.NoError(t, err)
+	valueOut, err = VerifyStorageProof(hash, crypto.Address{1}, key, proof)
+	require.NoError(t, err)
+	assert.Nil(t, valueOut)
+}
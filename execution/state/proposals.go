@@ -9,9 +9,14 @@ import (
 )
 
 var _ proposal.IterableReader = &State{}
+var _ proposal.ExpiryIterable = &State{}
 
 func (s *ImmutableState) GetProposal(proposalHash []byte) (*payload.Ballot, error) {
-	tree, err := s.Forest.Reader(keys.Proposal.Prefix())
+	return getProposal(s.Forest, proposalHash)
+}
+
+func getProposal(forest storage.ForestReader, proposalHash []byte) (*payload.Ballot, error) {
+	tree, err := forest.Reader(keys.Proposal.Prefix())
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +36,7 @@ func (s *ImmutableState) GetProposal(proposalHash []byte) (*payload.Ballot, erro
 }
 
 func (ws *writeState) UpdateProposal(proposalHash []byte, p *payload.Ballot) error {
-	return ws.forest.Write(keys.Proposal.Prefix(), func(tree *storage.RWTree) error {
+	err := ws.forest.Write(keys.Proposal.Prefix(), func(tree *storage.RWTree) error {
 		bs, err := encoding.Encode(p)
 		if err != nil {
 			return err
@@ -40,13 +45,53 @@ func (ws *writeState) UpdateProposal(proposalHash []byte, p *payload.Ballot) err
 		tree.Set(keys.Proposal.KeyNoPrefix(proposalHash), bs)
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	return ws.indexProposalExpiry(proposalHash, p, p.ProposalState == payload.Ballot_PROPOSED)
 }
 
 func (ws *writeState) RemoveProposal(proposalHash []byte) error {
-	return ws.forest.Write(keys.Proposal.Prefix(), func(tree *storage.RWTree) error {
+	prev, err := getProposal(ws.forest, proposalHash)
+	if err != nil {
+		return err
+	}
+	err = ws.forest.Write(keys.Proposal.Prefix(), func(tree *storage.RWTree) error {
 		tree.Delete(keys.Proposal.KeyNoPrefix(proposalHash))
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	return ws.indexProposalExpiry(proposalHash, prev, false)
+}
+
+// Keeps the proposals that are open for votes indexed by their expiry height so that they can be expired without
+// scanning every ballot
+func (ws *writeState) indexProposalExpiry(proposalHash []byte, ballot *payload.Ballot, open bool) error {
+	if ballot == nil || ballot.Proposal == nil || ballot.Proposal.ExpiryHeight == 0 {
+		return nil
+	}
+	return ws.forest.Write(keys.ProposalExpiry.Prefix(), func(tree *storage.RWTree) error {
+		key := keys.ProposalExpiry.KeyNoPrefix(ballot.Proposal.ExpiryHeight, proposalHash)
+		if open {
+			// The tree holds on to the value so it must not share the caller's slice
+			tree.Set(key, append([]byte(nil), proposalHash...))
+		} else {
+			tree.Delete(key)
+		}
+		return nil
+	})
+}
+
+func (s *ImmutableState) IterateExpiredProposals(height uint64, consumer func(proposalHash []byte) error) error {
+	tree, err := s.Forest.Reader(keys.ProposalExpiry.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, keys.ProposalExpiry.KeyNoPrefix(height+1), true, func(_, value []byte) error {
+		return consumer(value)
+	})
 }
 
 func (s *ImmutableState) IterateProposals(consumer func(proposalHash []byte, proposal *payload.Ballot) error) error {
@@ -5,6 +5,7 @@ package state
 
 import (
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -42,15 +43,17 @@ var _ names.IterableReader = &State{}
 var _ Updatable = &writeState{}
 
 type KeyFormatStore struct {
-	Account   *storage.MustKeyFormat
-	Storage   *storage.MustKeyFormat
-	Name      *storage.MustKeyFormat
-	Proposal  *storage.MustKeyFormat
-	Validator *storage.MustKeyFormat
-	Event     *storage.MustKeyFormat
-	Registry  *storage.MustKeyFormat
-	TxHash    *storage.MustKeyFormat
-	Abi       *storage.MustKeyFormat
+	Account  *storage.MustKeyFormat
+	Storage  *storage.MustKeyFormat
+	Name     *storage.MustKeyFormat
+	Proposal *storage.MustKeyFormat
+	// Indexes the proposals open for votes by the height at which they expire
+	ProposalExpiry *storage.MustKeyFormat
+	Validator      *storage.MustKeyFormat
+	Event          *storage.MustKeyFormat
+	Registry       *storage.MustKeyFormat
+	TxHash         *storage.MustKeyFormat
+	Abi            *storage.MustKeyFormat
 }
 
 var keys = KeyFormatStore{
@@ -63,6 +66,8 @@ var keys = KeyFormatStore{
 	Name: storage.NewMustKeyFormat("n", storage.VariadicSegmentLength),
 	// ProposalHash -> Proposal
 	Proposal: storage.NewMustKeyFormat("p", sha256.Size),
+	// ExpiryHeight, ProposalHash -> ProposalHash
+	ProposalExpiry: storage.NewMustKeyFormat("x", uint64Length, sha256.Size),
 	// ValidatorAddress -> Power
 	Validator: storage.NewMustKeyFormat("v", crypto.AddressLength),
 	// Height -> StreamEvent
@@ -260,7 +265,12 @@ func (s *State) AtLatestVersion() (*ImmutableState, error) {
 
 // Return a concurrent-safe immutable read state at the given height
 func (s *State) AtHeight(height uint64) (*ImmutableState, error) {
-	return s.AtVersion(VersionAtHeight(height))
+	st, err := s.AtVersion(VersionAtHeight(height))
+	if errors.Is(err, storage.ErrVersionDoesNotExist) {
+		return nil, fmt.Errorf("state at height %d is not available, either the height has not been reached or "+
+			"the state has been pruned: %w", height, err)
+	}
+	return st, err
 }
 
 // Return a concurrent-safe immutable read state at the given version
@@ -15,11 +15,13 @@
 package state
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
@@ -40,3 +42,51 @@ func TestState_UpdateAccount(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, source.JSONString(account), source.JSONString(accountOut))
 }
+
+func TestState_IterateExpiredProposals(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	ballots := map[string]*payload.Ballot{
+		"a": {Proposal: &payload.Proposal{Name: "a", ExpiryHeight: 5}, ProposalState: payload.Ballot_PROPOSED},
+		"b": {Proposal: &payload.Proposal{Name: "b", ExpiryHeight: 7}, ProposalState: payload.Ballot_PROPOSED},
+		"c": {Proposal: &payload.Proposal{Name: "c"}, ProposalState: payload.Ballot_PROPOSED},
+		"d": {Proposal: &payload.Proposal{Name: "d", ExpiryHeight: 3}, ProposalState: payload.Ballot_EXECUTED},
+	}
+	hash := func(name string) []byte {
+		h := sha256.Sum256([]byte(name))
+		return h[:]
+	}
+	_, _, err := s.Update(func(ws Updatable) error {
+		for name, ballot := range ballots {
+			err := ws.UpdateProposal(hash(name), ballot)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	expired := func(height uint64) [][]byte {
+		var hashes [][]byte
+		require.NoError(t, s.IterateExpiredProposals(height, func(proposalHash []byte) error {
+			hashes = append(hashes, proposalHash)
+			return nil
+		}))
+		return hashes
+	}
+	assert.Empty(t, expired(4))
+	assert.Equal(t, [][]byte{hash("a")}, expired(5))
+	assert.Equal(t, [][]byte{hash("a"), hash("b")}, expired(100))
+
+	// Only proposals still open for votes are indexed
+	_, _, err = s.Update(func(ws Updatable) error {
+		ballots["a"].ProposalState = payload.Ballot_EXPIRED
+		err := ws.UpdateProposal(hash("a"), ballots["a"])
+		if err != nil {
+			return err
+		}
+		return ws.RemoveProposal(hash("b"))
+	})
+	require.NoError(t, err)
+	assert.Empty(t, expired(100))
+}
@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"math/big"
 
+	"github.com/go-interpreter/wagon/wasm"
 	"github.com/go-interpreter/wagon/wasm/leb128"
+	"github.com/perlin-network/life/compiler"
 	lifeExec "github.com/perlin-network/life/exec"
 	hex "github.com/tmthrgd/go-hex"
 
@@ -31,13 +34,28 @@ const Revert = 2
 
 const ValueByteSize = 16
 
+// The panics life raises when an instruction would take the gas used past its limit and when a module recurses
+// deeper than its fixed size call stack or its configured maximum depth
+const (
+	lifeGasLimitExceeded  = "gas limit exceeded"
+	lifeCallStackOverflow = "call stack overflow"
+	lifeMaxStackDepth     = "max call stack depth exceeded"
+)
+
 func (c *Contract) Call(state engine.State, params engine.CallParams) (output []byte, err error) {
 	return engine.Call(state, params, c.execute)
 }
 
-func (c *Contract) execute(state engine.State, params engine.CallParams) ([]byte, error) {
+func (c *Contract) execute(state engine.State, params engine.CallParams) (_ []byte, err error) {
 	const errHeader = "ewasm"
 
+	// Life only recovers panics while running the module but loading a malformed one or starting it can panic too
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf(errors.Codes.ExecutionAborted, "%s: %v", errHeader, r)
+		}
+	}()
+
 	// Since Life runs the execution for us we push the arguments into the import resolver state
 	ctx := &context{
 		Contract: c,
@@ -46,8 +64,15 @@ func (c *Contract) execute(state engine.State, params engine.CallParams) ([]byte
 		code:     c.code,
 	}
 
+	// Life would take a zero limit to mean no limit at all
+	if params.Gas.Sign() <= 0 {
+		return nil, errors.Codes.InsufficientGas
+	}
+	vmConfig := c.vm.vmConfig
+	vmConfig.GasLimit = lifeGasLimit(params.Gas)
+
 	// panics in ResolveFunc() will be recovered for us, no need for our own
-	vm, err := lifeExec.NewVirtualMachine(c.code[0:int(wasmSize(c.code))], c.vm.vmConfig, ctx, nil)
+	vm, err := lifeExec.NewVirtualMachine(c.code[0:int(wasmSize(c.code))], vmConfig, ctx, c.vm.gasPolicy)
 	if err != nil {
 		return nil, errors.Errorf(errors.Codes.InvalidContract, "%s: motherfucker %v", errHeader, err)
 	}
@@ -58,17 +83,80 @@ func (c *Contract) execute(state engine.State, params engine.CallParams) ([]byte
 	}
 
 	_, err = vm.Run(entryID)
-	if err != nil && errors.GetCode(err) == errors.Codes.ExecutionReverted {
-		return nil, err
+	gasErr := ctx.chargeGas(vm)
+	if gasErr != nil {
+		return nil, gasErr
+	}
+	if err != nil && err.Error() == lifeGasLimitExceeded {
+		return nil, errors.Errorf(errors.Codes.InsufficientGas, "%s: %v", errHeader, err)
+	}
+	if err != nil && (err.Error() == lifeCallStackOverflow || err.Error() == lifeMaxStackDepth) {
+		return nil, errors.Errorf(errors.Codes.CallStackOverflow, "%s: %v", errHeader, err)
 	}
 
-	if err != nil && errors.GetCode(err) != errors.Codes.None {
+	switch errors.GetCode(err) {
+	case errors.Codes.None:
+		// Either no error or the contract called finish
+	case errors.Codes.Generic:
+		// The interpreter trapped
 		return nil, errors.Errorf(errors.Codes.ExecutionAborted, "%s: %v", errHeader, err)
+	default:
+		// Including the coded errors raised by host functions such as memory out of bounds
+		return nil, err
 	}
 
 	return ctx.output, nil
 }
 
+// ValidateCode checks that code is a WASM module within the configured limits that imports only the functions provided
+// by the host so that a contract that could never run is rejected when it is deployed rather than when it first
+// calls the missing import or allocates its memory
+func (vm *WVM) ValidateCode(code []byte) (err error) {
+	const errHeader = "ewasm"
+	// Wagon may panic on malformed modules
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf(errors.Codes.InvalidContractCode, "%s: could not load module: %v", errHeader, r)
+		}
+	}()
+
+	code = code[0:int(wasmSize(code))]
+	if vm.options.WASM.MaxModuleSize > 0 && uint64(len(code)) > vm.options.WASM.MaxModuleSize {
+		return errors.Errorf(errors.Codes.InvalidContractCode, "%s: module of %d bytes exceeds the maximum of %d",
+			errHeader, len(code), vm.options.WASM.MaxModuleSize)
+	}
+	m, err := compiler.LoadModule(code)
+	if err != nil {
+		return errors.Errorf(errors.Codes.InvalidContractCode, "%s: could not load module: %v", errHeader, err)
+	}
+	if m.Base.Memory != nil {
+		for _, mem := range m.Base.Memory.Entries {
+			if vm.vmConfig.MaxMemoryPages > 0 && int(mem.Limits.Initial) > vm.vmConfig.MaxMemoryPages {
+				return errors.Errorf(errors.Codes.InvalidContractCode,
+					"%s: module declares %d pages of memory but at most %d are allowed", errHeader,
+					mem.Limits.Initial, vm.vmConfig.MaxMemoryPages)
+			}
+		}
+	}
+	if m.Base.Import == nil {
+		return nil
+	}
+	ctx := new(context)
+	for _, imp := range m.Base.Import.Entries {
+		switch imp.Type.Kind() {
+		case wasm.ExternalFunction:
+			if ctx.resolveHostFunc(imp.ModuleName, imp.FieldName) == nil {
+				return errors.Errorf(errors.Codes.UnresolvedSymbols, "%s: unknown import %s.%s", errHeader,
+					imp.ModuleName, imp.FieldName)
+			}
+		case wasm.ExternalGlobal:
+			return errors.Errorf(errors.Codes.UnresolvedSymbols, "%s: unknown global import %s.%s", errHeader,
+				imp.ModuleName, imp.FieldName)
+		}
+	}
+	return nil
+}
+
 type context struct {
 	*Contract
 	state      engine.State
@@ -77,6 +165,8 @@ type context struct {
 	output     []byte
 	returnData []byte
 	sequence   uint64
+	// The gas used by the interpreter that has already been charged to params.Gas
+	gasCharged uint64
 }
 
 var _ lifeExec.ImportResolver = (*context)(nil)
@@ -85,7 +175,40 @@ func (ctx *context) ResolveGlobal(module, field string) int64 {
 	panic(fmt.Sprintf("global %s module %s not found", field, module))
 }
 
+// Charges the call for the instructions executed since the last charge
+func (ctx *context) chargeGas(vm *lifeExec.VirtualMachine) error {
+	err := engine.UseGasNegative(ctx.params.Gas, vm.Gas-ctx.gasCharged)
+	ctx.gasCharged = vm.Gas
+	return err
+}
+
+// Host functions may use gas (for example by calling other contracts) so the interpreter's limit must be reset to
+// whatever remains afterwards
+func (ctx *context) limitGas(vm *lifeExec.VirtualMachine) {
+	remaining := lifeGasLimit(ctx.params.Gas)
+	if remaining > math.MaxUint64-vm.Gas {
+		remaining = math.MaxUint64 - vm.Gas
+	}
+	vm.Config.GasLimit = vm.Gas + remaining
+}
+
 func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
+	hostFunc := ctx.resolveHostFunc(module, field)
+	if hostFunc == nil {
+		panic(errors.Errorf(errors.Codes.UnresolvedSymbols, "unknown import %s.%s", module, field))
+	}
+	return func(vm *lifeExec.VirtualMachine) int64 {
+		err := ctx.chargeGas(vm)
+		if err != nil {
+			panic(err)
+		}
+		defer ctx.limitGas(vm)
+		return hostFunc(vm)
+	}
+}
+
+// Returns the host function for an import or nil if there is no such function
+func (ctx *context) resolveHostFunc(module, field string) lifeExec.FunctionImport {
 	if module == "debug" {
 		// See https://github.com/ewasm/hera#interfaces
 		switch field {
@@ -127,10 +250,10 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 		case "printMem":
 			return func(vm *lifeExec.VirtualMachine) int64 {
-				dataPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
-				dataLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				dataPtr := uint32(vm.GetCurrentFrame().Locals[0])
+				dataLen := uint32(vm.GetCurrentFrame().Locals[1])
 
-				s := vm.Memory[dataPtr : dataPtr+dataLen]
+				s := memory(vm, dataPtr, dataLen)
 
 				err := ctx.state.EventSink.Print(&exec.PrintEvent{
 					Address: ctx.params.Callee,
@@ -146,10 +269,10 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 		case "printMemHex":
 			return func(vm *lifeExec.VirtualMachine) int64 {
-				dataPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
-				dataLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				dataPtr := uint32(vm.GetCurrentFrame().Locals[0])
+				dataLen := uint32(vm.GetCurrentFrame().Locals[1])
 
-				s := hex.EncodeToString(vm.Memory[dataPtr : dataPtr+dataLen])
+				s := hex.EncodeToString(memory(vm, dataPtr, dataLen))
 
 				err := ctx.state.EventSink.Print(&exec.PrintEvent{
 					Address: ctx.params.Callee,
@@ -165,11 +288,11 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 		case "printStorage":
 			return func(vm *lifeExec.VirtualMachine) int64 {
-				keyPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				keyPtr := uint32(vm.GetCurrentFrame().Locals[0])
 
 				key := bin.Word256{}
 
-				copy(key[:], vm.Memory[keyPtr:keyPtr+32])
+				copy(key[:], memory(vm, keyPtr, bin.Word256Bytes))
 
 				val, err := ctx.state.GetStorage(ctx.params.Callee, key)
 				if err != nil {
@@ -190,11 +313,11 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 		case "printStorageHex":
 			return func(vm *lifeExec.VirtualMachine) int64 {
-				keyPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				keyPtr := uint32(vm.GetCurrentFrame().Locals[0])
 
 				key := bin.Word256{}
 
-				copy(key[:], vm.Memory[keyPtr:keyPtr+32])
+				copy(key[:], memory(vm, keyPtr, bin.Word256Bytes))
 
 				val, err := ctx.state.GetStorage(ctx.params.Callee, key)
 				if err != nil {
@@ -216,27 +339,30 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 			}
 
 		default:
-			panic(fmt.Sprintf("function %s unknown for debug module", field))
+			return nil
 		}
 	}
 
 	if module != "ethereum" {
-		panic(fmt.Sprintf("unknown module %s", module))
+		return nil
 	}
 
 	switch field {
 	case "create":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			valuePtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+			valuePtr := uint32(vm.GetCurrentFrame().Locals[0])
 			dataPtr := uint32(vm.GetCurrentFrame().Locals[1])
 			dataLen := uint32(vm.GetCurrentFrame().Locals[2])
 			resultPtr := uint32(vm.GetCurrentFrame().Locals[3])
 
-			// TODO: is this guaranteed to be okay? Should be avoid panic here if out of bounds?
-			value := bin.BigIntFromLittleEndianBytes(vm.Memory[valuePtr : valuePtr+ValueByteSize])
+			value := bin.BigIntFromLittleEndianBytes(memory(vm, valuePtr, ValueByteSize))
+			code := memory(vm, dataPtr, dataLen)
+			result := memory(vm, resultPtr, crypto.AddressLength)
 
-			var data []byte
-			copy(data, vm.Memory[dataPtr:dataPtr+dataLen])
+			err := ctx.vm.ValidateCode(code)
+			if err != nil {
+				return Error
+			}
 
 			ctx.sequence++
 			nonce := make([]byte, txs.HashLength+8)
@@ -244,7 +370,7 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 			binary.BigEndian.PutUint64(nonce[txs.HashLength:], ctx.sequence)
 			newAccountAddress := crypto.NewContractAddress(ctx.params.Callee, nonce)
 
-			err := engine.EnsurePermission(ctx.state.CallFrame, ctx.params.Callee, permission.CreateContract)
+			err = engine.EnsurePermission(ctx.state.CallFrame, ctx.params.Callee, permission.CreateContract)
 			if err != nil {
 				return Error
 			}
@@ -254,7 +380,7 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 				return Error
 			}
 
-			res, err := ctx.vm.Contract(vm.Memory[dataPtr:dataPtr+dataLen]).Call(ctx.state, engine.CallParams{
+			res, err := ctx.vm.Contract(code).Call(ctx.state, engine.CallParams{
 				Caller: ctx.params.Caller,
 				Callee: newAccountAddress,
 				Input:  nil,
@@ -268,6 +394,10 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 				}
 				panic(err)
 			}
+			err = ctx.vm.ValidateCode(res)
+			if err != nil {
+				return Error
+			}
 			err = engine.InitWASMCode(ctx.state, newAccountAddress, res)
 			if err != nil {
 				if errors.GetCode(err) == errors.Codes.ExecutionReverted {
@@ -276,35 +406,35 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 				panic(err)
 			}
 
-			copy(vm.Memory[resultPtr:], newAccountAddress.Bytes())
+			copy(result, newAccountAddress.Bytes())
 
 			return Success
 		}
 
 	case "getBlockDifficulty":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			resultPtr := int(vm.GetCurrentFrame().Locals[0])
+			resultPtr := uint32(vm.GetCurrentFrame().Locals[0])
 
 			// set it to 1
-			copy(vm.Memory[resultPtr:resultPtr+32], bin.RightPadBytes([]byte{1}, 32))
+			copy(memory(vm, resultPtr, 32), bin.RightPadBytes([]byte{1}, 32))
 			return Success
 		}
 
 	case "getTxGasPrice":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			resultPtr := int(vm.GetCurrentFrame().Locals[0])
+			resultPtr := uint32(vm.GetCurrentFrame().Locals[0])
 
 			// set it to 1
-			copy(vm.Memory[resultPtr:resultPtr+16], bin.RightPadBytes([]byte{1}, 16))
+			copy(memory(vm, resultPtr, 16), bin.RightPadBytes([]byte{1}, 16))
 			return Success
 		}
 
 	case "selfDestruct":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			receiverPtr := int(vm.GetCurrentFrame().Locals[0])
+			receiverPtr := uint32(vm.GetCurrentFrame().Locals[0])
 
 			var receiver crypto.Address
-			copy(receiver[:], vm.Memory[receiverPtr:receiverPtr+crypto.AddressLength])
+			copy(receiver[:], memory(vm, receiverPtr, crypto.AddressLength))
 
 			receiverAcc, err := ctx.state.GetAccount(receiver)
 			if err != nil {
@@ -320,6 +450,9 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 			if err != nil {
 				panic(err)
 			}
+			if acc == nil {
+				panic(errors.Errorf(errors.Codes.NonExistentAccount, "account %v does not exist", ctx.params.Callee))
+			}
 			balance := acc.Balance
 			err = acc.AddToBalance(balance)
 			if err != nil {
@@ -339,22 +472,20 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 	case "call", "callCode", "callDelegate", "callStatic":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			gasLimit := big.NewInt(vm.GetCurrentFrame().Locals[0])
+			gasLimit := new(big.Int).SetUint64(uint64(vm.GetCurrentFrame().Locals[0]))
 			addressPtr := uint32(vm.GetCurrentFrame().Locals[1])
 			i := 2
-			var valuePtr int
+			value := new(big.Int)
 			if field == "call" || field == "callCode" {
-				valuePtr = int(uint32(vm.GetCurrentFrame().Locals[i]))
+				valuePtr := uint32(vm.GetCurrentFrame().Locals[i])
+				value = bin.BigIntFromLittleEndianBytes(memory(vm, valuePtr, ValueByteSize))
 				i++
 			}
 			dataPtr := uint32(vm.GetCurrentFrame().Locals[i])
 			dataLen := uint32(vm.GetCurrentFrame().Locals[i+1])
 
-			// TODO: avoid panic? Or at least panic with coded out-of-bounds
-			target := crypto.MustAddressFromBytes(vm.Memory[addressPtr : addressPtr+crypto.AddressLength])
-
-			// TODO: is this guaranteed to be okay? Should be avoid panic here if out of bounds?
-			value := bin.BigIntFromLittleEndianBytes(vm.Memory[valuePtr : valuePtr+ValueByteSize])
+			var target crypto.Address
+			copy(target[:], memory(vm, addressPtr, crypto.AddressLength))
 
 			var callType exec.CallType
 
@@ -365,7 +496,7 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 				callType = exec.CallTypeCode
 			case "callStatic":
 				callType = exec.CallTypeStatic
-			case "callDeletegate":
+			case "callDelegate":
 				callType = exec.CallTypeDelegate
 			default:
 				panic("should not happen")
@@ -376,7 +507,7 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 				engine.CallParams{
 					CallType: callType,
 					Callee:   target,
-					Input:    vm.Memory[dataPtr : dataPtr+dataLen],
+					Input:    memory(vm, dataPtr, dataLen),
 					Value:    *value,
 					Gas:      gasLimit,
 				})
@@ -406,12 +537,12 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 	case "callDataCopy":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			destPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
-			dataOffset := int(uint32(vm.GetCurrentFrame().Locals[1]))
-			dataLen := int(uint32(vm.GetCurrentFrame().Locals[2]))
+			destPtr := uint32(vm.GetCurrentFrame().Locals[0])
+			dataOffset := uint32(vm.GetCurrentFrame().Locals[1])
+			dataLen := uint32(vm.GetCurrentFrame().Locals[2])
 
 			if dataLen > 0 {
-				copy(vm.Memory[destPtr:], ctx.params.Input[dataOffset:dataOffset+dataLen])
+				copy(memory(vm, destPtr, dataLen), subslice(ctx.params.Input, dataOffset, dataLen, errors.Codes.InputOutOfBounds))
 			}
 
 			return Success
@@ -424,12 +555,12 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 	case "returnDataCopy":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			destPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
-			dataOffset := int(uint32(vm.GetCurrentFrame().Locals[1]))
-			dataLen := int(uint32(vm.GetCurrentFrame().Locals[2]))
+			destPtr := uint32(vm.GetCurrentFrame().Locals[0])
+			dataOffset := uint32(vm.GetCurrentFrame().Locals[1])
+			dataLen := uint32(vm.GetCurrentFrame().Locals[2])
 
 			if dataLen > 0 {
-				copy(vm.Memory[destPtr:], ctx.returnData[dataOffset:dataOffset+dataLen])
+				copy(memory(vm, destPtr, dataLen), subslice(ctx.returnData, dataOffset, dataLen, errors.Codes.ReturnDataOutOfBounds))
 			}
 
 			return Success
@@ -442,12 +573,12 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 	case "codeCopy":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			destPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
-			dataOffset := int(uint32(vm.GetCurrentFrame().Locals[1]))
-			dataLen := int(uint32(vm.GetCurrentFrame().Locals[2]))
+			destPtr := uint32(vm.GetCurrentFrame().Locals[0])
+			dataOffset := uint32(vm.GetCurrentFrame().Locals[1])
+			dataLen := uint32(vm.GetCurrentFrame().Locals[2])
 
 			if dataLen > 0 {
-				copy(vm.Memory[destPtr:], ctx.code[dataOffset:dataOffset+dataLen])
+				copy(memory(vm, destPtr, dataLen), subslice(ctx.code, dataOffset, dataLen, errors.Codes.CodeOutOfBounds))
 			}
 
 			return Success
@@ -455,14 +586,14 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 	case "storageStore":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			keyPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
-			dataPtr := int(uint32(vm.GetCurrentFrame().Locals[1]))
+			keyPtr := uint32(vm.GetCurrentFrame().Locals[0])
+			dataPtr := uint32(vm.GetCurrentFrame().Locals[1])
 
 			key := bin.Word256{}
 			value := make([]byte, 32)
 
-			copy(key[:], vm.Memory[keyPtr:keyPtr+32])
-			copy(value, vm.Memory[dataPtr:dataPtr+32])
+			copy(key[:], memory(vm, keyPtr, bin.Word256Bytes))
+			copy(value, memory(vm, dataPtr, bin.Word256Bytes))
 
 			err := ctx.state.SetStorage(ctx.params.Callee, key, value)
 			if err != nil {
@@ -474,28 +605,28 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 	case "storageLoad":
 		return func(vm *lifeExec.VirtualMachine) int64 {
 
-			keyPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
-			dataPtr := int(uint32(vm.GetCurrentFrame().Locals[1]))
+			keyPtr := uint32(vm.GetCurrentFrame().Locals[0])
+			dataPtr := uint32(vm.GetCurrentFrame().Locals[1])
 
 			key := bin.Word256{}
 
-			copy(key[:], vm.Memory[keyPtr:keyPtr+32])
+			copy(key[:], memory(vm, keyPtr, bin.Word256Bytes))
 
 			val, err := ctx.state.GetStorage(ctx.params.Callee, key)
 			if err != nil {
 				panic(err)
 			}
-			copy(vm.Memory[dataPtr:], val)
+			copy(memory(vm, dataPtr, uint32(len(val))), val)
 
 			return Success
 		}
 
 	case "finish":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			dataPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
-			dataLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+			dataPtr := uint32(vm.GetCurrentFrame().Locals[0])
+			dataLen := uint32(vm.GetCurrentFrame().Locals[1])
 
-			ctx.output = vm.Memory[dataPtr : dataPtr+dataLen]
+			ctx.output = memory(vm, dataPtr, dataLen)
 
 			panic(errors.Codes.None)
 		}
@@ -503,51 +634,53 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 	case "revert":
 		return func(vm *lifeExec.VirtualMachine) int64 {
 
-			dataPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
-			dataLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+			dataPtr := uint32(vm.GetCurrentFrame().Locals[0])
+			dataLen := uint32(vm.GetCurrentFrame().Locals[1])
 
-			ctx.output = vm.Memory[dataPtr : dataPtr+dataLen]
+			ctx.output = memory(vm, dataPtr, dataLen)
 
 			panic(errors.Codes.ExecutionReverted)
 		}
 
 	case "getAddress":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			addressPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+			addressPtr := uint32(vm.GetCurrentFrame().Locals[0])
 
-			copy(vm.Memory[addressPtr:], ctx.params.Callee.Bytes())
+			copy(memory(vm, addressPtr, crypto.AddressLength), ctx.params.Callee.Bytes())
 
 			return Success
 		}
 
 	case "getCallValue":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			valuePtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+			valuePtr := uint32(vm.GetCurrentFrame().Locals[0])
 
 			// ewasm value is little endian 128 bit value
-			copy(vm.Memory[valuePtr:], bin.BigIntToLittleEndianBytes(&ctx.params.Value))
+			copy(memory(vm, valuePtr, ValueByteSize), bin.BigIntToLittleEndianBytes(&ctx.params.Value))
 
 			return Success
 		}
 
 	case "getExternalBalance":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			addressPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
-			balancePtr := int(uint32(vm.GetCurrentFrame().Locals[1]))
+			addressPtr := uint32(vm.GetCurrentFrame().Locals[0])
+			balancePtr := uint32(vm.GetCurrentFrame().Locals[1])
 
 			address := crypto.Address{}
 
-			copy(address[:], vm.Memory[addressPtr:addressPtr+crypto.AddressLength])
+			copy(address[:], memory(vm, addressPtr, crypto.AddressLength))
 			acc, err := ctx.state.GetAccount(address)
 			if err != nil {
 				panic(errors.Codes.InvalidAddress)
 			}
 
 			// ewasm value is little endian 128 bit value
-			bs := make([]byte, 16)
-			binary.LittleEndian.PutUint64(bs, acc.Balance)
+			bs := make([]byte, ValueByteSize)
+			if acc != nil {
+				binary.LittleEndian.PutUint64(bs, acc.Balance)
+			}
 
-			copy(vm.Memory[balancePtr:], bs)
+			copy(memory(vm, balancePtr, ValueByteSize), bs)
 
 			return Success
 		}
@@ -564,18 +697,18 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 	case "getTxOrigin":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			addressPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+			addressPtr := uint32(vm.GetCurrentFrame().Locals[0])
 
-			copy(vm.Memory[addressPtr:addressPtr+crypto.AddressLength], ctx.params.Origin.Bytes())
+			copy(memory(vm, addressPtr, crypto.AddressLength), ctx.params.Origin.Bytes())
 
 			return Success
 		}
 
 	case "getCaller":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			addressPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+			addressPtr := uint32(vm.GetCurrentFrame().Locals[0])
 
-			copy(vm.Memory[addressPtr:addressPtr+crypto.AddressLength], ctx.params.Caller.Bytes())
+			copy(memory(vm, addressPtr, crypto.AddressLength), ctx.params.Caller.Bytes())
 
 			return Success
 		}
@@ -594,9 +727,9 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 	case "getBlockCoinbase":
 		return func(vm *lifeExec.VirtualMachine) int64 {
 			// do the same as EVM
-			addressPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+			addressPtr := uint32(vm.GetCurrentFrame().Locals[0])
 
-			copy(vm.Memory[addressPtr:addressPtr+crypto.AddressLength], crypto.ZeroAddress.Bytes())
+			copy(memory(vm, addressPtr, crypto.AddressLength), crypto.ZeroAddress.Bytes())
 
 			return Success
 		}
@@ -604,21 +737,23 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 	case "getBlockHash":
 		return func(vm *lifeExec.VirtualMachine) int64 {
 			blockNumber := uint64(vm.GetCurrentFrame().Locals[0])
-			hashPtr := int(vm.GetCurrentFrame().Locals[1])
+			hashPtr := uint32(vm.GetCurrentFrame().Locals[1])
 
 			lastBlockHeight := ctx.state.Blockchain.LastBlockHeight()
 			if blockNumber >= lastBlockHeight {
-				panic(fmt.Sprintf(" => attempted to get block hash of a non-existent block: %v", blockNumber))
+				panic(errors.Errorf(errors.Codes.InvalidBlockNumber,
+					" => attempted to get block hash of a non-existent block: %v", blockNumber))
 			} else if lastBlockHeight-blockNumber > evm.MaximumAllowedBlockLookBack {
-				panic(fmt.Sprintf(" => attempted to get block hash of a block %d outside of the allowed range "+
-					"(must be within %d blocks)", blockNumber, evm.MaximumAllowedBlockLookBack))
+				panic(errors.Errorf(errors.Codes.BlockNumberOutOfRange,
+					" => attempted to get block hash of a block %d outside of the allowed range "+
+						"(must be within %d blocks)", blockNumber, evm.MaximumAllowedBlockLookBack))
 			} else {
 				hash, err := ctx.state.Blockchain.BlockHash(blockNumber)
 				if err != nil {
 					panic(fmt.Sprintf(" => blockhash failed: %v", err))
 				}
 
-				copy(vm.Memory[hashPtr:hashPtr+len(hash)], hash)
+				copy(memory(vm, hashPtr, uint32(len(hash))), hash)
 			}
 
 			return Success
@@ -626,21 +761,20 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 	case "log":
 		return func(vm *lifeExec.VirtualMachine) int64 {
-			dataPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
-			dataLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+			dataPtr := uint32(vm.GetCurrentFrame().Locals[0])
+			dataLen := uint32(vm.GetCurrentFrame().Locals[1])
 
-			data := vm.Memory[dataPtr : dataPtr+dataLen]
+			data := memory(vm, dataPtr, dataLen)
 
 			topicCount := uint32(vm.GetCurrentFrame().Locals[2])
-			topics := make([]bin.Word256, topicCount)
-
 			if topicCount > 4 {
-				panic(fmt.Sprintf("%d topics not permitted", topicCount))
+				panic(errors.Errorf(errors.Codes.ExecutionAborted, "%d topics not permitted", topicCount))
 			}
+			topics := make([]bin.Word256, topicCount)
 
 			for i := uint32(0); i < topicCount; i++ {
-				topicPtr := int(uint32(vm.GetCurrentFrame().Locals[3+i]))
-				topicData := vm.Memory[topicPtr : topicPtr+bin.Word256Bytes]
+				topicPtr := uint32(vm.GetCurrentFrame().Locals[3+i])
+				topicData := memory(vm, topicPtr, bin.Word256Bytes)
 				topics[i] = bin.RightPadWord256(topicData)
 			}
 
@@ -658,10 +792,24 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 		}
 
 	default:
-		panic(fmt.Sprintf("unknown function %s", field))
+		return nil
 	}
 }
 
+// Returns the length bytes of the module's memory from ptr, raising a coded trap rather than a runtime panic when they
+// lie outside of it
+func memory(vm *lifeExec.VirtualMachine, ptr, length uint32) []byte {
+	return subslice(vm.Memory, ptr, length, errors.Codes.MemoryOutOfBounds)
+}
+
+func subslice(data []byte, offset, length uint32, code *errors.Code) []byte {
+	end := uint64(offset) + uint64(length)
+	if end > uint64(len(data)) {
+		panic(errors.Errorf(code, "cannot access %d bytes at offset %d of %d", length, offset, len(data)))
+	}
+	return data[offset:end]
+}
+
 // When deploying wasm code, the abi encoded arguments to the constructor are added to the code. Wagon
 // does not like seeing this data, so strip this off. We have to walk the wasm format to the last section
 
@@ -669,6 +817,10 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 func wasmSize(code []byte) int64 {
 	reader := bytes.NewReader(code)
 	top := int64(8)
+	if int64(len(code)) < top {
+		// Too short to even hold the header
+		return int64(len(code))
+	}
 	for {
 		reader.Seek(top, 0)
 		id, err := reader.ReadByte()
@@ -1,6 +1,9 @@
 package wasm
 
 import (
+	"math"
+	"math/big"
+
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/execution/defaults"
@@ -8,6 +11,7 @@ import (
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/native"
+	"github.com/perlin-network/life/compiler"
 	lifeExec "github.com/perlin-network/life/exec"
 )
 
@@ -19,10 +23,14 @@ var DefaultVMConfig = lifeExec.VMConfig{
 	DefaultMemoryPages:   16,
 }
 
+// Life's call stack has a fixed size so modules can never call deeper than this
+const MaxStackDepth = lifeExec.DefaultCallStackSize
+
 type WVM struct {
 	engine.Externals
 	options            engine.Options
 	vmConfig           lifeExec.VMConfig
+	gasPolicy          compiler.GasPolicy
 	externalDispatcher engine.Dispatcher
 }
 
@@ -31,6 +39,19 @@ func New(options engine.Options) *WVM {
 		options:  defaults.CompleteOptions(options),
 		vmConfig: DefaultVMConfig,
 	}
+	if options.WASM.MaxMemoryPages > 0 {
+		vm.vmConfig.MaxMemoryPages = lifeLimit(options.WASM.MaxMemoryPages)
+	}
+	if options.WASM.DefaultMemoryPages > 0 {
+		vm.vmConfig.DefaultMemoryPages = lifeLimit(options.WASM.DefaultMemoryPages)
+	}
+	if options.WASM.StackDepth > 0 {
+		vm.vmConfig.MaxCallStackDepth = lifeLimit(options.WASM.StackDepth)
+	}
+	if vm.options.WASM.CallStackMaxDepth == 0 {
+		vm.options.WASM.CallStackMaxDepth = vm.options.CallStackMaxDepth
+	}
+	vm.gasPolicy = gasPolicy{vm.options.GasSchedule}
 	vm.externalDispatcher = engine.Dispatchers{&vm.Externals, options.Natives, vm}
 	return vm
 }
@@ -51,7 +72,9 @@ func (vm *WVM) Execute(st acmstate.ReaderWriter, blockchain engine.Blockchain, e
 	st = native.NewState(vm.options.Natives, st)
 
 	state := engine.State{
-		CallFrame:  engine.NewCallFrame(st).WithMaxCallStackDepth(vm.options.CallStackMaxDepth),
+		CallFrame: engine.NewCallFrame(st).
+			WithMaxCallStackDepth(vm.options.WASM.CallStackMaxDepth).
+			WithGasSchedule(vm.options.GasSchedule),
 		Blockchain: blockchain,
 		EventSink:  eventSink,
 	}
@@ -79,3 +102,31 @@ func (vm *WVM) Contract(code []byte) *Contract {
 		code: code,
 	}
 }
+
+// Life compiles the cost of each basic block into the module and meters it as it executes
+type gasPolicy struct {
+	schedule *engine.GasSchedule
+}
+
+func (gp gasPolicy) GetCost(compiler.Instr) int64 {
+	if gp.schedule.WASMInstruction > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int64(gp.schedule.WASMInstruction)
+}
+
+// Life takes its limits as ints where zero means no limit
+func lifeLimit(limit uint64) int {
+	if limit > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int(limit)
+}
+
+// Life's gas limit is a uint64 where zero means no limit
+func lifeGasLimit(gas *big.Int) uint64 {
+	if !gas.IsUint64() {
+		return math.MaxUint64
+	}
+	return gas.Uint64()
+}
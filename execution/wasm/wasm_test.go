@@ -1,9 +1,11 @@
 package wasm
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"math/rand"
 	"testing"
 
 	"github.com/hyperledger/burrow/execution/native"
@@ -13,8 +15,10 @@ import (
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 
+	"github.com/go-interpreter/wagon/wasm/leb128"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/stretchr/testify/require"
 )
@@ -28,7 +32,7 @@ func TestStaticCallWithValue(t *testing.T) {
 		Callee: crypto.ZeroAddress,
 		Input:  []byte{},
 		Value:  *big.NewInt(0),
-		Gas:    big.NewInt(1000),
+		Gas:    big.NewInt(1000000),
 	}
 
 	vm := Default()
@@ -93,7 +97,7 @@ func TestCREATE(t *testing.T) {
 		Callee: crypto.ZeroAddress,
 		Input:  []byte{},
 		Value:  *big.NewInt(0),
-		Gas:    big.NewInt(1000),
+		Gas:    big.NewInt(1000000),
 	}
 
 	vm := New(engine.Options{Natives: native.MustDefaultNatives()})
@@ -145,7 +149,7 @@ func TestSelfDestruct(t *testing.T) {
 		Callee: crypto.ZeroAddress,
 		Input:  []byte{},
 		Value:  *big.NewInt(0),
-		Gas:    big.NewInt(1000),
+		Gas:    big.NewInt(1000000),
 	}
 
 	vm := New(engine.Options{Natives: native.MustDefaultNatives()})
@@ -179,7 +183,7 @@ func TestMisc(t *testing.T) {
 		Callee: crypto.ZeroAddress,
 		Input:  []byte{},
 		Value:  *big.NewInt(0),
-		Gas:    big.NewInt(1000),
+		Gas:    big.NewInt(1000000),
 	}
 
 	vm := New(engine.Options{Natives: native.MustDefaultNatives()})
@@ -213,6 +217,325 @@ func TestMisc(t *testing.T) {
 	require.Equal(t, "0000000000000000000000000000000000000000000000000000000000000001", hex.EncodeToString(res))
 }
 
+// (module (func (export "main") (loop (br 0))))
+var infiniteLoop = wasmModule(0x03, 0x40, 0x0c, 0x00, 0x0b)
+
+// (module (func $main (export "main") (call $main)))
+var infiniteRecursion = wasmModule(0x10, 0x00)
+
+// Assembles a module exporting a single function main of type [] -> [] with the given body
+func wasmModule(body ...byte) []byte {
+	return assembleModule(nil, nil, body)
+}
+
+// Assembles a module with one page of memory that exports main, which calls the host function module.field with args
+func hostCallModule(module, field string, args ...int32) []byte {
+	var body []byte
+	for _, arg := range args {
+		body = append(body, 0x41) // i32.const
+		body = append(body, sleb128(int64(arg))...)
+	}
+	body = append(body, 0x10, 0x00) // call the import
+	imp := append(append(wasmName(module), wasmName(field)...), 0x00, 0x01)
+	// Takes i32 args and returns nothing
+	importType := append(append([]byte{0x60}, uleb128(uint32(len(args)))...), bytes.Repeat([]byte{0x7f}, len(args))...)
+	return assembleModule(imp, append(importType, 0x00), body)
+}
+
+// Assembles a module where main has type 0 and, if imp is not nil, imports a function of type 1 along with one page
+// of memory
+func assembleModule(imp, importType, body []byte) []byte {
+	types := [][]byte{{0x60, 0x00, 0x00}}
+	mainIndex := byte(0)
+	code := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00} // magic and version
+	if imp != nil {
+		types = append(types, importType)
+		mainIndex = 1
+	}
+	code = append(code, wasmSection(0x01, types...)...)
+	if imp != nil {
+		code = append(code, wasmSection(0x02, imp)...)
+	}
+	code = append(code, wasmSection(0x03, []byte{0x00})...)
+	if imp != nil {
+		code = append(code, wasmSection(0x05, []byte{0x00, 0x01})...)
+	}
+	code = append(code, wasmSection(0x07, append(wasmName("main"), 0x00, mainIndex))...)
+	// One function body with no locals
+	body = append(append([]byte{0x00}, body...), 0x0b)
+	return append(code, wasmSection(0x0a, append(uleb128(uint32(len(body))), body...))...)
+}
+
+func wasmSection(id byte, entries ...[]byte) []byte {
+	contents := uleb128(uint32(len(entries)))
+	for _, entry := range entries {
+		contents = append(contents, entry...)
+	}
+	return append(append([]byte{id}, uleb128(uint32(len(contents)))...), contents...)
+}
+
+func wasmName(name string) []byte {
+	return append(uleb128(uint32(len(name))), name...)
+}
+
+func uleb128(n uint32) []byte {
+	buf := new(bytes.Buffer)
+	_, _ = leb128.WriteVarUint32(buf, n)
+	return buf.Bytes()
+}
+
+func sleb128(n int64) []byte {
+	buf := new(bytes.Buffer)
+	_, _ = leb128.WriteVarint64(buf, n)
+	return buf.Bytes()
+}
+
+func TestGasMetering(t *testing.T) {
+	blockchain := new(engine.TestBlockchain)
+	eventSink := exec.NewNoopEventSink()
+
+	t.Run("InfiniteLoop", func(t *testing.T) {
+		params := engine.CallParams{
+			Value: *big.NewInt(0),
+			Gas:   big.NewInt(100000),
+		}
+		_, err := Default().Execute(acmstate.NewMemoryState(), blockchain, eventSink, params, infiniteLoop)
+		require.Equal(t, errors.Codes.InsufficientGas, errors.GetCode(err))
+	})
+
+	t.Run("DeepRecursion", func(t *testing.T) {
+		// Each call costs at least one so this runs out before the interpreter's call stack does
+		params := engine.CallParams{
+			Value: *big.NewInt(0),
+			Gas:   big.NewInt(100),
+		}
+		_, err := Default().Execute(acmstate.NewMemoryState(), blockchain, eventSink, params, infiniteRecursion)
+		require.Equal(t, errors.Codes.InsufficientGas, errors.GetCode(err))
+
+		params.Gas = big.NewInt(100000)
+		_, err = Default().Execute(acmstate.NewMemoryState(), blockchain, eventSink, params, infiniteRecursion)
+		require.Equal(t, errors.Codes.CallStackOverflow, errors.GetCode(err))
+	})
+
+	t.Run("ChargesGasUsed", func(t *testing.T) {
+		params := engine.CallParams{
+			Value: *big.NewInt(0),
+			Gas:   big.NewInt(1000000),
+		}
+		_, err := Default().Execute(acmstate.NewMemoryState(), blockchain, eventSink, params, Bytecode_storage_test)
+		require.NoError(t, err)
+		gasUsed := 1000000 - params.Gas.Int64()
+		require.True(t, gasUsed > 0)
+
+		// The same contract runs out with a little less
+		params.Gas = big.NewInt(gasUsed - 1)
+		_, err = Default().Execute(acmstate.NewMemoryState(), blockchain, eventSink, params, Bytecode_storage_test)
+		require.Equal(t, errors.Codes.InsufficientGas, errors.GetCode(err))
+
+		params.Gas = big.NewInt(gasUsed)
+		_, err = Default().Execute(acmstate.NewMemoryState(), blockchain, eventSink, params, Bytecode_storage_test)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), params.Gas.Int64())
+	})
+
+	t.Run("NoGas", func(t *testing.T) {
+		params := engine.CallParams{
+			Value: *big.NewInt(0),
+			Gas:   big.NewInt(0),
+		}
+		_, err := Default().Execute(acmstate.NewMemoryState(), blockchain, eventSink, params, infiniteLoop)
+		require.Equal(t, errors.Codes.InsufficientGas, errors.GetCode(err))
+	})
+}
+
+func TestValidateCode(t *testing.T) {
+	vm := Default()
+	require.NoError(t, vm.ValidateCode(Bytecode_storage_test))
+	require.NoError(t, vm.ValidateCode(infiniteLoop))
+	require.NoError(t, vm.ValidateCode(hostCallModule("ethereum", "finish", 0, 0)))
+	require.NoError(t, vm.ValidateCode(hostCallModule("debug", "print32", 0)))
+
+	err := vm.ValidateCode(hostCallModule("ethereum", "launchMissiles", 0))
+	require.Equal(t, errors.Codes.UnresolvedSymbols, errors.GetCode(err))
+	err = vm.ValidateCode(hostCallModule("debug", "launchMissiles", 0))
+	require.Equal(t, errors.Codes.UnresolvedSymbols, errors.GetCode(err))
+	err = vm.ValidateCode(hostCallModule("env", "finish", 0, 0))
+	require.Equal(t, errors.Codes.UnresolvedSymbols, errors.GetCode(err))
+
+	err = vm.ValidateCode([]byte("not a wasm module"))
+	require.Equal(t, errors.Codes.InvalidContractCode, errors.GetCode(err))
+	err = vm.ValidateCode(nil)
+	require.Equal(t, errors.Codes.InvalidContractCode, errors.GetCode(err))
+
+	t.Run("Limits", func(t *testing.T) {
+		// The storage test declares two pages of memory
+		vm := New(engine.Options{WASM: engine.WASMOptions{MaxMemoryPages: 1}})
+		err := vm.ValidateCode(Bytecode_storage_test)
+		require.Equal(t, errors.Codes.InvalidContractCode, errors.GetCode(err))
+		vm = New(engine.Options{WASM: engine.WASMOptions{MaxMemoryPages: 2}})
+		require.NoError(t, vm.ValidateCode(Bytecode_storage_test))
+
+		vm = New(engine.Options{WASM: engine.WASMOptions{MaxModuleSize: uint64(len(infiniteLoop)) - 1}})
+		err = vm.ValidateCode(infiniteLoop)
+		require.Equal(t, errors.Codes.InvalidContractCode, errors.GetCode(err))
+		vm = New(engine.Options{WASM: engine.WASMOptions{MaxModuleSize: uint64(len(infiniteLoop))}})
+		require.NoError(t, vm.ValidateCode(infiniteLoop))
+	})
+}
+
+func TestOptions(t *testing.T) {
+	vm := New(engine.Options{CallStackMaxDepth: 3})
+	require.Equal(t, DefaultVMConfig.MaxMemoryPages, vm.vmConfig.MaxMemoryPages)
+	require.Equal(t, uint64(3), vm.options.WASM.CallStackMaxDepth)
+
+	vm = New(engine.Options{
+		CallStackMaxDepth: 3,
+		WASM: engine.WASMOptions{
+			MaxMemoryPages:     4,
+			DefaultMemoryPages: 2,
+			StackDepth:         10,
+			CallStackMaxDepth:  5,
+		},
+	})
+	require.Equal(t, 4, vm.vmConfig.MaxMemoryPages)
+	require.Equal(t, 2, vm.vmConfig.DefaultMemoryPages)
+	require.Equal(t, uint64(5), vm.options.WASM.CallStackMaxDepth)
+
+	// Recursion now stops well short of the interpreter's own limit
+	params := engine.CallParams{
+		Value: *big.NewInt(0),
+		Gas:   big.NewInt(100000),
+	}
+	_, err := vm.Execute(acmstate.NewMemoryState(), new(engine.TestBlockchain), exec.NewNoopEventSink(), params,
+		infiniteRecursion)
+	require.Equal(t, errors.Codes.CallStackOverflow, errors.GetCode(err))
+	require.True(t, params.Gas.Int64() > 99900, "should use little gas before overflowing")
+}
+
+func TestHostFunctionBounds(t *testing.T) {
+	const memorySize = 1 << 16
+	params := engine.CallParams{
+		Input: []byte{1, 2, 3, 4},
+		Value: *big.NewInt(0),
+	}
+
+	for _, tc := range []struct {
+		field string
+		args  []int32
+		code  *errors.Code
+	}{
+		{"finish", []int32{memorySize - 4, 4}, errors.Codes.None},
+		{"finish", []int32{memorySize - 4, 5}, errors.Codes.MemoryOutOfBounds},
+		{"finish", []int32{-1, 1}, errors.Codes.MemoryOutOfBounds},
+		{"finish", []int32{1, -1}, errors.Codes.MemoryOutOfBounds},
+		{"revert", []int32{memorySize, 1}, errors.Codes.MemoryOutOfBounds},
+		{"storageStore", []int32{memorySize - 31, 0}, errors.Codes.MemoryOutOfBounds},
+		{"storageStore", []int32{0, memorySize - 31}, errors.Codes.MemoryOutOfBounds},
+		{"getCaller", []int32{memorySize - 19}, errors.Codes.MemoryOutOfBounds},
+		{"callDataCopy", []int32{0, 2, 2}, errors.Codes.None},
+		{"callDataCopy", []int32{0, 2, 3}, errors.Codes.InputOutOfBounds},
+		{"callDataCopy", []int32{memorySize - 1, 0, 2}, errors.Codes.MemoryOutOfBounds},
+		{"returnDataCopy", []int32{0, 0, 1}, errors.Codes.ReturnDataOutOfBounds},
+		{"codeCopy", []int32{0, 1 << 30, 1}, errors.Codes.CodeOutOfBounds},
+		{"log", []int32{0, 0, 5, 0, 0, 0, 0}, errors.Codes.ExecutionAborted},
+		{"log", []int32{0, 0, 1, memorySize, 0, 0, 0}, errors.Codes.MemoryOutOfBounds},
+	} {
+		params.Gas = big.NewInt(100000)
+		_, err := Default().Execute(acmstate.NewMemoryState(), new(engine.TestBlockchain), exec.NewNoopEventSink(),
+			params, hostCallModule("ethereum", tc.field, tc.args...))
+		require.Equal(t, tc.code, errors.GetCode(err), "%s%v: %v", tc.field, tc.args, err)
+	}
+}
+
+// Arities of the host functions that take arguments
+var hostFunctionArity = map[string]map[string]int{
+	"debug": {
+		"print32": 1, "print64": 1, "printMem": 2, "printMemHex": 2, "printStorage": 1, "printStorageHex": 1,
+	},
+	"ethereum": {
+		"create": 4, "getBlockDifficulty": 1, "getTxGasPrice": 1, "selfDestruct": 1, "call": 5, "callCode": 5,
+		"callDelegate": 4, "callStatic": 4, "callDataCopy": 3, "returnDataCopy": 3, "codeCopy": 3, "storageStore": 2,
+		"storageLoad": 2, "finish": 2, "revert": 2, "getAddress": 1, "getCallValue": 1, "getExternalBalance": 2,
+		"getTxOrigin": 1, "getCaller": 1, "getBlockCoinbase": 1, "getBlockHash": 2, "log": 7,
+	},
+}
+
+func TestFuzzHostFunctions(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	// Favour pointers near the edges of memory
+	arg := func() int32 {
+		switch rnd.Intn(4) {
+		case 0:
+			return rnd.Int31n(64)
+		case 1:
+			return 1<<16 - rnd.Int31n(64)
+		case 2:
+			return -rnd.Int31n(64)
+		default:
+			return rnd.Int31()
+		}
+	}
+	for module, arities := range hostFunctionArity {
+		for field, arity := range arities {
+			for i := 0; i < 50; i++ {
+				args := make([]int32, arity)
+				for j := range args {
+					args[j] = arg()
+				}
+				code := hostCallModule(module, field, args...)
+				require.NoError(t, Default().ValidateCode(code))
+				_, err := callHost(t, code, rnd)
+				if err != nil {
+					require.NotContains(t, err.Error(), "runtime error", "%s.%s%v", module, field, args)
+				}
+			}
+		}
+	}
+}
+
+func TestFuzzModules(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, module := range [][]byte{Bytecode_storage_test, CREATETest} {
+		for i := 0; i < 200; i++ {
+			code := make([]byte, len(module))
+			copy(code, module)
+			for j := rnd.Intn(8); j >= 0; j-- {
+				code[rnd.Intn(len(code))] = byte(rnd.Intn(256))
+			}
+			code = code[:rnd.Intn(len(code)+1)]
+			require.NotPanics(t, func() {
+				_ = Default().ValidateCode(code)
+			})
+			// The interpreter traps on the module's own runtime errors so we only ask that nothing escapes
+			_, _ = callHost(t, code, rnd)
+		}
+	}
+}
+
+// Calls code without the recovery of Execute so that any panic fails the test
+func callHost(t *testing.T, code []byte, rnd *rand.Rand) (output []byte, err error) {
+	vm := New(engine.Options{Natives: native.MustDefaultNatives()})
+	st := native.NewState(vm.options.Natives, acmstate.NewMemoryState())
+	state := engine.State{
+		CallFrame: engine.NewCallFrame(st).
+			WithMaxCallStackDepth(vm.options.CallStackMaxDepth).
+			WithGasSchedule(vm.options.GasSchedule),
+		Blockchain: new(engine.TestBlockchain),
+		EventSink:  exec.NewNoopEventSink(),
+	}
+	input := make([]byte, rnd.Intn(64))
+	rnd.Read(input)
+	params := engine.CallParams{
+		Input: input,
+		Value: *big.NewInt(0),
+		Gas:   big.NewInt(100000),
+	}
+	require.NotPanics(t, func() {
+		output, err = vm.Contract(code).Call(state, params)
+	})
+	return
+}
+
 func blockHashGetter(height uint64) []byte {
 	return binary.LeftPadWord256([]byte(fmt.Sprintf("block_hash_%d", height))).Bytes()
 }
@@ -51,6 +51,8 @@ var DefaultPermissionsAccount = PermissionsAccount(permission.DefaultAccountPerm
 
 type params struct {
 	ProposalThreshold uint64
+	// The name of the gas schedule by which execution is priced, the flat schedule if empty
+	GasSchedule string `json:",omitempty" toml:",omitempty"`
 }
 
 type GenesisDoc struct {
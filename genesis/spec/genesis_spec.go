@@ -34,6 +34,7 @@ type GenesisSpec struct {
 
 type params struct {
 	ProposalThreshold uint64 `json:",omitempty" toml:",omitempty"`
+	GasSchedule       string `json:",omitempty" toml:",omitempty"`
 }
 
 // Produce a fully realised GenesisDoc from a template GenesisDoc that may omit values
@@ -55,6 +56,8 @@ func (gs *GenesisSpec) GenesisDoc(keyClient keys.KeyClient, curve crypto.CurveTy
 		genesisDoc.Params.ProposalThreshold = genesis.DefaultProposalThreshold
 	}
 
+	genesisDoc.Params.GasSchedule = gs.Params.GasSchedule
+
 	if len(gs.GlobalPermissions) == 0 {
 		genesisDoc.GlobalPermissions = permission.DefaultAccountPermissions.Clone()
 	} else {
@@ -0,0 +1,56 @@
+// Package instrument holds the metrics accumulated by individual operations throughout Burrow. They are exported
+// alongside the pre-aggregated metrics of rpc/metrics, which adds the chain_id and moniker labels when it registers them.
+package instrument
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	ProtocolGRPC    = "grpc"
+	ProtocolJSONRPC = "jsonrpc"
+	ProtocolHTTP    = "http"
+)
+
+var (
+	TxExecutionTime = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    prometheus.BuildFQName("burrow", "execution", "tx_duration_seconds"),
+		Help:    "Histogram metric of the time taken to execute a transaction by payload type",
+		Buckets: prometheus.ExponentialBuckets(0.0001, 4, 10),
+	}, []string{"payload_type"})
+
+	TxExceptions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: prometheus.BuildFQName("burrow", "execution", "tx_exceptions_total"),
+		Help: "Count of transactions executed with an exception by payload type and error code",
+	}, []string{"payload_type", "code"})
+
+	GasPerBlock = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    prometheus.BuildFQName("burrow", "execution", "gas_per_block"),
+		Help:    "Histogram metric of the gas used by the transactions in each block",
+		Buckets: prometheus.ExponentialBuckets(1000, 4, 12),
+	})
+
+	CommitTime = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    prometheus.BuildFQName("burrow", "state", "commit_duration_seconds"),
+		Help:    "Histogram metric of the time taken to commit the state at the end of each block",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+	})
+
+	RequestTime = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    prometheus.BuildFQName("burrow", "rpc", "request_duration_seconds"),
+		Help:    "Histogram metric of the time taken to serve an RPC request by protocol and method",
+		Buckets: prometheus.ExponentialBuckets(0.0005, 4, 10),
+	}, []string{"protocol", "method"})
+)
+
+// Collectors returns all of the metrics above for registration
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{TxExecutionTime, TxExceptions, GasPerBlock, CommitTime, RequestTime}
+}
+
+// ObserveRequest records the time since began against the method served over protocol
+func ObserveRequest(protocol, method string, began time.Time) {
+	RequestTime.WithLabelValues(protocol, method).Observe(time.Since(began).Seconds())
+}
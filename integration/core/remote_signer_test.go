@@ -0,0 +1,86 @@
+// +build integration
+
+package core
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/integration"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	genesisDoc, privateAccounts, privateValidators := genesis.NewDeterministicGenesis(123).GenesisDoc(1, 1)
+	conf, cleanup := integration.NewTestConfig(genesisDoc)
+	defer cleanup()
+	validator := privateValidators[0]
+
+	// Keep the socket path short enough for unix domain sockets
+	socketDir, err := ioutil.TempDir("", "signer")
+	require.NoError(t, err)
+	defer os.RemoveAll(socketDir)
+	conf.Tendermint.PrivValidatorListenAddress = "unix://" + filepath.Join(socketDir, "signer.sock")
+
+	// The signer process holds the key and the double-sign protection state
+	logger := logging.NewNoopLogger()
+	keyClient := keys.NewLocalKeyClient(keys.NewMemoryKeyStore(validator), logger)
+	signer, err := keys.AddressableSigner(keyClient, validator.GetAddress())
+	require.NoError(t, err)
+	stateFile := filepath.Join(socketDir, core.PrivValidatorStateFile)
+	privVal, err := tendermint.NewPrivValidatorPersisted(signer, signer, stateFile)
+	require.NoError(t, err)
+	server, err := tendermint.NewSignerServer(conf.Tendermint.PrivValidatorListenAddress, genesisDoc.GetChainID(),
+		privVal, logger)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer server.Stop()
+
+	kern, err := core.NewKernel(conf.BurrowDir)
+	require.NoError(t, err)
+	kern.SetLogger(logger)
+	kern.SetKeyClient(keys.NewLocalKeyClient(keys.NewMemoryKeyStore(privateAccounts...), logger))
+	require.NoError(t, kern.LoadExecutionOptionsFromConfig(conf.Execution))
+	require.NoError(t, kern.LoadState(conf.GenesisDoc))
+
+	// The node can only use a signer for the validator it expects
+	remotePrivVal, err := kern.RemotePrivValidator(validator.GetAddress(), conf.Tendermint.PrivValidatorListenAddress)
+	require.NoError(t, err)
+	require.NoError(t, kern.LoadTendermintFromConfig(conf, remotePrivVal))
+	kern.AddProcesses(core.DefaultProcessLaunchers(kern, conf.RPC, conf.Keys)...)
+
+	ctx := context.Background()
+	subID := event.GenSubID()
+	ch, err := kern.Emitter.Subscribe(ctx, subID, exec.QueryForBlockExecution(), 10)
+	require.NoError(t, err)
+	defer kern.Emitter.UnsubscribeAll(ctx, subID)
+
+	require.NoError(t, kern.Boot())
+	defer integration.Shutdown(kern)
+
+	const blocks = 3
+	for i := 0; i < blocks; i++ {
+		select {
+		case <-ch:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for block %d signed by remote signer", i+1)
+		}
+	}
+
+	// Everything signed was recorded by the signer
+	lsi, err := tendermint.LoadOrNewLastSignedInfo(stateFile, validator.GetAddress())
+	require.NoError(t, err)
+	assert.True(t, lsi.Height >= blocks, "signer should have recorded height %d but has %d", blocks, lsi.Height)
+}
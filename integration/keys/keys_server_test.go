@@ -91,6 +91,113 @@ func TestKeysServer(t *testing.T) {
 			})
 
 		}
+		t.Run("ImportMnemonic", func(t *testing.T) {
+			t.Parallel()
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			resp, err := cli.ImportMnemonic(ctx, &keys.ImportMnemonicRequest{
+				Mnemonic: "test test test test test test test test test test test junk",
+				Count:    2,
+				KeyName:  "hardhat",
+			})
+			require.NoError(t, err)
+			// As derived by MetaMask
+			assert.Equal(t, []*keys.DerivedKey{
+				{Address: "F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266", Path: "m/44'/60'/0'/0/0"},
+				{Address: "70997970C51812DC3A010C7D01B50E0D17DC79C8", Path: "m/44'/60'/0'/0/1"},
+			}, resp.Keys)
+
+			list, err := cli.List(ctx, &keys.ListRequest{KeyName: "hardhat-1"})
+			require.NoError(t, err)
+			require.Len(t, list.Key, 1)
+			assert.Equal(t, resp.Keys[1].Address, list.Key[0].Address)
+		})
+
+		t.Run("GenerateMnemonic", func(t *testing.T) {
+			t.Parallel()
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			mnemonic, err := cli.GenerateMnemonic(ctx, &keys.GenerateMnemonicRequest{EntropyBits: 128})
+			require.NoError(t, err)
+
+			request := &keys.ImportMnemonicRequest{Mnemonic: mnemonic.Mnemonic, CurveType: "ed25519"}
+			resp, err := cli.ImportMnemonic(ctx, request)
+			require.NoError(t, err)
+			require.Len(t, resp.Keys, 1)
+			assert.Equal(t, "m/44'/60'/0'/0'/0'", resp.Keys[0].Path)
+
+			// Derivation is deterministic
+			again, err := cli.ImportMnemonic(ctx, request)
+			require.NoError(t, err)
+			assert.Equal(t, resp.Keys, again.Keys)
+
+			_, err = cli.Sign(ctx, &keys.SignRequest{Address: resp.Keys[0].Address, Message: []byte("message")})
+			require.NoError(t, err)
+
+			request.Path = "m/44'/60'/0'/0/0"
+			_, err = cli.ImportMnemonic(ctx, request)
+			require.Error(t, err, "ed25519 keys can only be derived along hardened paths")
+
+			request.Path = ""
+			request.Count = 1001
+			_, err = cli.ImportMnemonic(ctx, request)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "the maximum is 1000")
+		})
+
+		t.Run("ExportImportV3", func(t *testing.T) {
+			t.Parallel()
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+			defer cancel()
+
+			genresp, err := cli.GenerateKey(ctx, &keys.GenRequest{Passphrase: "secret", CurveType: "secp256k1"})
+			require.NoError(t, err)
+
+			exported, err := cli.Export(ctx, &keys.ExportRequest{Passphrase: "secret", Address: genresp.Address,
+				Format: keys.ExportFormatV3})
+			require.NoError(t, err)
+			assert.Empty(t, exported.Privatekey)
+			require.True(t, keys.IsKeyV3([]byte(exported.JSON)))
+
+			_, err = cli.ImportJSON(ctx, &keys.ImportJSONRequest{Passphrase: "guess", JSON: exported.JSON})
+			require.Error(t, err)
+
+			imported, err := cli.ImportJSON(ctx, &keys.ImportJSONRequest{Passphrase: "secret", JSON: exported.JSON,
+				Name: "v3"})
+			require.NoError(t, err)
+			assert.Equal(t, genresp.Address, imported.Address)
+
+			list, err := cli.List(ctx, &keys.ListRequest{KeyName: "v3"})
+			require.NoError(t, err)
+			require.Len(t, list.Key, 1)
+			assert.Equal(t, genresp.Address, list.Key[0].Address)
+
+			_, err = cli.Export(ctx, &keys.ExportRequest{Passphrase: "secret", Address: genresp.Address,
+				Format: "pem"})
+			require.Error(t, err)
+		})
+
+		t.Run("ImportV3", func(t *testing.T) {
+			t.Parallel()
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// From go-ethereum's keystore test data
+			resp, err := cli.ImportJSON(ctx, &keys.ImportJSONRequest{JSON: `{"address":"45dea0fb0bba44f4fcf290bba71fd57d7117cbb8",
+				"crypto":{"cipher":"aes-128-ctr","ciphertext":"b87781948a1befd247bff51ef4063f716cf6c2d3481163e9a8f42e1f9bb74145",
+				"cipherparams":{"iv":"dc4926b48a105133d2f16b96833abf1e"},"kdf":"scrypt","kdfparams":{"dklen":32,"n":2,"p":1,"r":8,
+				"salt":"004244bbdc51cadda545b1cfa43cff9ed2ae88e08c61f1479dbb45410722f8f0"},
+				"mac":"39990c1684557447940d4c69e06b1b82b2aceacb43f284df65c956daf3046b85"},
+				"id":"ce541d8d-c79b-40f8-9f8c-20f59616faba","version":3}`})
+			require.NoError(t, err)
+			assert.Equal(t, "45DEA0FB0BBA44F4FCF290BBA71FD57D7117CBB8", resp.Address)
+
+			_, err = cli.Sign(ctx, &keys.SignRequest{Address: resp.Address, Message: []byte("message")})
+			require.NoError(t, err)
+		})
+
 		for _, typ := range []string{"sha256", "ripemd160"} {
 			t.Run("Hash", func(t *testing.T) {
 				t.Parallel()
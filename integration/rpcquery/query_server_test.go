@@ -13,7 +13,11 @@ import (
 	"github.com/tendermint/tendermint/crypto/tmhash"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/integration/rpctest"
@@ -122,6 +126,56 @@ func TestQueryServer(t *testing.T) {
 		assert.Equal(t, int64(height), header.Height)
 		assert.Len(t, header.AppHash, tmhash.Size)
 	})
+
+	t.Run("GetAtHeight", func(t *testing.T) {
+		tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
+		qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		ecli := rpctest.NewExecutionEventsClient(t, kern.GRPCListenAddress().String())
+		// Make sure the previous height is non-zero (which would mean latest)
+		err := rpctest.WaitNBlocks(ecli, 1)
+		require.NoError(t, err)
+		// Constructor that stores 0x2a at key 1
+		txe, err := rpctest.CreateEVMContract(tcli, rpctest.PrivateAccounts[0].GetAddress(),
+			bc.MustSplice(asm.PUSH1, 0x2a, asm.PUSH1, 1, asm.SSTORE, asm.STOP), nil)
+		require.NoError(t, err)
+		address := txe.Receipt.ContractAddress
+		key := binary.LeftPadWord256([]byte{1})
+
+		acc, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{
+			Address: address,
+			Height:  txe.Height - 1,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, crypto.Address{}, acc.Address)
+		value, err := qcli.GetStorage(context.Background(), &rpcquery.GetStorageParam{
+			Address: address,
+			Key:     key,
+			Height:  txe.Height - 1,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, value.Value)
+
+		acc, err = qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{
+			Address: address,
+			Height:  txe.Height,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, address, acc.Address)
+		value, err = qcli.GetStorage(context.Background(), &rpcquery.GetStorageParam{
+			Address: address,
+			Key:     key,
+			Height:  txe.Height,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, binary.LeftPadWord256([]byte{0x2a}).Bytes(), value.Value.Bytes())
+
+		_, err = qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{
+			Address: address,
+			Height:  txe.Height + 1000000,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not available")
+	})
 }
 
 func receiveNames(t testing.TB, qcli rpcquery.QueryClient, query string) []*names.Entry {
@@ -29,6 +29,7 @@ import (
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
 )
 
 func TestCallTx(t *testing.T) {
@@ -509,6 +510,17 @@ func testCallTx(t *testing.T, kern *core.Kernel, cli rpctransact.TransactClient)
 			return
 		})
 
+		t.Run("WasmUnknownImport", func(t *testing.T) {
+			t.Parallel()
+			// (module (import "ethereum" "launchMissiles" (func)) (func (export "main") (call 0)))
+			bytecode := hex.MustDecodeString("0061736d01000000010401600000021b0108657468657265756d0e6c61756e6368" +
+				"4d697373696c6573000003020100070801046d61696e00010a0601040010000b")
+			_, err := rpctest.CreateWASMContract(cli, inputAddress, bytecode, nil)
+			require.Error(t, err, "contracts with unknown imports should not deploy")
+			assert.Contains(t, err.Error(), errors.Codes.UnresolvedSymbols.Error())
+			return
+		})
+
 		t.Run("WasmCallEvm", func(t *testing.T) {
 			t.Parallel()
 			txe, err := rpctest.CreateWASMContract(cli, inputAddress, solidity.Bytecode_ewasm, nil)
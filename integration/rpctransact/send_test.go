@@ -6,9 +6,14 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/integration"
 
 	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -37,6 +42,39 @@ func TestSendTx(t *testing.T) {
 		}
 	})
 
+	t.Run("Batch", func(t *testing.T) {
+		tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
+		qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		signers := []*acm.PrivateAccount{rpctest.PrivateAccounts[4], rpctest.PrivateAccounts[5]}
+		recipient := rpctest.PrivateAccounts[6].GetAddress()
+		getAccount := func(address crypto.Address) *acm.Account {
+			acc, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: address})
+			require.NoError(t, err)
+			return acc
+		}
+		balance := getAccount(recipient).Balance
+
+		batchTx := &payload.BatchTx{}
+		for _, signer := range signers {
+			acc := getAccount(signer.GetAddress())
+			batchTx.Inputs = append(batchTx.Inputs, &payload.TxInput{Address: acc.Address, Sequence: acc.Sequence + 1})
+			send := payload.NewSendTx()
+			send.AddInputWithSequence(signer.GetPublicKey(), 100, acc.Sequence+1)
+			send.AddOutput(recipient, 100)
+			batchTx.Txs = append(batchTx.Txs, send.Any())
+		}
+		txEnv := txs.Enclose(rpctest.GenesisDoc.GetChainID(), batchTx)
+		require.NoError(t, txEnv.Sign(signers[0], signers[1]))
+		txe, err := tcli.BroadcastTxSync(context.Background(), &rpctransact.TxEnvelopeParam{Envelope: txEnv})
+		require.NoError(t, err)
+		require.Nil(t, txe.Exception)
+		require.Len(t, txe.TxExecutions, 2)
+		for _, containedTxe := range txe.TxExecutions {
+			assert.Equal(t, payload.TypeSend, containedTxe.TxType)
+		}
+		assert.Equal(t, balance+200, getAccount(recipient).Balance)
+	})
+
 	t.Run("Async", func(t *testing.T) {
 		cli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
 		numSends := 1000
@@ -22,6 +22,8 @@ interface IKeysService extends grpc.ServiceDefinition<grpc.UntypedServiceImpleme
     removeName: IKeysService_IRemoveName;
     list: IKeysService_IList;
     addName: IKeysService_IAddName;
+    generateMnemonic: IKeysService_IGenerateMnemonic;
+    importMnemonic: IKeysService_IImportMnemonic;
 }
 
 interface IKeysService_IGenerateKey extends grpc.MethodDefinition<keys_pb.GenRequest, keys_pb.GenResponse> {
@@ -123,6 +125,24 @@ interface IKeysService_IAddName extends grpc.MethodDefinition<keys_pb.AddNameReq
     responseSerialize: grpc.serialize<keys_pb.AddNameResponse>;
     responseDeserialize: grpc.deserialize<keys_pb.AddNameResponse>;
 }
+interface IKeysService_IGenerateMnemonic extends grpc.MethodDefinition<keys_pb.GenerateMnemonicRequest, keys_pb.GenerateMnemonicResponse> {
+    path: "/keys.Keys/GenerateMnemonic";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<keys_pb.GenerateMnemonicRequest>;
+    requestDeserialize: grpc.deserialize<keys_pb.GenerateMnemonicRequest>;
+    responseSerialize: grpc.serialize<keys_pb.GenerateMnemonicResponse>;
+    responseDeserialize: grpc.deserialize<keys_pb.GenerateMnemonicResponse>;
+}
+interface IKeysService_IImportMnemonic extends grpc.MethodDefinition<keys_pb.ImportMnemonicRequest, keys_pb.ImportMnemonicResponse> {
+    path: "/keys.Keys/ImportMnemonic";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<keys_pb.ImportMnemonicRequest>;
+    requestDeserialize: grpc.deserialize<keys_pb.ImportMnemonicRequest>;
+    responseSerialize: grpc.serialize<keys_pb.ImportMnemonicResponse>;
+    responseDeserialize: grpc.deserialize<keys_pb.ImportMnemonicResponse>;
+}
 
 export const KeysService: IKeysService;
 
@@ -138,6 +158,8 @@ export interface IKeysServer extends grpc.UntypedServiceImplementation {
     removeName: grpc.handleUnaryCall<keys_pb.RemoveNameRequest, keys_pb.RemoveNameResponse>;
     list: grpc.handleUnaryCall<keys_pb.ListRequest, keys_pb.ListResponse>;
     addName: grpc.handleUnaryCall<keys_pb.AddNameRequest, keys_pb.AddNameResponse>;
+    generateMnemonic: grpc.handleUnaryCall<keys_pb.GenerateMnemonicRequest, keys_pb.GenerateMnemonicResponse>;
+    importMnemonic: grpc.handleUnaryCall<keys_pb.ImportMnemonicRequest, keys_pb.ImportMnemonicResponse>;
 }
 
 export interface IKeysClient {
@@ -174,6 +196,12 @@ export interface IKeysClient {
     addName(request: keys_pb.AddNameRequest, callback: (error: grpc.ServiceError | null, response: keys_pb.AddNameResponse) => void): grpc.ClientUnaryCall;
     addName(request: keys_pb.AddNameRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: keys_pb.AddNameResponse) => void): grpc.ClientUnaryCall;
     addName(request: keys_pb.AddNameRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: keys_pb.AddNameResponse) => void): grpc.ClientUnaryCall;
+    generateMnemonic(request: keys_pb.GenerateMnemonicRequest, callback: (error: grpc.ServiceError | null, response: keys_pb.GenerateMnemonicResponse) => void): grpc.ClientUnaryCall;
+    generateMnemonic(request: keys_pb.GenerateMnemonicRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: keys_pb.GenerateMnemonicResponse) => void): grpc.ClientUnaryCall;
+    generateMnemonic(request: keys_pb.GenerateMnemonicRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: keys_pb.GenerateMnemonicResponse) => void): grpc.ClientUnaryCall;
+    importMnemonic(request: keys_pb.ImportMnemonicRequest, callback: (error: grpc.ServiceError | null, response: keys_pb.ImportMnemonicResponse) => void): grpc.ClientUnaryCall;
+    importMnemonic(request: keys_pb.ImportMnemonicRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: keys_pb.ImportMnemonicResponse) => void): grpc.ClientUnaryCall;
+    importMnemonic(request: keys_pb.ImportMnemonicRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: keys_pb.ImportMnemonicResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class KeysClient extends grpc.Client implements IKeysClient {
@@ -211,4 +239,10 @@ export class KeysClient extends grpc.Client implements IKeysClient {
     public addName(request: keys_pb.AddNameRequest, callback: (error: grpc.ServiceError | null, response: keys_pb.AddNameResponse) => void): grpc.ClientUnaryCall;
     public addName(request: keys_pb.AddNameRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: keys_pb.AddNameResponse) => void): grpc.ClientUnaryCall;
     public addName(request: keys_pb.AddNameRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: keys_pb.AddNameResponse) => void): grpc.ClientUnaryCall;
+    public generateMnemonic(request: keys_pb.GenerateMnemonicRequest, callback: (error: grpc.ServiceError | null, response: keys_pb.GenerateMnemonicResponse) => void): grpc.ClientUnaryCall;
+    public generateMnemonic(request: keys_pb.GenerateMnemonicRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: keys_pb.GenerateMnemonicResponse) => void): grpc.ClientUnaryCall;
+    public generateMnemonic(request: keys_pb.GenerateMnemonicRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: keys_pb.GenerateMnemonicResponse) => void): grpc.ClientUnaryCall;
+    public importMnemonic(request: keys_pb.ImportMnemonicRequest, callback: (error: grpc.ServiceError | null, response: keys_pb.ImportMnemonicResponse) => void): grpc.ClientUnaryCall;
+    public importMnemonic(request: keys_pb.ImportMnemonicRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: keys_pb.ImportMnemonicResponse) => void): grpc.ClientUnaryCall;
+    public importMnemonic(request: keys_pb.ImportMnemonicRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: keys_pb.ImportMnemonicResponse) => void): grpc.ClientUnaryCall;
 }
@@ -72,6 +72,28 @@ function deserialize_keys_GenResponse(buffer_arg) {
   return keys_pb.GenResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_keys_GenerateMnemonicRequest(arg) {
+  if (!(arg instanceof keys_pb.GenerateMnemonicRequest)) {
+    throw new Error('Expected argument of type keys.GenerateMnemonicRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_keys_GenerateMnemonicRequest(buffer_arg) {
+  return keys_pb.GenerateMnemonicRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_keys_GenerateMnemonicResponse(arg) {
+  if (!(arg instanceof keys_pb.GenerateMnemonicResponse)) {
+    throw new Error('Expected argument of type keys.GenerateMnemonicResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_keys_GenerateMnemonicResponse(buffer_arg) {
+  return keys_pb.GenerateMnemonicResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_keys_HashRequest(arg) {
   if (!(arg instanceof keys_pb.HashRequest)) {
     throw new Error('Expected argument of type keys.HashRequest');
@@ -105,6 +127,28 @@ function deserialize_keys_ImportJSONRequest(buffer_arg) {
   return keys_pb.ImportJSONRequest.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_keys_ImportMnemonicRequest(arg) {
+  if (!(arg instanceof keys_pb.ImportMnemonicRequest)) {
+    throw new Error('Expected argument of type keys.ImportMnemonicRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_keys_ImportMnemonicRequest(buffer_arg) {
+  return keys_pb.ImportMnemonicRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_keys_ImportMnemonicResponse(arg) {
+  if (!(arg instanceof keys_pb.ImportMnemonicResponse)) {
+    throw new Error('Expected argument of type keys.ImportMnemonicResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_keys_ImportMnemonicResponse(buffer_arg) {
+  return keys_pb.ImportMnemonicResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_keys_ImportRequest(arg) {
   if (!(arg instanceof keys_pb.ImportRequest)) {
     throw new Error('Expected argument of type keys.ImportRequest');
@@ -360,6 +404,28 @@ var KeysService = exports.KeysService = {
     responseSerialize: serialize_keys_AddNameResponse,
     responseDeserialize: deserialize_keys_AddNameResponse,
   },
+  generateMnemonic: {
+    path: '/keys.Keys/GenerateMnemonic',
+    requestStream: false,
+    responseStream: false,
+    requestType: keys_pb.GenerateMnemonicRequest,
+    responseType: keys_pb.GenerateMnemonicResponse,
+    requestSerialize: serialize_keys_GenerateMnemonicRequest,
+    requestDeserialize: deserialize_keys_GenerateMnemonicRequest,
+    responseSerialize: serialize_keys_GenerateMnemonicResponse,
+    responseDeserialize: deserialize_keys_GenerateMnemonicResponse,
+  },
+  importMnemonic: {
+    path: '/keys.Keys/ImportMnemonic',
+    requestStream: false,
+    responseStream: false,
+    requestType: keys_pb.ImportMnemonicRequest,
+    responseType: keys_pb.ImportMnemonicResponse,
+    requestSerialize: serialize_keys_ImportMnemonicRequest,
+    requestDeserialize: deserialize_keys_ImportMnemonicRequest,
+    responseSerialize: serialize_keys_ImportMnemonicResponse,
+    responseDeserialize: deserialize_keys_ImportMnemonicResponse,
+  },
 };
 
 exports.KeysClient = grpc.makeGenericClientConstructor(KeysService);
@@ -198,6 +198,8 @@ export class ImportJSONRequest extends jspb.Message {
     setPassphrase(value: string): ImportJSONRequest;
     getJson(): string;
     setJson(value: string): ImportJSONRequest;
+    getName(): string;
+    setName(value: string): ImportJSONRequest;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): ImportJSONRequest.AsObject;
@@ -213,6 +215,7 @@ export namespace ImportJSONRequest {
     export type AsObject = {
         passphrase: string,
         json: string,
+        name: string,
     }
 }
 
@@ -274,6 +277,8 @@ export class ExportRequest extends jspb.Message {
     setName(value: string): ExportRequest;
     getAddress(): string;
     setAddress(value: string): ExportRequest;
+    getFormat(): string;
+    setFormat(value: string): ExportRequest;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): ExportRequest.AsObject;
@@ -290,6 +295,7 @@ export namespace ExportRequest {
         passphrase: string,
         name: string,
         address: string,
+        format: string,
     }
 }
 
@@ -308,6 +314,8 @@ export class ExportResponse extends jspb.Message {
     setAddress(value: Uint8Array | string): ExportResponse;
     getCurvetype(): string;
     setCurvetype(value: string): ExportResponse;
+    getJson(): string;
+    setJson(value: string): ExportResponse;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): ExportResponse.AsObject;
@@ -325,6 +333,7 @@ export namespace ExportResponse {
         privatekey: Uint8Array | string,
         address: Uint8Array | string,
         curvetype: string,
+        json: string,
     }
 }
 
@@ -529,3 +538,126 @@ export namespace AddNameRequest {
         address: string,
     }
 }
+
+export class GenerateMnemonicRequest extends jspb.Message { 
+    getEntropybits(): number;
+    setEntropybits(value: number): GenerateMnemonicRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GenerateMnemonicRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GenerateMnemonicRequest): GenerateMnemonicRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GenerateMnemonicRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GenerateMnemonicRequest;
+    static deserializeBinaryFromReader(message: GenerateMnemonicRequest, reader: jspb.BinaryReader): GenerateMnemonicRequest;
+}
+
+export namespace GenerateMnemonicRequest {
+    export type AsObject = {
+        entropybits: number,
+    }
+}
+
+export class GenerateMnemonicResponse extends jspb.Message { 
+    getMnemonic(): string;
+    setMnemonic(value: string): GenerateMnemonicResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GenerateMnemonicResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GenerateMnemonicResponse): GenerateMnemonicResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GenerateMnemonicResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GenerateMnemonicResponse;
+    static deserializeBinaryFromReader(message: GenerateMnemonicResponse, reader: jspb.BinaryReader): GenerateMnemonicResponse;
+}
+
+export namespace GenerateMnemonicResponse {
+    export type AsObject = {
+        mnemonic: string,
+    }
+}
+
+export class ImportMnemonicRequest extends jspb.Message { 
+    getPassphrase(): string;
+    setPassphrase(value: string): ImportMnemonicRequest;
+    getMnemonic(): string;
+    setMnemonic(value: string): ImportMnemonicRequest;
+    getPassword(): string;
+    setPassword(value: string): ImportMnemonicRequest;
+    getCurvetype(): string;
+    setCurvetype(value: string): ImportMnemonicRequest;
+    getPath(): string;
+    setPath(value: string): ImportMnemonicRequest;
+    getCount(): number;
+    setCount(value: number): ImportMnemonicRequest;
+    getKeyname(): string;
+    setKeyname(value: string): ImportMnemonicRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ImportMnemonicRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ImportMnemonicRequest): ImportMnemonicRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ImportMnemonicRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ImportMnemonicRequest;
+    static deserializeBinaryFromReader(message: ImportMnemonicRequest, reader: jspb.BinaryReader): ImportMnemonicRequest;
+}
+
+export namespace ImportMnemonicRequest {
+    export type AsObject = {
+        passphrase: string,
+        mnemonic: string,
+        password: string,
+        curvetype: string,
+        path: string,
+        count: number,
+        keyname: string,
+    }
+}
+
+export class DerivedKey extends jspb.Message { 
+    getAddress(): string;
+    setAddress(value: string): DerivedKey;
+    getPath(): string;
+    setPath(value: string): DerivedKey;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DerivedKey.AsObject;
+    static toObject(includeInstance: boolean, msg: DerivedKey): DerivedKey.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DerivedKey, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DerivedKey;
+    static deserializeBinaryFromReader(message: DerivedKey, reader: jspb.BinaryReader): DerivedKey;
+}
+
+export namespace DerivedKey {
+    export type AsObject = {
+        address: string,
+        path: string,
+    }
+}
+
+export class ImportMnemonicResponse extends jspb.Message { 
+    clearKeysList(): void;
+    getKeysList(): Array<DerivedKey>;
+    setKeysList(value: Array<DerivedKey>): ImportMnemonicResponse;
+    addKeys(value?: DerivedKey, index?: number): DerivedKey;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ImportMnemonicResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ImportMnemonicResponse): ImportMnemonicResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ImportMnemonicResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ImportMnemonicResponse;
+    static deserializeBinaryFromReader(message: ImportMnemonicResponse, reader: jspb.BinaryReader): ImportMnemonicResponse;
+}
+
+export namespace ImportMnemonicResponse {
+    export type AsObject = {
+        keysList: Array<DerivedKey.AsObject>,
+    }
+}
@@ -21,13 +21,18 @@ var crypto_pb = require('./crypto_pb.js');
 goog.object.extend(proto, crypto_pb);
 goog.exportSymbol('proto.keys.AddNameRequest', null, global);
 goog.exportSymbol('proto.keys.AddNameResponse', null, global);
+goog.exportSymbol('proto.keys.DerivedKey', null, global);
 goog.exportSymbol('proto.keys.ExportRequest', null, global);
 goog.exportSymbol('proto.keys.ExportResponse', null, global);
 goog.exportSymbol('proto.keys.GenRequest', null, global);
 goog.exportSymbol('proto.keys.GenResponse', null, global);
+goog.exportSymbol('proto.keys.GenerateMnemonicRequest', null, global);
+goog.exportSymbol('proto.keys.GenerateMnemonicResponse', null, global);
 goog.exportSymbol('proto.keys.HashRequest', null, global);
 goog.exportSymbol('proto.keys.HashResponse', null, global);
 goog.exportSymbol('proto.keys.ImportJSONRequest', null, global);
+goog.exportSymbol('proto.keys.ImportMnemonicRequest', null, global);
+goog.exportSymbol('proto.keys.ImportMnemonicResponse', null, global);
 goog.exportSymbol('proto.keys.ImportRequest', null, global);
 goog.exportSymbol('proto.keys.ImportResponse', null, global);
 goog.exportSymbol('proto.keys.KeyID', null, global);
@@ -503,6 +508,111 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.keys.AddNameRequest.displayName = 'proto.keys.AddNameRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.keys.GenerateMnemonicRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.keys.GenerateMnemonicRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.keys.GenerateMnemonicRequest.displayName = 'proto.keys.GenerateMnemonicRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.keys.GenerateMnemonicResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.keys.GenerateMnemonicResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.keys.GenerateMnemonicResponse.displayName = 'proto.keys.GenerateMnemonicResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.keys.ImportMnemonicRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.keys.ImportMnemonicRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.keys.ImportMnemonicRequest.displayName = 'proto.keys.ImportMnemonicRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.keys.DerivedKey = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.keys.DerivedKey, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.keys.DerivedKey.displayName = 'proto.keys.DerivedKey';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.keys.ImportMnemonicResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.keys.ImportMnemonicResponse.repeatedFields_, null);
+};
+goog.inherits(proto.keys.ImportMnemonicResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.keys.ImportMnemonicResponse.displayName = 'proto.keys.ImportMnemonicResponse';
+}
 
 
 
@@ -1763,7 +1873,8 @@ proto.keys.ImportJSONRequest.prototype.toObject = function(opt_includeInstance)
 proto.keys.ImportJSONRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     passphrase: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    json: jspb.Message.getFieldWithDefault(msg, 2, "")
+    json: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -1808,6 +1919,10 @@ proto.keys.ImportJSONRequest.deserializeBinaryFromReader = function(msg, reader)
       var value = /** @type {string} */ (reader.readString());
       msg.setJson(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1851,6 +1966,13 @@ proto.keys.ImportJSONRequest.serializeBinaryToWriter = function(message, writer)
       f
     );
   }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -1890,6 +2012,24 @@ proto.keys.ImportJSONRequest.prototype.setJson = function(value) {
 };
 
 
+/**
+ * optional string Name = 3;
+ * @return {string}
+ */
+proto.keys.ImportJSONRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.keys.ImportJSONRequest} returns this
+ */
+proto.keys.ImportJSONRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
 
 
 
@@ -2298,7 +2438,8 @@ proto.keys.ExportRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     passphrase: jspb.Message.getFieldWithDefault(msg, 1, ""),
     name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    address: jspb.Message.getFieldWithDefault(msg, 3, "")
+    address: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    format: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -2347,6 +2488,10 @@ proto.keys.ExportRequest.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setAddress(value);
       break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFormat(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2397,6 +2542,13 @@ proto.keys.ExportRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getFormat();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -2454,6 +2606,23 @@ proto.keys.ExportRequest.prototype.setAddress = function(value) {
 };
 
 
+/**
+ * optional string Format = 4;
+ * @return {string}
+ */
+proto.keys.ExportRequest.prototype.getFormat = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.keys.ExportRequest} returns this
+ */
+proto.keys.ExportRequest.prototype.setFormat = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
 
 
 
@@ -2489,7 +2658,8 @@ proto.keys.ExportResponse.toObject = function(includeInstance, msg) {
     publickey: msg.getPublickey_asB64(),
     privatekey: msg.getPrivatekey_asB64(),
     address: msg.getAddress_asB64(),
-    curvetype: jspb.Message.getFieldWithDefault(msg, 4, "")
+    curvetype: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    json: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -2542,6 +2712,10 @@ proto.keys.ExportResponse.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setCurvetype(value);
       break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setJson(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2599,6 +2773,13 @@ proto.keys.ExportResponse.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getJson();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -2746,6 +2927,24 @@ proto.keys.ExportResponse.prototype.setCurvetype = function(value) {
 };
 
 
+/**
+ * optional string JSON = 5;
+ * @return {string}
+ */
+proto.keys.ExportResponse.prototype.getJson = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.keys.ExportResponse} returns this
+ */
+proto.keys.ExportResponse.prototype.setJson = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
 
 
 
@@ -4220,4 +4419,890 @@ proto.keys.AddNameRequest.prototype.setAddress = function(value) {
 };
 
 
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.keys.GenerateMnemonicRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.keys.GenerateMnemonicRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.keys.GenerateMnemonicRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.keys.GenerateMnemonicRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    entropybits: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.keys.GenerateMnemonicRequest}
+ */
+proto.keys.GenerateMnemonicRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.keys.GenerateMnemonicRequest;
+  return proto.keys.GenerateMnemonicRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.keys.GenerateMnemonicRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.keys.GenerateMnemonicRequest}
+ */
+proto.keys.GenerateMnemonicRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setEntropybits(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.keys.GenerateMnemonicRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.keys.GenerateMnemonicRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.keys.GenerateMnemonicRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.keys.GenerateMnemonicRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEntropybits();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 EntropyBits = 1;
+ * @return {number}
+ */
+proto.keys.GenerateMnemonicRequest.prototype.getEntropybits = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.keys.GenerateMnemonicRequest} returns this
+ */
+proto.keys.GenerateMnemonicRequest.prototype.setEntropybits = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.keys.GenerateMnemonicResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.keys.GenerateMnemonicResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.keys.GenerateMnemonicResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.keys.GenerateMnemonicResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    mnemonic: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.keys.GenerateMnemonicResponse}
+ */
+proto.keys.GenerateMnemonicResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.keys.GenerateMnemonicResponse;
+  return proto.keys.GenerateMnemonicResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.keys.GenerateMnemonicResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.keys.GenerateMnemonicResponse}
+ */
+proto.keys.GenerateMnemonicResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMnemonic(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.keys.GenerateMnemonicResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.keys.GenerateMnemonicResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.keys.GenerateMnemonicResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.keys.GenerateMnemonicResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMnemonic();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Mnemonic = 1;
+ * @return {string}
+ */
+proto.keys.GenerateMnemonicResponse.prototype.getMnemonic = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.keys.GenerateMnemonicResponse} returns this
+ */
+proto.keys.GenerateMnemonicResponse.prototype.setMnemonic = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.keys.ImportMnemonicRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.keys.ImportMnemonicRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.keys.ImportMnemonicRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.keys.ImportMnemonicRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    passphrase: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    mnemonic: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    password: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    curvetype: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    path: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    count: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    keyname: jspb.Message.getFieldWithDefault(msg, 7, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.keys.ImportMnemonicRequest}
+ */
+proto.keys.ImportMnemonicRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.keys.ImportMnemonicRequest;
+  return proto.keys.ImportMnemonicRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.keys.ImportMnemonicRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.keys.ImportMnemonicRequest}
+ */
+proto.keys.ImportMnemonicRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPassphrase(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMnemonic(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPassword(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCurvetype(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCount(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKeyname(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.keys.ImportMnemonicRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.keys.ImportMnemonicRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.keys.ImportMnemonicRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.keys.ImportMnemonicRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPassphrase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMnemonic();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPassword();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getCurvetype();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getCount();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getKeyname();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Passphrase = 1;
+ * @return {string}
+ */
+proto.keys.ImportMnemonicRequest.prototype.getPassphrase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.keys.ImportMnemonicRequest} returns this
+ */
+proto.keys.ImportMnemonicRequest.prototype.setPassphrase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string Mnemonic = 2;
+ * @return {string}
+ */
+proto.keys.ImportMnemonicRequest.prototype.getMnemonic = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.keys.ImportMnemonicRequest} returns this
+ */
+proto.keys.ImportMnemonicRequest.prototype.setMnemonic = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string Password = 3;
+ * @return {string}
+ */
+proto.keys.ImportMnemonicRequest.prototype.getPassword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.keys.ImportMnemonicRequest} returns this
+ */
+proto.keys.ImportMnemonicRequest.prototype.setPassword = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string CurveType = 4;
+ * @return {string}
+ */
+proto.keys.ImportMnemonicRequest.prototype.getCurvetype = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.keys.ImportMnemonicRequest} returns this
+ */
+proto.keys.ImportMnemonicRequest.prototype.setCurvetype = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string Path = 5;
+ * @return {string}
+ */
+proto.keys.ImportMnemonicRequest.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.keys.ImportMnemonicRequest} returns this
+ */
+proto.keys.ImportMnemonicRequest.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 Count = 6;
+ * @return {number}
+ */
+proto.keys.ImportMnemonicRequest.prototype.getCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.keys.ImportMnemonicRequest} returns this
+ */
+proto.keys.ImportMnemonicRequest.prototype.setCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional string KeyName = 7;
+ * @return {string}
+ */
+proto.keys.ImportMnemonicRequest.prototype.getKeyname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.keys.ImportMnemonicRequest} returns this
+ */
+proto.keys.ImportMnemonicRequest.prototype.setKeyname = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.keys.DerivedKey.prototype.toObject = function(opt_includeInstance) {
+  return proto.keys.DerivedKey.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.keys.DerivedKey} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.keys.DerivedKey.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    address: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    path: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.keys.DerivedKey}
+ */
+proto.keys.DerivedKey.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.keys.DerivedKey;
+  return proto.keys.DerivedKey.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.keys.DerivedKey} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.keys.DerivedKey}
+ */
+proto.keys.DerivedKey.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.keys.DerivedKey.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.keys.DerivedKey.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.keys.DerivedKey} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.keys.DerivedKey.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Address = 1;
+ * @return {string}
+ */
+proto.keys.DerivedKey.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.keys.DerivedKey} returns this
+ */
+proto.keys.DerivedKey.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string Path = 2;
+ * @return {string}
+ */
+proto.keys.DerivedKey.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.keys.DerivedKey} returns this
+ */
+proto.keys.DerivedKey.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.keys.ImportMnemonicResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.keys.ImportMnemonicResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.keys.ImportMnemonicResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.keys.ImportMnemonicResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.keys.ImportMnemonicResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    keysList: jspb.Message.toObjectList(msg.getKeysList(),
+    proto.keys.DerivedKey.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.keys.ImportMnemonicResponse}
+ */
+proto.keys.ImportMnemonicResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.keys.ImportMnemonicResponse;
+  return proto.keys.ImportMnemonicResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.keys.ImportMnemonicResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.keys.ImportMnemonicResponse}
+ */
+proto.keys.ImportMnemonicResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.keys.DerivedKey;
+      reader.readMessage(value,proto.keys.DerivedKey.deserializeBinaryFromReader);
+      msg.addKeys(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.keys.ImportMnemonicResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.keys.ImportMnemonicResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.keys.ImportMnemonicResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.keys.ImportMnemonicResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.keys.DerivedKey.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated DerivedKey Keys = 1;
+ * @return {!Array<!proto.keys.DerivedKey>}
+ */
+proto.keys.ImportMnemonicResponse.prototype.getKeysList = function() {
+  return /** @type{!Array<!proto.keys.DerivedKey>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.keys.DerivedKey, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.keys.DerivedKey>} value
+ * @return {!proto.keys.ImportMnemonicResponse} returns this
+*/
+proto.keys.ImportMnemonicResponse.prototype.setKeysList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.keys.DerivedKey=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.keys.DerivedKey}
+ */
+proto.keys.ImportMnemonicResponse.prototype.addKeys = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.keys.DerivedKey, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.keys.ImportMnemonicResponse} returns this
+ */
+proto.keys.ImportMnemonicResponse.prototype.clearKeysList = function() {
+  return this.setKeysList([]);
+};
+
+
 goog.object.extend(exports, proto.keys);
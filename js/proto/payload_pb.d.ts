@@ -401,6 +401,8 @@ export class ProposalTx extends jspb.Message {
     clearProposal(): void;
     getProposal(): Proposal | undefined;
     setProposal(value?: Proposal): ProposalTx;
+    getWithdraw(): boolean;
+    setWithdraw(value: boolean): ProposalTx;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): ProposalTx.AsObject;
@@ -418,6 +420,7 @@ export namespace ProposalTx {
         votingweight: number,
         proposalhash: Uint8Array | string,
         proposal?: Proposal.AsObject,
+        withdraw: boolean,
     }
 }
 
@@ -511,6 +514,8 @@ export class Proposal extends jspb.Message {
     clearBatchtx(): void;
     getBatchtx(): BatchTx | undefined;
     setBatchtx(value?: BatchTx): Proposal;
+    getExpiryheight(): number;
+    setExpiryheight(value: number): Proposal;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): Proposal.AsObject;
@@ -527,6 +532,7 @@ export namespace Proposal {
         name: string,
         description: string,
         batchtx?: BatchTx.AsObject,
+        expiryheight: number,
     }
 }
 
@@ -569,6 +575,7 @@ export namespace Ballot {
     PROPOSED = 0,
     EXECUTED = 1,
     FAILED = 2,
+    EXPIRED = 3,
     }
 
 }
@@ -3310,7 +3310,8 @@ proto.payload.ProposalTx.toObject = function(includeInstance, msg) {
     input: (f = msg.getInput()) && proto.payload.TxInput.toObject(includeInstance, f),
     votingweight: jspb.Message.getFieldWithDefault(msg, 2, 0),
     proposalhash: msg.getProposalhash_asB64(),
-    proposal: (f = msg.getProposal()) && proto.payload.Proposal.toObject(includeInstance, f)
+    proposal: (f = msg.getProposal()) && proto.payload.Proposal.toObject(includeInstance, f),
+    withdraw: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -3365,6 +3366,10 @@ proto.payload.ProposalTx.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,proto.payload.Proposal.deserializeBinaryFromReader);
       msg.setProposal(value);
       break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWithdraw(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3424,6 +3429,13 @@ proto.payload.ProposalTx.serializeBinaryToWriter = function(message, writer) {
       proto.payload.Proposal.serializeBinaryToWriter
     );
   }
+  f = message.getWithdraw();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -3561,6 +3573,24 @@ proto.payload.ProposalTx.prototype.hasProposal = function() {
 };
 
 
+/**
+ * optional bool Withdraw = 5;
+ * @return {boolean}
+ */
+proto.payload.ProposalTx.prototype.getWithdraw = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.payload.ProposalTx} returns this
+ */
+proto.payload.ProposalTx.prototype.setWithdraw = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
@@ -4203,7 +4233,8 @@ proto.payload.Proposal.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
     description: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    batchtx: (f = msg.getBatchtx()) && proto.payload.BatchTx.toObject(includeInstance, f)
+    batchtx: (f = msg.getBatchtx()) && proto.payload.BatchTx.toObject(includeInstance, f),
+    expiryheight: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -4253,6 +4284,10 @@ proto.payload.Proposal.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,proto.payload.BatchTx.deserializeBinaryFromReader);
       msg.setBatchtx(value);
       break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setExpiryheight(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4304,6 +4339,13 @@ proto.payload.Proposal.serializeBinaryToWriter = function(message, writer) {
       proto.payload.BatchTx.serializeBinaryToWriter
     );
   }
+  f = message.getExpiryheight();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -4380,6 +4422,24 @@ proto.payload.Proposal.prototype.hasBatchtx = function() {
 };
 
 
+/**
+ * optional uint64 ExpiryHeight = 4;
+ * @return {number}
+ */
+proto.payload.Proposal.prototype.getExpiryheight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.payload.Proposal} returns this
+ */
+proto.payload.Proposal.prototype.setExpiryheight = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
@@ -4546,7 +4606,8 @@ proto.payload.Ballot.serializeBinaryToWriter = function(message, writer) {
 proto.payload.Ballot.ProposalState = {
   PROPOSED: 0,
   EXECUTED: 1,
-  FAILED: 2
+  FAILED: 2,
+  EXPIRED: 3
 };
 
 /**
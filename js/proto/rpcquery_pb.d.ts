@@ -42,6 +42,8 @@ export class GetAccountParam extends jspb.Message {
     getAddress_asU8(): Uint8Array;
     getAddress_asB64(): string;
     setAddress(value: Uint8Array | string): GetAccountParam;
+    getHeight(): number;
+    setHeight(value: number): GetAccountParam;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GetAccountParam.AsObject;
@@ -56,6 +58,7 @@ export class GetAccountParam extends jspb.Message {
 export namespace GetAccountParam {
     export type AsObject = {
         address: Uint8Array | string,
+        height: number,
     }
 }
 
@@ -115,6 +118,8 @@ export class GetStorageParam extends jspb.Message {
     getKey_asU8(): Uint8Array;
     getKey_asB64(): string;
     setKey(value: Uint8Array | string): GetStorageParam;
+    getHeight(): number;
+    setHeight(value: number): GetStorageParam;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GetStorageParam.AsObject;
@@ -130,6 +135,7 @@ export namespace GetStorageParam {
     export type AsObject = {
         address: Uint8Array | string,
         key: Uint8Array | string,
+        height: number,
     }
 }
 
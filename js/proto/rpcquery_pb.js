@@ -707,7 +707,8 @@ proto.rpcquery.GetAccountParam.prototype.toObject = function(opt_includeInstance
  */
 proto.rpcquery.GetAccountParam.toObject = function(includeInstance, msg) {
   var f, obj = {
-    address: msg.getAddress_asB64()
+    address: msg.getAddress_asB64(),
+    height: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -748,6 +749,10 @@ proto.rpcquery.GetAccountParam.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
       msg.setAddress(value);
       break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setHeight(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -784,6 +789,13 @@ proto.rpcquery.GetAccountParam.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -829,6 +841,24 @@ proto.rpcquery.GetAccountParam.prototype.setAddress = function(value) {
 };
 
 
+/**
+ * optional uint64 Height = 2;
+ * @return {number}
+ */
+proto.rpcquery.GetAccountParam.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.rpcquery.GetAccountParam} returns this
+ */
+proto.rpcquery.GetAccountParam.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
 
 
 
@@ -1200,7 +1230,8 @@ proto.rpcquery.GetStorageParam.prototype.toObject = function(opt_includeInstance
 proto.rpcquery.GetStorageParam.toObject = function(includeInstance, msg) {
   var f, obj = {
     address: msg.getAddress_asB64(),
-    key: msg.getKey_asB64()
+    key: msg.getKey_asB64(),
+    height: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -1245,6 +1276,10 @@ proto.rpcquery.GetStorageParam.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
       msg.setKey(value);
       break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setHeight(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1288,6 +1323,13 @@ proto.rpcquery.GetStorageParam.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -1375,6 +1417,24 @@ proto.rpcquery.GetStorageParam.prototype.setKey = function(value) {
 };
 
 
+/**
+ * optional uint64 Height = 3;
+ * @return {number}
+ */
+proto.rpcquery.GetStorageParam.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.rpcquery.GetStorageParam} returns this
+ */
+proto.rpcquery.GetStorageParam.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
 
 
 
@@ -0,0 +1,322 @@
+package keys
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	hex "github.com/tmthrgd/go-hex"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+	keysServicePrefix   = "/keys.Keys/"
+)
+
+// ServerAuthConfig secures the standalone keys server
+type ServerAuthConfig struct {
+	// PEM-encoded server certificate and key, when set the server is served over TLS
+	TLSCertFile string
+	TLSKeyFile  string
+	// PEM-encoded CA certificates that client certificates must be signed by, when set clients must authenticate
+	// with mutual TLS
+	ClientCAFile string
+	// TOML file identifying clients by certificate or bearer token and restricting what they may do (see Policy). When
+	// not set any client that can connect, which ClientCAFile may restrict, can do anything.
+	PolicyFile string
+}
+
+// RemoteAuthConfig holds the credentials with which to connect to a secured keys server
+type RemoteAuthConfig struct {
+	// PEM-encoded CA certificates with which to verify the keys server, when set connections use TLS
+	CAFile string
+	// PEM-encoded client certificate and key for mutual TLS
+	TLSCertFile string
+	TLSKeyFile  string
+	// Bearer token identifying this client in the server's policy
+	Token string
+}
+
+// NewServer returns a keys server for keyStore authenticating and authorising clients according to conf, which
+// may be nil for an unsecured server. Every Sign request is audit logged to logger.
+func NewServer(keyStore *FilesystemKeyStore, conf *ServerAuthConfig, logger *logging.Logger) (*grpc.Server, error) {
+	auth := &authorizer{
+		keyStore: keyStore,
+		logger:   logger.WithScope("KeysServer"),
+	}
+	var opts []grpc.ServerOption
+	if conf != nil {
+		if conf.TLSCertFile != "" || conf.TLSKeyFile != "" {
+			tlsConfig, err := conf.tlsConfig()
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		} else if conf.ClientCAFile != "" {
+			return nil, fmt.Errorf("keys server ClientCAFile requires TLSCertFile and TLSKeyFile to be set")
+		}
+		if conf.PolicyFile != "" {
+			policy, err := LoadPolicy(conf.PolicyFile)
+			if err != nil {
+				return nil, err
+			}
+			if conf.TLSCertFile == "" {
+				auth.logger.InfoMsg("Keys server bearer tokens are being sent without TLS, " +
+					"set TLSCertFile and TLSKeyFile unless clients connect over a trusted network")
+			}
+			auth.policy = policy
+		}
+	}
+	opts = append(opts, grpc.UnaryInterceptor(auth.unaryInterceptor))
+	grpcServer := grpc.NewServer(opts...)
+	RegisterKeysServer(grpcServer, keyStore)
+	return grpcServer, nil
+}
+
+func (conf *ServerAuthConfig) tlsConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(conf.TLSCertFile, conf.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not load keys server certificate: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if conf.ClientCAFile != "" {
+		tlsConfig.ClientCAs, err = loadCertPool(conf.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		if conf.PolicyFile != "" {
+			// Clients may instead identify themselves with a bearer token
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+	return tlsConfig, nil
+}
+
+// Dial connects to the keys server at address using these credentials, conf may be nil for an unsecured server
+func (conf *RemoteAuthConfig) Dial(address string) (*grpc.ClientConn, error) {
+	if conf == nil {
+		return encoding.GRPCDial(address)
+	}
+	if conf.CAFile == "" {
+		if conf.TLSCertFile != "" || conf.TLSKeyFile != "" {
+			return nil, fmt.Errorf("a client certificate for the keys server requires CAFile to be set")
+		}
+		if conf.Token == "" {
+			return encoding.GRPCDial(address)
+		}
+		return encoding.GRPCDial(address, grpc.WithPerRPCCredentials(bearerToken{token: conf.Token}))
+	}
+	rootCAs, err := loadCertPool(conf.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		RootCAs:    rootCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	if conf.TLSCertFile != "" || conf.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(conf.TLSCertFile, conf.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not load keys client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithDefaultCallOptions(grpc.CallContentSubtype(encoding.GRPCCodecName)),
+	}
+	if conf.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken{token: conf.Token, requireTLS: true}))
+	}
+	return grpc.Dial(address, opts...)
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read CA certificates: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM certificates found in %s", caFile)
+	}
+	return pool, nil
+}
+
+type bearerToken struct {
+	token      string
+	requireTLS bool
+}
+
+func (bt bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{authorizationHeader: bearerPrefix + bt.token}, nil
+}
+
+func (bt bearerToken) RequireTransportSecurity() bool {
+	return bt.requireTLS
+}
+
+type authorizer struct {
+	// When nil any client that can connect may call any method, though mutual TLS may have restricted who can connect
+	policy   *Policy
+	keyStore *FilesystemKeyStore
+	logger   *logging.Logger
+}
+
+func (auth *authorizer) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if !strings.HasPrefix(info.FullMethod, keysServicePrefix) {
+		return handler(ctx, req)
+	}
+	method := strings.TrimPrefix(info.FullMethod, keysServicePrefix)
+	client, err := auth.authenticate(ctx)
+	if err != nil {
+		auth.logger.InfoMsg("Rejected unauthenticated keys server request", "method", method,
+			structure.ErrorKey, err)
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if signRequest, ok := req.(*SignRequest); ok {
+		err = auth.authorizeSign(client, signRequest)
+		// Every signing request is audited whether or not it is allowed
+		keyvals := []interface{}{
+			"client", client.Name,
+			"peer", peerAddress(ctx),
+			"key_name", signRequest.GetName(),
+			"address", signRequest.GetAddress(),
+			"message_sha256", messageHash(signRequest.GetMessage()),
+			"allowed", err == nil,
+		}
+		if err != nil {
+			keyvals = append(keyvals, structure.ErrorKey, err)
+		}
+		auth.logger.InfoMsg("Sign request", keyvals...)
+	} else {
+		err = auth.authorize(client, method)
+	}
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return handler(ctx, req)
+}
+
+func (auth *authorizer) authenticate(ctx context.Context) (*ClientPolicy, error) {
+	commonName := verifiedCommonName(ctx)
+	if auth.policy == nil {
+		return &ClientPolicy{Name: commonName, CommonName: commonName}, nil
+	}
+	if client := auth.policy.ClientForCommonName(commonName); client != nil {
+		return client, nil
+	}
+	if client := auth.policy.ClientForToken(bearerTokenFromContext(ctx)); client != nil {
+		return client, nil
+	}
+	if commonName != "" {
+		return nil, fmt.Errorf("client certificate common name '%s' is not in the keys server policy", commonName)
+	}
+	return nil, fmt.Errorf("a client certificate or bearer token in the keys server policy is required")
+}
+
+func (auth *authorizer) authorize(client *ClientPolicy, method string) error {
+	if auth.policy == nil {
+		return nil
+	}
+	// Deny by default so that a method added to the service is not open to every client until it is listed here
+	switch method {
+	case "PublicKey", "Verify", "Hash", "List", "GenerateMnemonic":
+		return nil
+	case "Export":
+		if !client.AllowExport {
+			return fmt.Errorf("client %s may not export keys", client.Name)
+		}
+		return nil
+	case "GenerateKey", "Import", "ImportJSON", "ImportMnemonic", "AddName", "RemoveName":
+		if !client.AllowKeyManagement {
+			return fmt.Errorf("client %s may not call %s since it does not have key management permission",
+				client.Name, method)
+		}
+		return nil
+	default:
+		return fmt.Errorf("client %s may not call %s since it is not covered by the keys server policy",
+			client.Name, method)
+	}
+}
+
+func (auth *authorizer) authorizeSign(client *ClientPolicy, in *SignRequest) error {
+	if auth.policy == nil {
+		return nil
+	}
+	// Names take precedence over addresses when signing
+	if in.GetName() != "" {
+		if client.CanSignWith(in.GetName()) {
+			return nil
+		}
+		return fmt.Errorf("client %s may not sign with key %s", client.Name, in.GetName())
+	}
+	names, err := coreNameList(auth.keyStore.keysDirPath)
+	if err != nil {
+		return err
+	}
+	address := strings.ToUpper(in.GetAddress())
+	for name, addr := range names {
+		if strings.ToUpper(addr) == address && client.CanSignWith(name) {
+			return nil
+		}
+	}
+	return fmt.Errorf("client %s may not sign with address %s", client.Name, in.GetAddress())
+}
+
+func verifiedCommonName(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+}
+
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	return p.Addr.String()
+}
+
+func bearerTokenFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get(authorizationHeader) {
+		if strings.HasPrefix(value, bearerPrefix) {
+			return strings.TrimPrefix(value, bearerPrefix)
+		}
+	}
+	return ""
+}
+
+func messageHash(message []byte) string {
+	hash := sha256.Sum256(message)
+	return hex.EncodeUpperToString(hash[:])
+}
@@ -0,0 +1,263 @@
+package keys
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServerMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir)
+	conf := &ServerAuthConfig{ClientCAFile: ca.certFile}
+	conf.TLSCertFile, conf.TLSKeyFile = ca.issue(t, "localhost")
+	address, keyStore := startTestServer(t, conf, logging.NewNoopLogger())
+
+	key, err := keyStore.Gen("", crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+
+	clientCert, clientKey := ca.issue(t, "anyone")
+	cli := dialTestServer(t, address, &RemoteAuthConfig{CAFile: ca.certFile, TLSCertFile: clientCert,
+		TLSKeyFile: clientKey})
+	_, err = cli.Sign(context.Background(), &SignRequest{Address: key.Address.String(), Message: []byte("hi")})
+	require.NoError(t, err)
+
+	// Without a client certificate the TLS handshake fails
+	cli = dialTestServer(t, address, &RemoteAuthConfig{CAFile: ca.certFile})
+	_, err = cli.Sign(context.Background(), &SignRequest{Address: key.Address.String(), Message: []byte("hi")})
+	require.Error(t, err)
+
+	// Nor will the server talk plaintext
+	cli = dialTestServer(t, address, nil)
+	_, err = cli.List(context.Background(), &ListRequest{})
+	require.Error(t, err)
+}
+
+func TestServerPolicy(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir)
+	tokenHash := sha256.Sum256([]byte("admin-token"))
+	policyFile := filepath.Join(dir, "policy.toml")
+	require.NoError(t, ioutil.WriteFile(policyFile, []byte(`
+[[Clients]]
+Name = "validator"
+CommonName = "validator.example.com"
+SignKeyNames = ["validator-*"]
+
+[[Clients]]
+Name = "admin"
+TokenSHA256 = "`+hex.EncodeToString(tokenHash[:])+`"
+SignKeyNames = ["*"]
+AllowKeyManagement = true
+AllowExport = true
+`), 0600))
+
+	conf := &ServerAuthConfig{ClientCAFile: ca.certFile, PolicyFile: policyFile}
+	conf.TLSCertFile, conf.TLSKeyFile = ca.issue(t, "localhost")
+	audit := new(auditLog)
+	address, _ := startTestServer(t, conf, logging.NewLogger(audit))
+	ctx := context.Background()
+
+	admin := dialTestServer(t, address, &RemoteAuthConfig{CAFile: ca.certFile, Token: "admin-token"})
+	validatorKey, err := admin.GenerateKey(ctx, &GenRequest{CurveType: "ed25519", KeyName: "validator-1"})
+	require.NoError(t, err)
+	otherKey, err := admin.GenerateKey(ctx, &GenRequest{CurveType: "ed25519", KeyName: "other"})
+	require.NoError(t, err)
+	_, err = admin.Export(ctx, &ExportRequest{Name: "other"})
+	require.NoError(t, err)
+
+	certFile, keyFile := ca.issue(t, "validator.example.com")
+	validator := dialTestServer(t, address, &RemoteAuthConfig{CAFile: ca.certFile, TLSCertFile: certFile,
+		TLSKeyFile: keyFile})
+	_, err = validator.Sign(ctx, &SignRequest{Name: "validator-1", Message: []byte("block")})
+	require.NoError(t, err)
+	_, err = validator.Sign(ctx, &SignRequest{Address: validatorKey.Address, Message: []byte("block")})
+	require.NoError(t, err)
+	_, err = validator.Sign(ctx, &SignRequest{Address: otherKey.Address, Message: []byte("block")})
+	assertCode(t, codes.PermissionDenied, err)
+	_, err = validator.Sign(ctx, &SignRequest{Name: "other", Message: []byte("block")})
+	assertCode(t, codes.PermissionDenied, err)
+	_, err = validator.Export(ctx, &ExportRequest{Name: "validator-1"})
+	assertCode(t, codes.PermissionDenied, err)
+	_, err = validator.AddName(ctx, &AddNameRequest{Keyname: "validator-2", Address: otherKey.Address})
+	assertCode(t, codes.PermissionDenied, err)
+	_, err = validator.PublicKey(ctx, &PubRequest{Name: "other"})
+	require.NoError(t, err)
+	_, err = validator.List(ctx, &ListRequest{})
+	require.NoError(t, err)
+
+	// Methods the policy does not know about are denied
+	policy, err := LoadPolicy(policyFile)
+	require.NoError(t, err)
+	auth := &authorizer{policy: policy}
+	require.Error(t, auth.authorize(policy.Clients[1], "Unknown"))
+	require.NoError(t, auth.authorize(policy.Clients[1], "Export"))
+
+	certFile, keyFile = ca.issue(t, "stranger")
+	stranger := dialTestServer(t, address, &RemoteAuthConfig{CAFile: ca.certFile, TLSCertFile: certFile,
+		TLSKeyFile: keyFile, Token: "guess"})
+	_, err = stranger.List(ctx, &ListRequest{})
+	assertCode(t, codes.Unauthenticated, err)
+
+	signs := audit.messages("Sign request")
+	require.Len(t, signs, 4)
+	assert.Equal(t, "validator", signs[0]["client"])
+	assert.Equal(t, "validator-1", signs[0]["key_name"])
+	assert.Equal(t, true, signs[0]["allowed"])
+	assert.Equal(t, validatorKey.Address, signs[1]["address"])
+	assert.Equal(t, false, signs[2]["allowed"])
+	assert.Equal(t, false, signs[3]["allowed"])
+}
+
+func TestLoadPolicy(t *testing.T) {
+	dir := t.TempDir()
+	for _, invalid := range []string{
+		`[[Clients]]
+CommonName = "no name"`,
+		`[[Clients]]
+Name = "unidentified"`,
+		`[[Clients]]
+Name = "short"
+TokenSHA256 = "abcd"`,
+		`[[Clients]]
+Name = "bad-pattern"
+CommonName = "x"
+SignKeyNames = ["["]`,
+	} {
+		policyFile := filepath.Join(dir, "policy.toml")
+		require.NoError(t, ioutil.WriteFile(policyFile, []byte(invalid), 0600))
+		_, err := LoadPolicy(policyFile)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func startTestServer(t *testing.T, conf *ServerAuthConfig, logger *logging.Logger) (string, *FilesystemKeyStore) {
+	keyStore := NewFilesystemKeyStore(t.TempDir(), false)
+	server, err := NewServer(keyStore, conf, logger)
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return listener.Addr().String(), keyStore
+}
+
+func dialTestServer(t *testing.T, address string, conf *RemoteAuthConfig) KeysClient {
+	conn, err := conf.Dial(address)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return NewKeysClient(conn)
+}
+
+func assertCode(t *testing.T, code codes.Code, err error) {
+	t.Helper()
+	require.Error(t, err)
+	assert.Equal(t, code, status.Code(err), err.Error())
+}
+
+type testCA struct {
+	dir      string
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certFile string
+	serial   int64
+}
+
+func newTestCA(t *testing.T, dir string) *testCA {
+	ca := &testCA{dir: dir}
+	ca.cert, ca.key, ca.certFile, _ = ca.write(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	})
+	return ca
+}
+
+// Issues a certificate valid for both server and client authentication
+func (ca *testCA) issue(t *testing.T, commonName string) (certFile, keyFile string) {
+	_, _, certFile, keyFile = ca.write(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: commonName},
+		DNSNames:    []string{commonName},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	})
+	return
+}
+
+func (ca *testCA) write(t *testing.T, template *x509.Certificate) (*x509.Certificate, *ecdsa.PrivateKey, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ca.serial++
+	template.SerialNumber = big.NewInt(ca.serial)
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	parent, signer := template, key
+	if ca.cert != nil {
+		parent, signer = ca.cert, ca.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	name := filepath.Join(ca.dir, template.Subject.CommonName)
+	certFile, keyFile := name+".crt", name+".key"
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		0600))
+	return cert, key, certFile, keyFile
+}
+
+// Captures log lines as maps
+type auditLog struct {
+	sync.Mutex
+	lines []map[string]interface{}
+}
+
+func (al *auditLog) Log(keyvals ...interface{}) error {
+	al.Lock()
+	defer al.Unlock()
+	line := make(map[string]interface{})
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		line[keyvals[i].(string)] = keyvals[i+1]
+	}
+	al.lines = append(al.lines, line)
+	return nil
+}
+
+func (al *auditLog) messages(message string) []map[string]interface{} {
+	al.Lock()
+	defer al.Unlock()
+	var lines []map[string]interface{}
+	for _, line := range al.lines {
+		if line["message"] == message {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
@@ -5,6 +5,12 @@ type KeysConfig struct {
 	AllowBadFilePermissions bool
 	RemoteAddress           string
 	KeysDirectory           string
+	// Secures the standalone keys server (burrow keys server)
+	ServerAuth *ServerAuthConfig `json:",omitempty" toml:",omitempty"`
+	// Credentials for the keys server at RemoteAddress
+	RemoteAuth *RemoteAuthConfig `json:",omitempty" toml:",omitempty"`
+	// Sign with keys held on a PKCS#11 token rather than in KeysDirectory
+	PKCS11 *PKCS11Config `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultKeysConfig() *KeysConfig {
@@ -0,0 +1,122 @@
+// Package hd implements BIP-39 mnemonics and hierarchical deterministic key derivation: BIP-32 for secp256k1 and
+// SLIP-10 for ed25519
+package hd
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/ed25519"
+)
+
+// DefaultEntropyBits gives a 24 word mnemonic
+const DefaultEntropyBits = 256
+
+var (
+	secp256k1SeedKey = []byte("Bitcoin seed")
+	ed25519SeedKey   = []byte("ed25519 seed")
+)
+
+// NewMnemonic generates a random BIP-39 mnemonic in English from entropy of bits, which must be a multiple of 32
+// between 128 and 256
+func NewMnemonic(bits int) (string, error) {
+	entropy, err := bip39.NewEntropy(bits)
+	if err != nil {
+		return "", fmt.Errorf("could not generate entropy for mnemonic: %w", err)
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// Seed checks the mnemonic and returns its BIP-39 seed, salted with the optional password
+func Seed(mnemonic, password string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, password)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %w", err)
+	}
+	return seed, nil
+}
+
+// DerivePrivateKey derives the private key at path from seed
+func DerivePrivateKey(seed []byte, path Path, curveType crypto.CurveType) (crypto.PrivateKey, error) {
+	switch curveType {
+	case crypto.CurveTypeSecp256k1:
+		key, err := deriveSecp256k1(seed, path)
+		if err != nil {
+			return crypto.PrivateKey{}, err
+		}
+		return crypto.PrivateKeyFromRawBytes(key, crypto.CurveTypeSecp256k1)
+	case crypto.CurveTypeEd25519:
+		key, err := deriveEd25519(seed, path)
+		if err != nil {
+			return crypto.PrivateKey{}, err
+		}
+		return crypto.PrivateKeyFromRawBytes(ed25519.NewKeyFromSeed(key), crypto.CurveTypeEd25519)
+	default:
+		return crypto.PrivateKey{}, crypto.ErrInvalidCurve(curveType)
+	}
+}
+
+// deriveSecp256k1 follows BIP-32 returning the 32 byte private key at path
+func deriveSecp256k1(seed []byte, path Path) ([]byte, error) {
+	n := btcec.S256().N
+	key, chainCode := split(hmacSHA512(secp256k1SeedKey, seed))
+	k := new(big.Int).SetBytes(key)
+	if k.Sign() == 0 || k.Cmp(n) >= 0 {
+		return nil, fmt.Errorf("seed gives invalid secp256k1 master key")
+	}
+	for _, index := range path {
+		var data []byte
+		if index >= HardenedOffset {
+			data = append([]byte{0}, key...)
+		} else {
+			_, pub := btcec.PrivKeyFromBytes(btcec.S256(), key)
+			data = pub.SerializeCompressed()
+		}
+		var tweak []byte
+		tweak, chainCode = split(hmacSHA512(chainCode, appendIndex(data, index)))
+		t := new(big.Int).SetBytes(tweak)
+		k.Add(k, t).Mod(k, n)
+		// Per BIP-32 these keys are invalid and the next index should be used, which happens with probability < 2^-127
+		if t.Cmp(n) >= 0 || k.Sign() == 0 {
+			return nil, fmt.Errorf("invalid secp256k1 key at index %d of path %v, try the next index", index, path)
+		}
+		key = make([]byte, btcec.PrivKeyBytesLen)
+		k.FillBytes(key)
+	}
+	return key, nil
+}
+
+// deriveEd25519 follows SLIP-10 returning the 32 byte ed25519 seed at path
+func deriveEd25519(seed []byte, path Path) ([]byte, error) {
+	key, chainCode := split(hmacSHA512(ed25519SeedKey, seed))
+	for _, index := range path {
+		if index < HardenedOffset {
+			return nil, fmt.Errorf("ed25519 only supports hardened derivation but path %v has unhardened index %d",
+				path, index)
+		}
+		key, chainCode = split(hmacSHA512(chainCode, appendIndex(append([]byte{0}, key...), index)))
+	}
+	return key, nil
+}
+
+func appendIndex(data []byte, index uint32) []byte {
+	bs := make([]byte, 4)
+	binary.BigEndian.PutUint32(bs, index)
+	return append(data, bs...)
+}
+
+func hmacSHA512(key, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+func split(bs []byte) ([]byte, []byte) {
+	return bs[:32], bs[32:]
+}
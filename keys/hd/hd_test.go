@@ -0,0 +1,106 @@
+package hd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+	"golang.org/x/crypto/ed25519"
+)
+
+// BIP-32 and SLIP-10 test vector 1
+const testSeed = "000102030405060708090a0b0c0d0e0f"
+
+func TestMnemonic(t *testing.T) {
+	mnemonic, err := NewMnemonic(DefaultEntropyBits)
+	require.NoError(t, err)
+	assert.Len(t, strings.Fields(mnemonic), 24)
+	_, err = Seed(mnemonic, "")
+	require.NoError(t, err)
+
+	// From the BIP-39 test vectors
+	seed, err := Seed(strings.Repeat("abandon ", 11)+"about", "TREZOR")
+	require.NoError(t, err)
+	assert.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		hex.EncodeToString(seed))
+
+	_, err = Seed(strings.Repeat("abandon ", 12), "")
+	require.Error(t, err, "checksum should fail")
+	_, err = NewMnemonic(100)
+	require.Error(t, err)
+}
+
+func TestDeriveSecp256k1(t *testing.T) {
+	seed := hex.MustDecodeString(testSeed)
+	for path, expected := range map[string]string{
+		"m":                      "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+		"m/0'":                   "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+		"m/0'/1":                 "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368",
+		"m/0H/1/2h/2/1000000000": "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8",
+	} {
+		key, err := DerivePrivateKey(seed, mustParsePath(t, path), crypto.CurveTypeSecp256k1)
+		require.NoError(t, err)
+		assert.Equal(t, expected, hex.EncodeToString(key.RawBytes()), path)
+	}
+}
+
+func TestDeriveEd25519(t *testing.T) {
+	seed := hex.MustDecodeString(testSeed)
+	for path, expected := range map[string]string{
+		"m":    "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+		"m/0'": "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+	} {
+		key, err := DerivePrivateKey(seed, mustParsePath(t, path), crypto.CurveTypeEd25519)
+		require.NoError(t, err)
+		assert.Equal(t, expected, hex.EncodeToString(ed25519.PrivateKey(key.RawBytes()).Seed()), path)
+	}
+
+	_, err := DerivePrivateKey(seed, mustParsePath(t, "m/0'/1"), crypto.CurveTypeEd25519)
+	require.Error(t, err)
+}
+
+func TestMetaMaskAddresses(t *testing.T) {
+	// The default mnemonic of Hardhat whose accounts are also those derived by MetaMask
+	seed, err := Seed("test test test test test test test test test test test junk", "")
+	require.NoError(t, err)
+	for index, expected := range []string{
+		"F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266",
+		"70997970C51812DC3A010C7D01B50E0D17DC79C8",
+	} {
+		key, err := DerivePrivateKey(seed, DefaultPath(crypto.CurveTypeSecp256k1, uint32(index)),
+			crypto.CurveTypeSecp256k1)
+		require.NoError(t, err)
+		assert.Equal(t, expected, key.GetPublicKey().GetAddress().String())
+	}
+}
+
+func TestPath(t *testing.T) {
+	assert.Equal(t, "m/44'/60'/0'/0/3", DefaultPath(crypto.CurveTypeSecp256k1, 3).String())
+	assert.Equal(t, "m/44'/60'/0'/0'/3'", DefaultPath(crypto.CurveTypeEd25519, 3).String())
+
+	path := mustParsePath(t, "m/44h/60H/0'/0/7")
+	assert.Equal(t, DefaultPath(crypto.CurveTypeSecp256k1, 7), path)
+	next, err := path.Offset(2)
+	require.NoError(t, err)
+	assert.Equal(t, "m/44'/60'/0'/0/9", next.String())
+	assert.Equal(t, "m/44'/60'/0'/0/7", path.String(), "offset should copy")
+
+	_, err = mustParsePath(t, "m/2147483647").Offset(1)
+	require.Error(t, err)
+	_, err = Path{}.Offset(1)
+	require.Error(t, err)
+
+	for _, invalid := range []string{"", "44'/60'", "m/-1", "m/2147483648", "m/1x", "m//1"} {
+		_, err := ParsePath(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func mustParsePath(t *testing.T, str string) Path {
+	path, err := ParsePath(str)
+	require.NoError(t, err)
+	return path
+}
@@ -0,0 +1,88 @@
+package hd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// HardenedOffset is added to the index of a path component to use hardened derivation
+const HardenedOffset uint32 = 0x80000000
+
+const (
+	purposeBIP44 = 44
+	// Ethereum's SLIP-44 coin type, used so that secp256k1 accounts match those derived by Ethereum wallets
+	coinTypeEthereum = 60
+)
+
+// Path is a BIP-32 derivation path of child indices, with hardened indices offset by HardenedOffset
+type Path []uint32
+
+// DefaultPath returns the BIP-44 path of the account at index. For secp256k1 this is m/44'/60'/0'/0/index as used by
+// MetaMask and other Ethereum wallets. SLIP-10 only defines hardened derivation for ed25519 so every component is
+// hardened: m/44'/60'/0'/0'/index'.
+func DefaultPath(curveType crypto.CurveType, index uint32) Path {
+	path := Path{purposeBIP44 + HardenedOffset, coinTypeEthereum + HardenedOffset, HardenedOffset, 0, index}
+	if curveType == crypto.CurveTypeEd25519 {
+		path[3] += HardenedOffset
+		path[4] += HardenedOffset
+	}
+	return path
+}
+
+// ParsePath parses a path of the form m/44'/60'/0'/0/0 where a hardened index is marked with ', h, or H
+func ParsePath(str string) (Path, error) {
+	components := strings.Split(strings.TrimSpace(str), "/")
+	if components[0] != "m" {
+		return nil, fmt.Errorf("derivation path '%s' should start with 'm'", str)
+	}
+	path := make(Path, len(components)-1)
+	for i, component := range components[1:] {
+		hardened := false
+		if strings.HasSuffix(component, "'") || strings.HasSuffix(component, "h") || strings.HasSuffix(component, "H") {
+			hardened = true
+			component = component[:len(component)-1]
+		}
+		index, err := strconv.ParseUint(component, 10, 32)
+		if err != nil || uint32(index) >= HardenedOffset {
+			return nil, fmt.Errorf("invalid index '%s' in derivation path '%s'", components[i+1], str)
+		}
+		path[i] = uint32(index)
+		if hardened {
+			path[i] += HardenedOffset
+		}
+	}
+	return path, nil
+}
+
+// Offset returns a copy of the path with n added to its last index, for deriving consecutive accounts
+func (p Path) Offset(n uint32) (Path, error) {
+	if len(p) == 0 {
+		return nil, fmt.Errorf("cannot offset the master key path")
+	}
+	last := p[len(p)-1]
+	if uint64(last%HardenedOffset)+uint64(n) >= uint64(HardenedOffset) {
+		return nil, fmt.Errorf("offsetting path %v by %d overflows its last index", p, n)
+	}
+	path := make(Path, len(p))
+	copy(path, p)
+	path[len(path)-1] += n
+	return path, nil
+}
+
+func (p Path) String() string {
+	var sb strings.Builder
+	sb.WriteString("m")
+	for _, index := range p {
+		sb.WriteString("/")
+		if index >= HardenedOffset {
+			sb.WriteString(strconv.FormatUint(uint64(index-HardenedOffset), 10))
+			sb.WriteString("'")
+		} else {
+			sb.WriteString(strconv.FormatUint(uint64(index), 10))
+		}
+	}
+	return sb.String()
+}
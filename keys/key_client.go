@@ -8,8 +8,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hyperledger/burrow/encoding"
-
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/logging"
 )
@@ -163,8 +161,13 @@ func (l *remoteKeyClient) HealthCheck() error {
 
 // NewRemoteKeyClient returns a new keys client for provided rpc location
 func NewRemoteKeyClient(rpcAddress string, logger *logging.Logger) (KeyClient, error) {
+	return NewRemoteKeyClientWithAuth(rpcAddress, nil, logger)
+}
+
+// NewRemoteKeyClientWithAuth returns a new keys client for a keys server secured with TLS or a bearer token
+func NewRemoteKeyClientWithAuth(rpcAddress string, auth *RemoteAuthConfig, logger *logging.Logger) (KeyClient, error) {
 	logger = logger.WithScope("RemoteKeyClient")
-	conn, err := encoding.GRPCDial(rpcAddress)
+	conn, err := auth.Dial(rpcAddress)
 	if err != nil {
 		return nil, err
 	}
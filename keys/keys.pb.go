@@ -459,8 +459,11 @@ func (*PubResponse) XXX_MessageName() string {
 }
 
 type ImportJSONRequest struct {
-	Passphrase           string   `protobuf:"bytes,1,opt,name=Passphrase,proto3" json:"Passphrase,omitempty"`
+	// Passphrase with which to encrypt the key, also used to decrypt Ethereum V3 keystores
+	Passphrase string `protobuf:"bytes,1,opt,name=Passphrase,proto3" json:"Passphrase,omitempty"`
+	// Burrow key JSON or an Ethereum V3 keystore
 	JSON                 string   `protobuf:"bytes,2,opt,name=JSON,proto3" json:"JSON,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -509,6 +512,13 @@ func (m *ImportJSONRequest) GetJSON() string {
 	return ""
 }
 
+func (m *ImportJSONRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 func (*ImportJSONRequest) XXX_MessageName() string {
 	return "keys.ImportJSONRequest"
 }
@@ -632,9 +642,11 @@ func (*ImportRequest) XXX_MessageName() string {
 }
 
 type ExportRequest struct {
-	Passphrase           string   `protobuf:"bytes,1,opt,name=Passphrase,proto3" json:"Passphrase,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Address              string   `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	Passphrase string `protobuf:"bytes,1,opt,name=Passphrase,proto3" json:"Passphrase,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Address    string `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	// Set to "v3" to export a secp256k1 key as an Ethereum V3 keystore encrypted with Passphrase rather than as raw bytes
+	Format               string   `protobuf:"bytes,4,opt,name=Format,proto3" json:"Format,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -690,15 +702,25 @@ func (m *ExportRequest) GetAddress() string {
 	return ""
 }
 
+func (m *ExportRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
 func (*ExportRequest) XXX_MessageName() string {
 	return "keys.ExportRequest"
 }
 
 type ExportResponse struct {
-	Publickey            []byte   `protobuf:"bytes,1,opt,name=Publickey,proto3" json:"Publickey,omitempty"`
-	Privatekey           []byte   `protobuf:"bytes,2,opt,name=Privatekey,proto3" json:"Privatekey,omitempty"`
-	Address              []byte   `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
-	CurveType            string   `protobuf:"bytes,4,opt,name=CurveType,proto3" json:"CurveType,omitempty"`
+	Publickey []byte `protobuf:"bytes,1,opt,name=Publickey,proto3" json:"Publickey,omitempty"`
+	// Unset when exporting a V3 keystore
+	Privatekey []byte `protobuf:"bytes,2,opt,name=Privatekey,proto3" json:"Privatekey,omitempty"`
+	Address    []byte `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	CurveType  string `protobuf:"bytes,4,opt,name=CurveType,proto3" json:"CurveType,omitempty"`
+	// The V3 keystore when requested by Format
+	JSON                 string   `protobuf:"bytes,5,opt,name=JSON,proto3" json:"JSON,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -761,6 +783,13 @@ func (m *ExportResponse) GetCurveType() string {
 	return ""
 }
 
+func (m *ExportResponse) GetJSON() string {
+	if m != nil {
+		return m.JSON
+	}
+	return ""
+}
+
 func (*ExportResponse) XXX_MessageName() string {
 	return "keys.ExportResponse"
 }
@@ -1204,6 +1233,304 @@ func (m *AddNameRequest) GetAddress() string {
 func (*AddNameRequest) XXX_MessageName() string {
 	return "keys.AddNameRequest"
 }
+
+type GenerateMnemonicRequest struct {
+	// Bits of entropy, a multiple of 32 between 128 and 256 (defaults to 256 giving 24 words)
+	EntropyBits          uint32   `protobuf:"varint,1,opt,name=EntropyBits,proto3" json:"EntropyBits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateMnemonicRequest) Reset()         { *m = GenerateMnemonicRequest{} }
+func (m *GenerateMnemonicRequest) String() string { return proto.CompactTextString(m) }
+func (*GenerateMnemonicRequest) ProtoMessage()    {}
+func (*GenerateMnemonicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{22}
+}
+func (m *GenerateMnemonicRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenerateMnemonicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GenerateMnemonicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateMnemonicRequest.Merge(m, src)
+}
+func (m *GenerateMnemonicRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenerateMnemonicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateMnemonicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateMnemonicRequest proto.InternalMessageInfo
+
+func (m *GenerateMnemonicRequest) GetEntropyBits() uint32 {
+	if m != nil {
+		return m.EntropyBits
+	}
+	return 0
+}
+
+func (*GenerateMnemonicRequest) XXX_MessageName() string {
+	return "keys.GenerateMnemonicRequest"
+}
+
+type GenerateMnemonicResponse struct {
+	Mnemonic             string   `protobuf:"bytes,1,opt,name=Mnemonic,proto3" json:"Mnemonic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateMnemonicResponse) Reset()         { *m = GenerateMnemonicResponse{} }
+func (m *GenerateMnemonicResponse) String() string { return proto.CompactTextString(m) }
+func (*GenerateMnemonicResponse) ProtoMessage()    {}
+func (*GenerateMnemonicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{23}
+}
+func (m *GenerateMnemonicResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenerateMnemonicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GenerateMnemonicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateMnemonicResponse.Merge(m, src)
+}
+func (m *GenerateMnemonicResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenerateMnemonicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateMnemonicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateMnemonicResponse proto.InternalMessageInfo
+
+func (m *GenerateMnemonicResponse) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (*GenerateMnemonicResponse) XXX_MessageName() string {
+	return "keys.GenerateMnemonicResponse"
+}
+
+type ImportMnemonicRequest struct {
+	// Passphrase with which to encrypt the derived keys
+	Passphrase string `protobuf:"bytes,1,opt,name=Passphrase,proto3" json:"Passphrase,omitempty"`
+	Mnemonic   string `protobuf:"bytes,2,opt,name=Mnemonic,proto3" json:"Mnemonic,omitempty"`
+	// Optional BIP-39 password salting the seed
+	Password string `protobuf:"bytes,3,opt,name=Password,proto3" json:"Password,omitempty"`
+	// Curve of the derived keys (defaults to secp256k1)
+	CurveType string `protobuf:"bytes,4,opt,name=CurveType,proto3" json:"CurveType,omitempty"`
+	// BIP-32 path of the first key (defaults to the BIP-44 path of the first account)
+	Path string `protobuf:"bytes,5,opt,name=Path,proto3" json:"Path,omitempty"`
+	// Number of keys to derive by incrementing the last index of the path (defaults to 1)
+	Count uint32 `protobuf:"varint,6,opt,name=Count,proto3" json:"Count,omitempty"`
+	// Name for the key, suffixed by -<n> for the nth key when deriving more than one
+	KeyName              string   `protobuf:"bytes,7,opt,name=KeyName,proto3" json:"KeyName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportMnemonicRequest) Reset()         { *m = ImportMnemonicRequest{} }
+func (m *ImportMnemonicRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportMnemonicRequest) ProtoMessage()    {}
+func (*ImportMnemonicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{24}
+}
+func (m *ImportMnemonicRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportMnemonicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ImportMnemonicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportMnemonicRequest.Merge(m, src)
+}
+func (m *ImportMnemonicRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportMnemonicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportMnemonicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportMnemonicRequest proto.InternalMessageInfo
+
+func (m *ImportMnemonicRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *ImportMnemonicRequest) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *ImportMnemonicRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *ImportMnemonicRequest) GetCurveType() string {
+	if m != nil {
+		return m.CurveType
+	}
+	return ""
+}
+
+func (m *ImportMnemonicRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ImportMnemonicRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ImportMnemonicRequest) GetKeyName() string {
+	if m != nil {
+		return m.KeyName
+	}
+	return ""
+}
+
+func (*ImportMnemonicRequest) XXX_MessageName() string {
+	return "keys.ImportMnemonicRequest"
+}
+
+type DerivedKey struct {
+	Address              string   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DerivedKey) Reset()         { *m = DerivedKey{} }
+func (m *DerivedKey) String() string { return proto.CompactTextString(m) }
+func (*DerivedKey) ProtoMessage()    {}
+func (*DerivedKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{25}
+}
+func (m *DerivedKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivedKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DerivedKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedKey.Merge(m, src)
+}
+func (m *DerivedKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivedKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedKey proto.InternalMessageInfo
+
+func (m *DerivedKey) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DerivedKey) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (*DerivedKey) XXX_MessageName() string {
+	return "keys.DerivedKey"
+}
+
+type ImportMnemonicResponse struct {
+	Keys                 []*DerivedKey `protobuf:"bytes,1,rep,name=Keys,proto3" json:"Keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ImportMnemonicResponse) Reset()         { *m = ImportMnemonicResponse{} }
+func (m *ImportMnemonicResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportMnemonicResponse) ProtoMessage()    {}
+func (*ImportMnemonicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9084e97af2346a26, []int{26}
+}
+func (m *ImportMnemonicResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportMnemonicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ImportMnemonicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportMnemonicResponse.Merge(m, src)
+}
+func (m *ImportMnemonicResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportMnemonicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportMnemonicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportMnemonicResponse proto.InternalMessageInfo
+
+func (m *ImportMnemonicResponse) GetKeys() []*DerivedKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (*ImportMnemonicResponse) XXX_MessageName() string {
+	return "keys.ImportMnemonicResponse"
+}
 func init() {
 	proto.RegisterType((*ListRequest)(nil), "keys.ListRequest")
 	golang_proto.RegisterType((*ListRequest)(nil), "keys.ListRequest")
@@ -1249,61 +1576,84 @@ func init() {
 	golang_proto.RegisterType((*ListResponse)(nil), "keys.ListResponse")
 	proto.RegisterType((*AddNameRequest)(nil), "keys.AddNameRequest")
 	golang_proto.RegisterType((*AddNameRequest)(nil), "keys.AddNameRequest")
+	proto.RegisterType((*GenerateMnemonicRequest)(nil), "keys.GenerateMnemonicRequest")
+	golang_proto.RegisterType((*GenerateMnemonicRequest)(nil), "keys.GenerateMnemonicRequest")
+	proto.RegisterType((*GenerateMnemonicResponse)(nil), "keys.GenerateMnemonicResponse")
+	golang_proto.RegisterType((*GenerateMnemonicResponse)(nil), "keys.GenerateMnemonicResponse")
+	proto.RegisterType((*ImportMnemonicRequest)(nil), "keys.ImportMnemonicRequest")
+	golang_proto.RegisterType((*ImportMnemonicRequest)(nil), "keys.ImportMnemonicRequest")
+	proto.RegisterType((*DerivedKey)(nil), "keys.DerivedKey")
+	golang_proto.RegisterType((*DerivedKey)(nil), "keys.DerivedKey")
+	proto.RegisterType((*ImportMnemonicResponse)(nil), "keys.ImportMnemonicResponse")
+	golang_proto.RegisterType((*ImportMnemonicResponse)(nil), "keys.ImportMnemonicResponse")
 }
 
 func init() { proto.RegisterFile("keys.proto", fileDescriptor_9084e97af2346a26) }
 func init() { golang_proto.RegisterFile("keys.proto", fileDescriptor_9084e97af2346a26) }
 
 var fileDescriptor_9084e97af2346a26 = []byte{
-	// 766 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xd3, 0x4a,
-	0x14, 0x95, 0x63, 0xb7, 0xaf, 0xb9, 0x4e, 0xf3, 0x1a, 0xbf, 0x3e, 0xbd, 0xc8, 0xea, 0x8b, 0xaa,
-	0xd9, 0xb4, 0x42, 0x4a, 0x82, 0x52, 0x89, 0x05, 0x45, 0xaa, 0xfa, 0x81, 0x4a, 0x09, 0x94, 0x2a,
-	0x45, 0x2c, 0x90, 0x58, 0x38, 0xcd, 0x25, 0x89, 0xda, 0xc4, 0x61, 0xc6, 0x2e, 0xf5, 0x82, 0x2d,
-	0xbf, 0x8b, 0x65, 0x57, 0x88, 0x25, 0x4b, 0x94, 0xfe, 0x11, 0x34, 0x33, 0x9e, 0x78, 0xc6, 0x2d,
-	0x25, 0x12, 0xbb, 0xb9, 0x5f, 0x73, 0xee, 0xbd, 0x73, 0x72, 0x1c, 0x80, 0x73, 0x4c, 0x58, 0x63,
-	0x42, 0xc3, 0x28, 0xf4, 0x1c, 0x7e, 0xf6, 0x57, 0xfb, 0x61, 0x3f, 0x14, 0x8e, 0x26, 0x3f, 0xc9,
-	0x98, 0x5f, 0x3a, 0xa3, 0xc9, 0x24, 0x4a, 0x2d, 0xb2, 0x01, 0xee, 0x8b, 0x21, 0x8b, 0x3a, 0xf8,
-	0x21, 0x46, 0x16, 0x79, 0x55, 0xf8, 0xab, 0x8d, 0xc9, 0x71, 0x30, 0xc2, 0xaa, 0xb5, 0x6e, 0x6d,
-	0x16, 0x3b, 0xca, 0x24, 0x2b, 0x50, 0x7e, 0x83, 0x74, 0xf8, 0x3e, 0xe9, 0x20, 0x9b, 0x84, 0x63,
-	0x86, 0x64, 0x15, 0xbc, 0x0e, 0x8e, 0xc2, 0x4b, 0xe4, 0xf1, 0x99, 0xb7, 0x02, 0x7f, 0xef, 0xf6,
-	0x7a, 0x86, 0xab, 0x0e, 0x15, 0x3d, 0xf1, 0x77, 0x48, 0x3d, 0x80, 0x43, 0x1c, 0xab, 0xbc, 0x1a,
-	0xc0, 0x49, 0xc0, 0xd8, 0x64, 0x40, 0x03, 0xa6, 0x52, 0x35, 0x8f, 0xb7, 0x06, 0xc5, 0xfd, 0x98,
-	0x5e, 0xe2, 0xeb, 0x64, 0x82, 0xd5, 0x82, 0x08, 0x67, 0x0e, 0x1d, 0xc5, 0x36, 0x51, 0x36, 0xc0,
-	0x15, 0x28, 0xb2, 0x47, 0x9e, 0xb8, 0xdb, 0xeb, 0x51, 0x64, 0x4c, 0xb5, 0x93, 0x9a, 0xe4, 0x31,
-	0xc0, 0x49, 0xdc, 0xd5, 0xda, 0xbe, 0x3b, 0xcf, 0xf3, 0xc0, 0x11, 0x38, 0xb2, 0x07, 0x71, 0x26,
-	0x47, 0xe0, 0x8a, 0xda, 0x14, 0x64, 0x0d, 0x8a, 0x27, 0x71, 0xf7, 0x62, 0x78, 0xd6, 0xc6, 0x44,
-	0x94, 0x97, 0x3a, 0x99, 0xe3, 0xfe, 0x49, 0xc8, 0x21, 0x54, 0x8e, 0x46, 0x93, 0x90, 0x46, 0xcf,
-	0x4f, 0x5f, 0x1d, 0xcf, 0xbb, 0x1c, 0x0f, 0x1c, 0x9e, 0xae, 0x7a, 0xe2, 0x67, 0xf2, 0x00, 0xca,
-	0xf2, 0xa2, 0x39, 0x66, 0xff, 0x04, 0xcb, 0x2a, 0x77, 0x6e, 0xc0, 0xfc, 0x12, 0xcc, 0xb9, 0xec,
-	0xfc, 0x0b, 0xf9, 0xb0, 0xd4, 0xc6, 0x64, 0x2f, 0x89, 0x90, 0x55, 0x1d, 0xb1, 0x92, 0x99, 0x4d,
-	0xde, 0xc1, 0xf2, 0xd3, 0xab, 0x3f, 0x85, 0xd7, 0xa6, 0xb3, 0xcd, 0xe9, 0x3e, 0x5b, 0x50, 0x56,
-	0xf7, 0xe7, 0x5f, 0xe8, 0x3c, 0xff, 0x42, 0xe7, 0x98, 0x08, 0x78, 0x3a, 0xbc, 0x0c, 0x22, 0xe4,
-	0xe1, 0x82, 0x08, 0x6b, 0x9e, 0x3c, 0x54, 0x29, 0x23, 0x87, 0xb1, 0x03, 0x27, 0xff, 0xb6, 0x31,
-	0xb8, 0xa7, 0xc3, 0xfe, 0xdc, 0x94, 0xd7, 0x60, 0x0a, 0x77, 0x73, 0xd0, 0x36, 0xe7, 0x7f, 0x89,
-	0x8c, 0x05, 0x7d, 0x4c, 0xf7, 0xab, 0x4c, 0xb2, 0x03, 0x25, 0x09, 0x9b, 0x0e, 0xdf, 0x84, 0x22,
-	0xb7, 0x83, 0x28, 0xa6, 0xf2, 0x0a, 0xb7, 0x55, 0x69, 0xa4, 0x6a, 0x31, 0x0b, 0x74, 0xb2, 0x1c,
-	0x72, 0x05, 0xcb, 0x4a, 0x13, 0x64, 0xe7, 0x06, 0xc1, 0x0b, 0x79, 0x82, 0x6b, 0x9d, 0xd8, 0x46,
-	0x27, 0x26, 0xf2, 0xc2, 0x1c, 0xc8, 0xfb, 0xe0, 0x3e, 0x0b, 0xd8, 0x40, 0xe1, 0xfa, 0xb0, 0xc4,
-	0xcd, 0x88, 0x6f, 0x57, 0xee, 0x6b, 0x66, 0xeb, 0xa8, 0x05, 0x73, 0x7e, 0x02, 0x25, 0x79, 0x49,
-	0x3a, 0xbf, 0x07, 0x0e, 0xb7, 0xd3, 0x1b, 0xc4, 0x99, 0x6c, 0xc3, 0x42, 0x1b, 0x93, 0xa3, 0x83,
-	0x7b, 0x7e, 0xf8, 0x9a, 0xc6, 0x14, 0xd6, 0x6d, 0x5d, 0x63, 0xea, 0x50, 0x92, 0xe2, 0x9a, 0x02,
-	0xfc, 0x0f, 0xb6, 0xe4, 0x95, 0xbd, 0xe9, 0xb6, 0xdc, 0x86, 0x10, 0x6c, 0x71, 0x7b, 0x87, 0xfb,
-	0xc9, 0x01, 0x94, 0x67, 0xd2, 0xa9, 0x8b, 0xe4, 0xd8, 0x14, 0xc9, 0x71, 0x8e, 0xd5, 0x26, 0x07,
-	0x5a, 0x5f, 0x1d, 0x70, 0xda, 0x98, 0x30, 0xaf, 0x25, 0x14, 0x0e, 0x69, 0x10, 0x21, 0xdf, 0xfe,
-	0x8a, 0xc4, 0xcb, 0xa4, 0xd5, 0xaf, 0x68, 0x9e, 0xb4, 0xc3, 0x87, 0xda, 0x03, 0xaa, 0x8a, 0x4c,
-	0xfd, 0x54, 0x85, 0xae, 0x69, 0x75, 0x70, 0xf8, 0xb3, 0x78, 0x69, 0x48, 0xe3, 0xb1, 0xef, 0xe9,
-	0xae, 0x34, 0x7d, 0x0b, 0x16, 0x25, 0x65, 0xbc, 0x7f, 0x64, 0xd4, 0x20, 0x90, 0xbf, 0x6a, 0x3a,
-	0xb3, 0x22, 0x29, 0x43, 0xaa, 0xc8, 0x10, 0x25, 0x55, 0x94, 0x53, 0xb5, 0x6d, 0x80, 0x4c, 0x30,
-	0xbd, 0xff, 0xf4, 0x1c, 0x4d, 0x42, 0x7f, 0x51, 0xbc, 0x05, 0x8b, 0x52, 0x19, 0x14, 0xa2, 0xa1,
-	0x43, 0xaa, 0x28, 0x27, 0x1e, 0x75, 0xc9, 0x1f, 0xb5, 0x0a, 0x8d, 0xa0, 0x6a, 0x15, 0x06, 0xdd,
-	0x76, 0x00, 0xb2, 0xcf, 0xa2, 0x6a, 0xf0, 0xd6, 0x87, 0xd2, 0xaf, 0xde, 0x0e, 0x64, 0x78, 0x9c,
-	0x5e, 0x0a, 0x4f, 0xfb, 0x8e, 0x2b, 0x3c, 0x83, 0x7d, 0x8f, 0x04, 0x65, 0x04, 0x58, 0xda, 0xbf,
-	0xc9, 0x36, 0xff, 0xdf, 0x9c, 0x57, 0xd6, 0xed, 0x3d, 0xb9, 0x9e, 0xd6, 0xac, 0x6f, 0xd3, 0x9a,
-	0xf5, 0x7d, 0x5a, 0xb3, 0x7e, 0x4c, 0x6b, 0xd6, 0x97, 0x9b, 0x9a, 0x75, 0x7d, 0x53, 0xb3, 0xde,
-	0x92, 0xfe, 0x30, 0x1a, 0xc4, 0xdd, 0xc6, 0x59, 0x38, 0x6a, 0x0e, 0x92, 0x09, 0xd2, 0x0b, 0xec,
-	0xf5, 0x91, 0x36, 0xbb, 0x31, 0xa5, 0xe1, 0xc7, 0x26, 0xbf, 0xad, 0xbb, 0x28, 0xfe, 0x67, 0x6c,
-	0xfd, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x02, 0xda, 0xb5, 0x9f, 0x08, 0x00, 0x00,
+	// 970 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x06, 0x45, 0x49, 0xb1, 0x86, 0xb2, 0x6b, 0x6d, 0x95, 0x44, 0x60, 0x1d, 0xc1, 0x58, 0x14,
+	0x48, 0x50, 0xc0, 0x56, 0x61, 0x03, 0x39, 0xc4, 0x45, 0x83, 0xf8, 0xa7, 0xa9, 0xab, 0x26, 0x75,
+	0x99, 0xa2, 0x87, 0xf6, 0x44, 0x59, 0x5b, 0x89, 0x70, 0x44, 0xb2, 0xcb, 0xa5, 0x63, 0x1e, 0xfa,
+	0x22, 0x7d, 0x9a, 0x1e, 0x73, 0x6c, 0x6f, 0x3d, 0x16, 0xce, 0xbd, 0xcf, 0x50, 0xec, 0x1f, 0xb9,
+	0x4b, 0x2b, 0xb1, 0x80, 0xde, 0x76, 0xbe, 0x99, 0x9d, 0x6f, 0x76, 0x66, 0x67, 0x76, 0x01, 0x2e,
+	0x48, 0x91, 0xed, 0xa6, 0x34, 0x61, 0x09, 0x6a, 0xf2, 0xb5, 0xdf, 0x9f, 0x25, 0xb3, 0x44, 0x00,
+	0x23, 0xbe, 0x92, 0x3a, 0xbf, 0x7b, 0x4e, 0x8b, 0x94, 0x29, 0x09, 0x3f, 0x04, 0xef, 0xdb, 0x28,
+	0x63, 0x01, 0xf9, 0x35, 0x27, 0x19, 0x43, 0x03, 0xb8, 0x33, 0x26, 0xc5, 0xcb, 0x70, 0x41, 0x06,
+	0xce, 0xb6, 0xf3, 0xa8, 0x13, 0x68, 0x11, 0x6f, 0xc2, 0xc6, 0x8f, 0x84, 0x46, 0xbf, 0x14, 0x01,
+	0xc9, 0xd2, 0x24, 0xce, 0x08, 0xee, 0x03, 0x0a, 0xc8, 0x22, 0xb9, 0x24, 0x5c, 0x5f, 0xa2, 0x3d,
+	0xf8, 0xe8, 0xd9, 0x74, 0x6a, 0x41, 0x3b, 0xd0, 0x33, 0x0d, 0x6f, 0x63, 0x9a, 0x02, 0x3c, 0x27,
+	0xb1, 0xb6, 0x1b, 0x02, 0x9c, 0x85, 0x59, 0x96, 0xce, 0x69, 0x98, 0x69, 0x53, 0x03, 0x41, 0x5b,
+	0xd0, 0x39, 0xca, 0xe9, 0x25, 0xf9, 0xa1, 0x48, 0xc9, 0xa0, 0x21, 0xd4, 0x15, 0x60, 0xb2, 0xb8,
+	0x36, 0xcb, 0x43, 0xf0, 0x04, 0x8b, 0x8c, 0x91, 0x1b, 0x3e, 0x9b, 0x4e, 0x29, 0xc9, 0x32, 0x1d,
+	0x8e, 0x12, 0xf1, 0x13, 0x80, 0xb3, 0x7c, 0x62, 0x84, 0xbd, 0xdc, 0x0e, 0x21, 0x68, 0x0a, 0x1e,
+	0x19, 0x83, 0x58, 0xe3, 0x53, 0xf0, 0xc4, 0x5e, 0x45, 0xb2, 0x05, 0x9d, 0xb3, 0x7c, 0xf2, 0x3a,
+	0x3a, 0x1f, 0x93, 0x42, 0x6c, 0xef, 0x06, 0x15, 0xf0, 0xe1, 0x93, 0xe0, 0x9f, 0xa1, 0x77, 0xba,
+	0x48, 0x13, 0xca, 0xbe, 0x79, 0xf5, 0xdd, 0xcb, 0x55, 0x93, 0x83, 0xa0, 0xc9, 0xcd, 0x75, 0x4c,
+	0x7c, 0x5d, 0xc6, 0xe9, 0x1a, 0x71, 0x7e, 0x06, 0x1b, 0xd2, 0xf9, 0x0a, 0xf9, 0xf8, 0x0d, 0xd6,
+	0xb5, 0xed, 0xca, 0x41, 0xd4, 0x13, 0x63, 0x9f, 0xd5, 0xad, 0x57, 0xcd, 0x87, 0xb5, 0x31, 0x29,
+	0x0e, 0x0b, 0x46, 0xb2, 0x41, 0x53, 0xa4, 0xa9, 0x94, 0x71, 0x0e, 0xeb, 0x27, 0x57, 0xff, 0x97,
+	0xde, 0x38, 0x9d, 0x6b, 0x57, 0xf1, 0x1e, 0xb4, 0xbf, 0x4a, 0xe8, 0x22, 0x64, 0x82, 0xb8, 0x13,
+	0x28, 0x09, 0xff, 0xee, 0xc0, 0xc6, 0xc9, 0x95, 0x95, 0xa2, 0xb2, 0x9a, 0x17, 0xf5, 0x6a, 0x5e,
+	0x90, 0x42, 0x84, 0x45, 0xa3, 0xcb, 0x90, 0x11, 0xae, 0x6e, 0x08, 0xb5, 0x81, 0xd4, 0x43, 0xe8,
+	0x56, 0x21, 0x58, 0xb9, 0x69, 0xd6, 0x73, 0xa3, 0x4b, 0xda, 0xaa, 0x4a, 0x8a, 0x73, 0xf0, 0x5e,
+	0x45, 0xb3, 0x95, 0x5b, 0xc6, 0xa0, 0x6e, 0x2c, 0xbf, 0xc3, 0xae, 0x9d, 0xab, 0x17, 0x24, 0xcb,
+	0xc2, 0x19, 0x51, 0xb5, 0xd0, 0x22, 0x7e, 0x0a, 0x5d, 0x49, 0xab, 0x12, 0x32, 0x82, 0x0e, 0x97,
+	0x43, 0x96, 0x53, 0xe9, 0xc2, 0xdb, 0xeb, 0xed, 0xaa, 0x69, 0x53, 0x2a, 0x82, 0xca, 0x06, 0x5f,
+	0xc1, 0xba, 0x9e, 0x29, 0x32, 0x72, 0xab, 0x41, 0x1a, 0xf5, 0x06, 0x31, 0x22, 0x71, 0xad, 0x48,
+	0x6c, 0xe6, 0xd6, 0x0a, 0xcc, 0x47, 0xe0, 0x7d, 0x1d, 0x66, 0x73, 0xcd, 0xeb, 0xc3, 0x1a, 0x17,
+	0x59, 0x91, 0xea, 0x7c, 0x95, 0xb2, 0xc9, 0xda, 0xb0, 0xcf, 0x8f, 0xa1, 0x2b, 0x9d, 0xa8, 0xf3,
+	0x23, 0x68, 0x72, 0x59, 0x79, 0x10, 0x6b, 0x7c, 0x00, 0xad, 0x31, 0x29, 0x4e, 0x8f, 0x3f, 0x30,
+	0x38, 0x8c, 0x19, 0xd5, 0xd8, 0x76, 0xcd, 0x19, 0xb5, 0x03, 0x5d, 0x39, 0x9c, 0x15, 0xc1, 0x03,
+	0x70, 0xe5, 0x5d, 0x73, 0x1f, 0x79, 0x7b, 0xde, 0xae, 0x18, 0xf8, 0xc2, 0x7b, 0xc0, 0x71, 0x7c,
+	0x0c, 0x1b, 0xe5, 0xe8, 0x35, 0x87, 0x6c, 0x6c, 0x0f, 0xd9, 0xb8, 0xd6, 0x01, 0xf6, 0x1d, 0xc0,
+	0x07, 0x70, 0xff, 0x39, 0x89, 0x09, 0x0d, 0x19, 0x79, 0x11, 0x93, 0x45, 0x12, 0x47, 0xe7, 0xda,
+	0xdd, 0x36, 0x78, 0x27, 0x31, 0xa3, 0x49, 0x5a, 0x1c, 0x46, 0x4c, 0x9e, 0x63, 0x3d, 0x30, 0x21,
+	0xfc, 0x18, 0x06, 0x37, 0x37, 0xab, 0xe8, 0x7d, 0x58, 0xd3, 0x98, 0x4e, 0xb2, 0x96, 0xf1, 0x5f,
+	0x0e, 0xdc, 0x95, 0x53, 0xa5, 0xce, 0x79, 0xdb, 0x65, 0x36, 0xbd, 0x36, 0x6c, 0xaf, 0x5c, 0xc7,
+	0x2d, 0xdf, 0x24, 0x74, 0xaa, 0xae, 0x74, 0x29, 0xdf, 0xde, 0x65, 0x67, 0x21, 0x9b, 0xeb, 0x2e,
+	0xe3, 0x6b, 0xd4, 0x87, 0xd6, 0x51, 0x92, 0xc7, 0x6c, 0xd0, 0x16, 0xe7, 0x96, 0x82, 0x59, 0xbd,
+	0x3b, 0xf6, 0x0b, 0xf3, 0x04, 0xe0, 0x98, 0xd0, 0xe8, 0x92, 0x4c, 0xc7, 0x76, 0xbf, 0xdf, 0x7c,
+	0x38, 0x04, 0x57, 0xa3, 0xe2, 0xc2, 0x5f, 0xc2, 0xbd, 0x7a, 0x3a, 0x54, 0x16, 0x3f, 0x85, 0xe6,
+	0x98, 0x14, 0x99, 0xba, 0x04, 0x9b, 0xf2, 0x12, 0x54, 0x3c, 0x81, 0xd0, 0xee, 0xfd, 0xdb, 0x92,
+	0x66, 0x68, 0x0f, 0x3c, 0x5d, 0x10, 0x1e, 0x85, 0xb2, 0xaf, 0xde, 0x57, 0xbf, 0x67, 0x20, 0x8a,
+	0xe2, 0x73, 0xa3, 0x0b, 0xf5, 0x8e, 0xea, 0x09, 0xf4, 0x7b, 0x06, 0xa2, 0x76, 0xec, 0x40, 0x93,
+	0xf7, 0x16, 0x52, 0x2a, 0x63, 0x18, 0xf9, 0xc8, 0x84, 0x94, 0xf9, 0x3e, 0xb4, 0x65, 0xdf, 0xa3,
+	0x8f, 0xa5, 0xd6, 0x9a, 0x02, 0x7e, 0xdf, 0x06, 0xab, 0x4d, 0x32, 0x25, 0x7a, 0x93, 0xf5, 0x0a,
+	0xf9, 0x7d, 0x1b, 0x54, 0x9b, 0x0e, 0x00, 0xaa, 0x57, 0x13, 0xdd, 0x37, 0x6d, 0x8c, 0x77, 0xf4,
+	0x3d, 0x9b, 0xf7, 0xa1, 0x7d, 0x72, 0x65, 0x32, 0x5a, 0x0f, 0x8f, 0xdf, 0xb7, 0xc1, 0x2a, 0x15,
+	0xbc, 0xf1, 0x75, 0x2a, 0x8c, 0x29, 0xe3, 0x23, 0x13, 0x52, 0xe6, 0x4f, 0x01, 0xaa, 0xbf, 0x91,
+	0x0e, 0xf0, 0xc6, 0x6f, 0xc9, 0x1f, 0xdc, 0x54, 0x54, 0x7c, 0x7c, 0x46, 0x68, 0x3e, 0xe3, 0x33,
+	0xe7, 0x23, 0x13, 0x52, 0xe6, 0x8f, 0xc5, 0x35, 0x14, 0x64, 0x2a, 0x7e, 0x7b, 0x64, 0xf8, 0x77,
+	0x6b, 0xa8, 0xda, 0xf7, 0x3d, 0x6c, 0xd6, 0x1b, 0x1b, 0x3d, 0x28, 0xaf, 0xce, 0xb2, 0x69, 0xe1,
+	0x0f, 0xdf, 0xa7, 0x56, 0x2e, 0xc7, 0xfa, 0xd3, 0x51, 0x3a, 0xfc, 0xc4, 0x2c, 0x43, 0xdd, 0xdd,
+	0xd6, 0x72, 0xa5, 0x74, 0x76, 0xf8, 0xc5, 0xdb, 0xeb, 0xa1, 0xf3, 0xe7, 0xf5, 0xd0, 0xf9, 0xfb,
+	0x7a, 0xe8, 0xfc, 0x73, 0x3d, 0x74, 0xfe, 0x78, 0x37, 0x74, 0xde, 0xbe, 0x1b, 0x3a, 0x3f, 0xe1,
+	0x59, 0xc4, 0xe6, 0xf9, 0x64, 0xf7, 0x3c, 0x59, 0x8c, 0xe6, 0x45, 0x4a, 0xe8, 0x6b, 0x32, 0x9d,
+	0x11, 0x3a, 0x9a, 0xe4, 0x94, 0x26, 0x6f, 0x46, 0xdc, 0xe9, 0xa4, 0x2d, 0x3e, 0xc3, 0xfb, 0xff,
+	0x0d, 0x00, 0xd7, 0x53, 0x1a, 0x78, 0x44, 0x0b, 0x00, 0x00,
 }
 
 func (m *ListRequest) Marshal() (dAtA []byte, err error) {
@@ -1643,6 +1993,13 @@ func (m *ImportJSONRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.JSON) > 0 {
 		i -= len(m.JSON)
 		copy(dAtA[i:], m.JSON)
@@ -1773,6 +2130,13 @@ func (m *ExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -1821,6 +2185,13 @@ func (m *ExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.JSON) > 0 {
+		i -= len(m.JSON)
+		copy(dAtA[i:], m.JSON)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.JSON)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.CurveType) > 0 {
 		i -= len(m.CurveType)
 		copy(dAtA[i:], m.CurveType)
@@ -2199,43 +2570,265 @@ func (m *AddNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintKeys(dAtA []byte, offset int, v uint64) int {
-	offset -= sovKeys(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GenerateMnemonicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.KeyName)
-	if l > 0 {
-		n += 1 + l + sovKeys(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *GenerateMnemonicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerifyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GenerateMnemonicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.EntropyBits != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.EntropyBits))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenerateMnemonicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenerateMnemonicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenerateMnemonicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Mnemonic) > 0 {
+		i -= len(m.Mnemonic)
+		copy(dAtA[i:], m.Mnemonic)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Mnemonic)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportMnemonicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportMnemonicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportMnemonicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.KeyName) > 0 {
+		i -= len(m.KeyName)
+		copy(dAtA[i:], m.KeyName)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.KeyName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Count != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CurveType) > 0 {
+		i -= len(m.CurveType)
+		copy(dAtA[i:], m.CurveType)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.CurveType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Mnemonic) > 0 {
+		i -= len(m.Mnemonic)
+		copy(dAtA[i:], m.Mnemonic)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Mnemonic)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Passphrase) > 0 {
+		i -= len(m.Passphrase)
+		copy(dAtA[i:], m.Passphrase)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Passphrase)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivedKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivedKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivedKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportMnemonicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportMnemonicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportMnemonicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKeys(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKeys(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyName)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
 
 func (m *RemoveNameResponse) Size() (n int) {
@@ -2372,6 +2965,10 @@ func (m *ImportJSONRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2440,6 +3037,10 @@ func (m *ExportRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2468,6 +3069,10 @@ func (m *ExportResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeys(uint64(l))
 	}
+	l = len(m.JSON)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2638,40 +3243,148 @@ func (m *AddNameRequest) Size() (n int) {
 	return n
 }
 
-func sovKeys(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *GenerateMnemonicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntropyBits != 0 {
+		n += 1 + sovKeys(uint64(m.EntropyBits))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func sozKeys(x uint64) (n int) {
-	return sovKeys(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *GenerateMnemonicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *ListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowKeys
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
+
+func (m *ImportMnemonicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Passphrase)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.CurveType)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovKeys(uint64(m.Count))
+	}
+	l = len(m.KeyName)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DerivedKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportMnemonicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovKeys(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeys(x uint64) (n int) {
+	return sovKeys(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
@@ -3518,6 +4231,38 @@ func (m *ImportJSONRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.JSON = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -3929,6 +4674,38 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -4114,6 +4891,38 @@ func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.CurveType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSON", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSON = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeys(dAtA[iNdEx:])
@@ -5074,6 +5883,621 @@ func (m *AddNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenerateMnemonicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateMnemonicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateMnemonicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntropyBits", wireType)
+			}
+			m.EntropyBits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntropyBits |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenerateMnemonicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateMnemonicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateMnemonicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportMnemonicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportMnemonicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportMnemonicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passphrase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Passphrase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurveType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivedKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivedKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivedKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportMnemonicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportMnemonicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportMnemonicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &DerivedKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipKeys(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30,6 +30,8 @@ type KeysClient interface {
 	RemoveName(ctx context.Context, in *RemoveNameRequest, opts ...grpc.CallOption) (*RemoveNameResponse, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	AddName(ctx context.Context, in *AddNameRequest, opts ...grpc.CallOption) (*AddNameResponse, error)
+	GenerateMnemonic(ctx context.Context, in *GenerateMnemonicRequest, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error)
+	ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest, opts ...grpc.CallOption) (*ImportMnemonicResponse, error)
 }
 
 type keysClient struct {
@@ -139,6 +141,24 @@ func (c *keysClient) AddName(ctx context.Context, in *AddNameRequest, opts ...gr
 	return out, nil
 }
 
+func (c *keysClient) GenerateMnemonic(ctx context.Context, in *GenerateMnemonicRequest, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error) {
+	out := new(GenerateMnemonicResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/GenerateMnemonic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest, opts ...grpc.CallOption) (*ImportMnemonicResponse, error) {
+	out := new(ImportMnemonicResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/ImportMnemonic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KeysServer is the server API for Keys service.
 // All implementations must embed UnimplementedKeysServer
 // for forward compatibility
@@ -154,6 +174,8 @@ type KeysServer interface {
 	RemoveName(context.Context, *RemoveNameRequest) (*RemoveNameResponse, error)
 	List(context.Context, *ListRequest) (*ListResponse, error)
 	AddName(context.Context, *AddNameRequest) (*AddNameResponse, error)
+	GenerateMnemonic(context.Context, *GenerateMnemonicRequest) (*GenerateMnemonicResponse, error)
+	ImportMnemonic(context.Context, *ImportMnemonicRequest) (*ImportMnemonicResponse, error)
 	mustEmbedUnimplementedKeysServer()
 }
 
@@ -194,6 +216,12 @@ func (UnimplementedKeysServer) List(context.Context, *ListRequest) (*ListRespons
 func (UnimplementedKeysServer) AddName(context.Context, *AddNameRequest) (*AddNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddName not implemented")
 }
+func (UnimplementedKeysServer) GenerateMnemonic(context.Context, *GenerateMnemonicRequest) (*GenerateMnemonicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateMnemonic not implemented")
+}
+func (UnimplementedKeysServer) ImportMnemonic(context.Context, *ImportMnemonicRequest) (*ImportMnemonicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMnemonic not implemented")
+}
 func (UnimplementedKeysServer) mustEmbedUnimplementedKeysServer() {}
 
 // UnsafeKeysServer may be embedded to opt out of forward compatibility for this service.
@@ -405,6 +433,42 @@ func _Keys_AddName_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Keys_GenerateMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateMnemonicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).GenerateMnemonic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/GenerateMnemonic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).GenerateMnemonic(ctx, req.(*GenerateMnemonicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_ImportMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMnemonicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).ImportMnemonic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/ImportMnemonic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).ImportMnemonic(ctx, req.(*ImportMnemonicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Keys_ServiceDesc is the grpc.ServiceDesc for Keys service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -456,6 +520,14 @@ var Keys_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddName",
 			Handler:    _Keys_AddName_Handler,
 		},
+		{
+			MethodName: "GenerateMnemonic",
+			Handler:    _Keys_GenerateMnemonic_Handler,
+		},
+		{
+			MethodName: "ImportMnemonic",
+			Handler:    _Keys_ImportMnemonic_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "keys.proto",
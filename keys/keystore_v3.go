@@ -0,0 +1,253 @@
+package keys
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/tmthrgd/go-hex"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Web3 Secret Storage (V3 keystore) as used by geth, MetaMask and other Ethereum tools, see
+// https://github.com/ethereum/wiki/wiki/Web3-Secret-Storage-Definition
+const (
+	v3Version     = 3
+	v3Cipher      = "aes-128-ctr"
+	v3KDFScrypt   = "scrypt"
+	v3KDFPBKDF2   = "pbkdf2"
+	v3PRF         = "hmac-sha256"
+	v3ScryptDKLen = 32
+	// The 'standard' scrypt parameters used by geth
+	v3ScryptN = 1 << 18
+	v3ScryptP = 1
+	v3ScryptR = 8
+	// Keystores arrive over RPC so we bound the work they can ask of us. scrypt needs 128*N*r bytes and N*r*p time,
+	// which these limits hold to 1 GiB and four times geth's 'standard' parameters.
+	v3MaxScryptN    = 1 << 20
+	v3MaxScryptWork = 1 << 23
+	v3MaxPBKDF2C    = 10000000
+	v3MaxDKLen      = 64
+)
+
+// ExportFormatV3 requests a V3 keystore from Export
+const ExportFormatV3 = "v3"
+
+type v3KeyJSON struct {
+	Address string       `json:"address"`
+	Crypto  v3CryptoJSON `json:"crypto"`
+	ID      string       `json:"id"`
+	Version int          `json:"version"`
+}
+
+type v3CryptoJSON struct {
+	Cipher       string             `json:"cipher"`
+	CipherText   string             `json:"ciphertext"`
+	CipherParams v3CipherParamsJSON `json:"cipherparams"`
+	KDF          string             `json:"kdf"`
+	KDFParams    v3KDFParamsJSON    `json:"kdfparams"`
+	MAC          string             `json:"mac"`
+}
+
+type v3CipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+// Holds the parameters of both scrypt and pbkdf2
+type v3KDFParamsJSON struct {
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n,omitempty"`
+	R     int    `json:"r,omitempty"`
+	P     int    `json:"p,omitempty"`
+	C     int    `json:"c,omitempty"`
+	PRF   string `json:"prf,omitempty"`
+}
+
+// IsKeyV3 returns whether keyJSON looks like a V3 keystore
+func IsKeyV3(keyJSON []byte) bool {
+	k := new(v3KeyJSON)
+	return json.Unmarshal(keyJSON, k) == nil && k.Version == v3Version && k.Crypto.Cipher != ""
+}
+
+// EncryptKeyV3 encrypts a secp256k1 key as a V3 keystore with the standard scrypt parameters
+func EncryptKeyV3(passphrase string, key *Key) ([]byte, error) {
+	return encryptKeyV3(passphrase, key, v3ScryptN, v3ScryptP)
+}
+
+func encryptKeyV3(passphrase string, key *Key, scryptN, scryptP int) ([]byte, error) {
+	if key.CurveType != crypto.CurveTypeSecp256k1 {
+		return nil, fmt.Errorf("V3 keystores only hold secp256k1 keys but key %v is %v", key.Address, key.CurveType)
+	}
+	salt, err := randomBytes(32)
+	if err != nil {
+		return nil, err
+	}
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, scryptN, v3ScryptR, scryptP, v3ScryptDKLen)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := randomBytes(aes.BlockSize)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aesCTR(derivedKey[:16], iv, key.PrivateKey.RawBytes())
+	if err != nil {
+		return nil, err
+	}
+	id, err := randomBytes(16)
+	if err != nil {
+		return nil, err
+	}
+	// Version 4 UUID
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	return json.Marshal(v3KeyJSON{
+		Address: hex.EncodeToString(key.Address[:]),
+		Crypto: v3CryptoJSON{
+			Cipher:       v3Cipher,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: v3CipherParamsJSON{IV: hex.EncodeToString(iv)},
+			KDF:          v3KDFScrypt,
+			KDFParams: v3KDFParamsJSON{
+				DKLen: v3ScryptDKLen,
+				Salt:  hex.EncodeToString(salt),
+				N:     scryptN,
+				R:     v3ScryptR,
+				P:     scryptP,
+			},
+			MAC: hex.EncodeToString(v3MAC(derivedKey, cipherText)),
+		},
+		ID:      fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]),
+		Version: v3Version,
+	})
+}
+
+// DecryptKeyV3 decrypts the secp256k1 key held in a V3 keystore
+func DecryptKeyV3(passphrase string, keyJSON []byte) (*Key, error) {
+	k := new(v3KeyJSON)
+	err := json.Unmarshal(keyJSON, k)
+	if err != nil {
+		return nil, err
+	}
+	if k.Version != v3Version {
+		return nil, fmt.Errorf("expected V3 keystore but got version %d", k.Version)
+	}
+	if k.Crypto.Cipher != v3Cipher {
+		return nil, fmt.Errorf("unsupported V3 keystore cipher '%s'", k.Crypto.Cipher)
+	}
+	derivedKey, err := k.Crypto.KDFParams.deriveKey(k.Crypto.KDF, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := hex.DecodeString(k.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode V3 keystore ciphertext: %w", err)
+	}
+	mac, err := hex.DecodeString(k.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode V3 keystore MAC: %w", err)
+	}
+	if !bytes.Equal(mac, v3MAC(derivedKey, cipherText)) {
+		return nil, fmt.Errorf("could not decrypt V3 keystore: wrong passphrase or corrupt keystore")
+	}
+	iv, err := hex.DecodeString(k.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode V3 keystore IV: %w", err)
+	}
+	privKey, err := aesCTR(derivedKey[:16], iv, cipherText)
+	if err != nil {
+		return nil, err
+	}
+	// Some tools have written keys with leading zeros dropped
+	if len(privKey) < 32 {
+		privKey = append(make([]byte, 32-len(privKey)), privKey...)
+	}
+	key, err := NewKeyFromPriv(crypto.CurveTypeSecp256k1, privKey)
+	if err != nil {
+		return nil, err
+	}
+	// The address is optional
+	if k.Address != "" {
+		address, err := crypto.AddressFromHexString(k.Address)
+		if err != nil {
+			return nil, err
+		}
+		if address != key.Address {
+			return nil, fmt.Errorf("V3 keystore address %v does not match the address of its key %v",
+				address, key.Address)
+		}
+	}
+	return key, nil
+}
+
+func (params v3KDFParamsJSON) deriveKey(kdf, passphrase string) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode V3 keystore salt: %w", err)
+	}
+	// The MAC and cipher key are taken from the first 32 bytes
+	if params.DKLen < 32 {
+		return nil, fmt.Errorf("V3 keystore derived key length %d is shorter than 32 bytes", params.DKLen)
+	}
+	if params.DKLen > v3MaxDKLen {
+		return nil, fmt.Errorf("V3 keystore derived key length %d is longer than %d bytes", params.DKLen, v3MaxDKLen)
+	}
+	switch kdf {
+	case v3KDFScrypt:
+		if params.N <= 0 || params.R <= 0 || params.P <= 0 {
+			return nil, fmt.Errorf("V3 keystore scrypt parameters must be positive but got n=%d, r=%d, p=%d",
+				params.N, params.R, params.P)
+		}
+		// Divide rather than multiply so that large parameters cannot overflow
+		if params.N > v3MaxScryptN || params.R > v3MaxScryptWork/params.N ||
+			params.P > v3MaxScryptWork/(params.N*params.R) {
+			return nil, fmt.Errorf("V3 keystore scrypt parameters n=%d, r=%d, p=%d exceed the limits "+
+				"n <= %d and n*r*p <= %d", params.N, params.R, params.P, v3MaxScryptN, v3MaxScryptWork)
+		}
+		return scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.DKLen)
+	case v3KDFPBKDF2:
+		if params.PRF != v3PRF {
+			return nil, fmt.Errorf("unsupported V3 keystore pbkdf2 PRF '%s'", params.PRF)
+		}
+		if params.C <= 0 || params.C > v3MaxPBKDF2C {
+			return nil, fmt.Errorf("V3 keystore pbkdf2 iteration count %d is not between 1 and %d", params.C,
+				v3MaxPBKDF2C)
+		}
+		return pbkdf2.Key([]byte(passphrase), salt, params.C, params.DKLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("unsupported V3 keystore KDF '%s'", kdf)
+	}
+}
+
+func v3MAC(derivedKey, cipherText []byte) []byte {
+	return crypto.Keccak256(append(append([]byte{}, derivedKey[16:32]...), cipherText...))
+}
+
+func aesCTR(key, iv, text []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != block.BlockSize() {
+		return nil, fmt.Errorf("V3 keystore IV should be %d bytes but is %d", block.BlockSize(), len(iv))
+	}
+	out := make([]byte, len(text))
+	cipher.NewCTR(block, iv).XORKeyStream(out, text)
+	return out, nil
+}
+
+func randomBytes(n int) ([]byte, error) {
+	bs := make([]byte, n)
+	_, err := rand.Read(bs)
+	if err != nil {
+		return nil, err
+	}
+	return bs, nil
+}
@@ -0,0 +1,141 @@
+package keys
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmthrgd/go-hex"
+)
+
+// Test vectors from go-ethereum's accounts/keystore/testdata
+var v3TestVectors = []struct {
+	name, json, password, priv string
+}{
+	{
+		name: "scrypt",
+		json: `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},
+			"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt",
+			"kdfparams":{"dklen":32,"n":262144,"r":1,"p":8,
+				"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},
+			"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},
+			"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`,
+		password: "testpassword",
+		priv:     "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d",
+	},
+	{
+		name: "pbkdf2",
+		json: `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},
+			"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2",
+			"kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256",
+				"salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},
+			"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},
+			"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`,
+		password: "testpassword",
+		priv:     "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d",
+	},
+	{
+		name: "31 byte key",
+		json: `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"e0c41130a323adc1446fc82f724bca2f"},
+			"ciphertext":"9517cd5bdbe69076f9bf5057248c6c050141e970efa36ce53692d5d59a3984","kdf":"scrypt",
+			"kdfparams":{"dklen":32,"n":2,"r":8,"p":1,
+				"salt":"711f816911c92d649fb4c84b047915679933555030b3552c1212609b38208c63"},
+			"mac":"d5e116151c6aa71470e67a7d42c9620c75c4d23229847dcc127794f0732b0db5"},
+			"id":"fecfc4ce-e956-48fd-953b-30f8b52ed66c","version":3}`,
+		password: "foo",
+		priv:     "00fa7b3db73dc7dfdf8c5fbdb796d741e4488628c41fc4febd9160a866ba0f35",
+	},
+	{
+		name: "with address",
+		json: `{"address":"45dea0fb0bba44f4fcf290bba71fd57d7117cbb8","crypto":{"cipher":"aes-128-ctr",
+			"ciphertext":"b87781948a1befd247bff51ef4063f716cf6c2d3481163e9a8f42e1f9bb74145",
+			"cipherparams":{"iv":"dc4926b48a105133d2f16b96833abf1e"},"kdf":"scrypt",
+			"kdfparams":{"dklen":32,"n":2,"p":1,"r":8,
+				"salt":"004244bbdc51cadda545b1cfa43cff9ed2ae88e08c61f1479dbb45410722f8f0"},
+			"mac":"39990c1684557447940d4c69e06b1b82b2aceacb43f284df65c956daf3046b85"},
+			"id":"ce541d8d-c79b-40f8-9f8c-20f59616faba","version":3}`,
+		password: "",
+	},
+}
+
+func TestDecryptKeyV3(t *testing.T) {
+	for _, tv := range v3TestVectors {
+		t.Run(tv.name, func(t *testing.T) {
+			require.True(t, IsKeyV3([]byte(tv.json)))
+			key, err := DecryptKeyV3(tv.password, []byte(tv.json))
+			require.NoError(t, err)
+			assert.Equal(t, crypto.CurveTypeSecp256k1, key.CurveType)
+			if tv.priv != "" {
+				assert.Equal(t, tv.priv, hex.EncodeToString(key.PrivateKey.RawBytes()))
+			} else {
+				assert.Equal(t, "45DEA0FB0BBA44F4FCF290BBA71FD57D7117CBB8", key.Address.String())
+			}
+
+			_, err = DecryptKeyV3(tv.password+"wrong", []byte(tv.json))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDecryptKeyV3Limits(t *testing.T) {
+	key, err := NewKey(crypto.CurveTypeSecp256k1)
+	require.NoError(t, err)
+	keyJSON, err := encryptKeyV3("secret", key, 1<<4, 1)
+	require.NoError(t, err)
+
+	for name, params := range map[string]v3KDFParamsJSON{
+		"ScryptN":        {N: 1 << 24, R: 8, P: 1},
+		"ScryptWork":     {N: 1 << 20, R: 8, P: 2},
+		"ScryptOverflow": {N: 1 << 20, R: 1 << 62, P: 1 << 62},
+		"ScryptZero":     {N: 0, R: 8, P: 1},
+		"DKLen":          {N: 1 << 4, R: 8, P: 1, DKLen: 1 << 30},
+	} {
+		t.Run(name, func(t *testing.T) {
+			k := new(v3KeyJSON)
+			require.NoError(t, json.Unmarshal(keyJSON, k))
+			params.Salt = k.Crypto.KDFParams.Salt
+			if params.DKLen == 0 {
+				params.DKLen = 32
+			}
+			k.Crypto.KDFParams = params
+			bs, err := json.Marshal(k)
+			require.NoError(t, err)
+			_, err = DecryptKeyV3("secret", bs)
+			require.Error(t, err)
+		})
+	}
+
+	for _, c := range []int{0, 100000000} {
+		_, err := v3KDFParamsJSON{DKLen: 32, C: c, PRF: v3PRF}.deriveKey(v3KDFPBKDF2, "secret")
+		require.Error(t, err)
+	}
+}
+
+func TestEncryptKeyV3(t *testing.T) {
+	key, err := NewKey(crypto.CurveTypeSecp256k1)
+	require.NoError(t, err)
+	// Use light scrypt parameters to keep the test fast
+	keyJSON, err := encryptKeyV3("secret", key, 1<<4, 1)
+	require.NoError(t, err)
+	require.True(t, IsKeyV3(keyJSON))
+
+	decrypted, err := DecryptKeyV3("secret", keyJSON)
+	require.NoError(t, err)
+	assert.Equal(t, key.Address, decrypted.Address)
+	assert.Equal(t, key.PrivateKey.RawBytes(), decrypted.PrivateKey.RawBytes())
+
+	_, err = DecryptKeyV3("guess", keyJSON)
+	require.Error(t, err)
+
+	// Burrow's own key format is not a V3 keystore
+	burrowJSON, err := key.MarshalJSON()
+	require.NoError(t, err)
+	assert.False(t, IsKeyV3(burrowJSON))
+
+	edKey, err := NewKey(crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	_, err = EncryptKeyV3("secret", edKey)
+	require.Error(t, err)
+}
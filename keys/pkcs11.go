@@ -0,0 +1,66 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// PKCS11Config selects a PKCS#11 token (for example an HSM) on which to generate and hold keys. It requires burrow to
+// be built with the 'pkcs11' build tag.
+type PKCS11Config struct {
+	// Path to the PKCS#11 module (shared library) provided by the token vendor
+	Library string
+	// Label of the token holding keys
+	TokenLabel string
+	// User PIN with which to log in to the token
+	PIN string
+}
+
+// DER-encoded CKA_EC_PARAMS for the curves we support
+var (
+	// OID 1.3.132.0.10
+	secp256k1ECParams = []byte{0x06, 0x05, 0x2b, 0x81, 0x04, 0x00, 0x0a}
+	// OID 1.3.101.112
+	ed25519ECParams = []byte{0x06, 0x03, 0x2b, 0x65, 0x70}
+)
+
+// Returns the public key held in a CKA_EC_POINT which should be a DER-encoded octet string though some tokens
+// return the point unwrapped
+func publicKeyFromECPoint(ecPoint []byte, curveType crypto.CurveType) (*crypto.PublicKey, error) {
+	var point []byte
+	rest, err := asn1.Unmarshal(ecPoint, &point)
+	if err != nil || len(rest) > 0 {
+		point = ecPoint
+	}
+	return crypto.PublicKeyFromBytes(point, curveType)
+}
+
+// Converts an ECDSA signature as returned by CKM_ECDSA (r || s) into the recoverable compact signature over hash
+// that we use for secp256k1 keys
+func compactSecp256k1Signature(rs []byte, hash []byte, publicKey *crypto.PublicKey) ([]byte, error) {
+	if len(rs) != 64 {
+		return nil, fmt.Errorf("expected 64 byte ECDSA signature but got %d bytes", len(rs))
+	}
+	curve := btcec.S256()
+	s := new(big.Int).SetBytes(rs[32:])
+	// Like btcec we only produce canonical signatures with s in the lower half of the curve order
+	if s.Cmp(new(big.Int).Rsh(curve.N, 1)) > 0 {
+		s.Sub(curve.N, s)
+	}
+	sig := make([]byte, 65)
+	copy(sig[1:33], rs[:32])
+	s.FillBytes(sig[33:])
+	for recoveryID := byte(0); recoveryID < 4; recoveryID++ {
+		sig[0] = 27 + recoveryID
+		recovered, _, err := btcec.RecoverCompact(curve, sig, hash)
+		if err == nil && bytes.Equal(recovered.SerializeUncompressed(), publicKey.PublicKey) {
+			return sig, nil
+		}
+	}
+	return nil, fmt.Errorf("could not recover public key %v from ECDSA signature", publicKey)
+}
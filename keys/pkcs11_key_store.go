@@ -0,0 +1,331 @@
+// PKCS#11 modules are loaded through CGO so support is optional
+// +build pkcs11
+
+package keys
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/miekg/pkcs11"
+)
+
+// EdDSA was only standardised in PKCS#11 v3.0 so is not defined by the pkcs11 package
+const (
+	ckkECEdwards             = 0x00000040
+	ckmECEdwardsKeyPairGen   = 0x00001055
+	ckmEdDSA                 = 0x00001057
+	maxObjectsPerFindRequest = 16
+)
+
+var _ KeyStore = (*PKCS11KeyStore)(nil)
+
+// PKCS11KeyStore generates and uses keys that never leave a PKCS#11 token. Keys are labelled with their name and
+// identified (CKA_ID) by their address.
+type PKCS11KeyStore struct {
+	sync.Mutex
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+}
+
+func NewPKCS11KeyStore(conf *PKCS11Config) (*PKCS11KeyStore, error) {
+	ctx := pkcs11.New(conf.Library)
+	if ctx == nil {
+		return nil, fmt.Errorf("could not load PKCS#11 module %s", conf.Library)
+	}
+	err := ctx.Initialize()
+	if err != nil {
+		ctx.Destroy()
+		return nil, fmt.Errorf("could not initialise PKCS#11 module %s: %w", conf.Library, err)
+	}
+	ks := &PKCS11KeyStore{ctx: ctx}
+	err = ks.openSession(conf)
+	if err != nil {
+		ctx.Finalize()
+		ctx.Destroy()
+		return nil, err
+	}
+	return ks, nil
+}
+
+func (ks *PKCS11KeyStore) openSession(conf *PKCS11Config) error {
+	slots, err := ks.ctx.GetSlotList(true)
+	if err != nil {
+		return fmt.Errorf("could not list PKCS#11 slots: %w", err)
+	}
+	for _, slot := range slots {
+		info, err := ks.ctx.GetTokenInfo(slot)
+		if err != nil {
+			return fmt.Errorf("could not get info for PKCS#11 token in slot %d: %w", slot, err)
+		}
+		if strings.TrimSpace(info.Label) != conf.TokenLabel {
+			continue
+		}
+		ks.session, err = ks.ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+		if err != nil {
+			return fmt.Errorf("could not open session with PKCS#11 token %s: %w", conf.TokenLabel, err)
+		}
+		err = ks.ctx.Login(ks.session, pkcs11.CKU_USER, conf.PIN)
+		if err != nil && err != pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+			ks.ctx.CloseSession(ks.session)
+			return fmt.Errorf("could not log in to PKCS#11 token %s: %w", conf.TokenLabel, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("could not find PKCS#11 token with label '%s'", conf.TokenLabel)
+}
+
+// Close logs out of the token and unloads the PKCS#11 module
+func (ks *PKCS11KeyStore) Close() error {
+	ks.Lock()
+	defer ks.Unlock()
+	ks.ctx.Logout(ks.session)
+	ks.ctx.CloseSession(ks.session)
+	err := ks.ctx.Finalize()
+	ks.ctx.Destroy()
+	return err
+}
+
+func (ks *PKCS11KeyStore) GetAddressForKeyName(keyName string) (crypto.Address, error) {
+	ks.Lock()
+	defer ks.Unlock()
+	key, err := ks.findKey(keyName, "")
+	if err != nil {
+		return crypto.Address{}, err
+	}
+	return key.publicKey.GetAddress(), nil
+}
+
+func (ks *PKCS11KeyStore) GenerateKey(ctx context.Context, in *GenRequest) (*GenResponse, error) {
+	curveType, err := crypto.CurveTypeFromString(in.CurveType)
+	if err != nil {
+		return nil, err
+	}
+	var mechanism uint
+	var ecParams []byte
+	keyType := pkcs11.CKK_EC
+	switch curveType {
+	case crypto.CurveTypeSecp256k1:
+		mechanism, ecParams = pkcs11.CKM_EC_KEY_PAIR_GEN, secp256k1ECParams
+	case crypto.CurveTypeEd25519:
+		mechanism, ecParams, keyType = ckmECEdwardsKeyPairGen, ed25519ECParams, ckkECEdwards
+	default:
+		return nil, crypto.ErrInvalidCurve(curveType)
+	}
+
+	ks.Lock()
+	defer ks.Unlock()
+	if in.KeyName != "" {
+		// Labels must be unique for names to identify keys
+		_, err := ks.findObjects(pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, in.KeyName))
+		if err == nil {
+			return nil, fmt.Errorf("a key named %s already exists on the PKCS#11 token", in.KeyName)
+		}
+	}
+	public := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, keyType),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, ecParams),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, in.KeyName),
+	}
+	private := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, keyType),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, in.KeyName),
+	}
+	publicHandle, privateHandle, err := ks.ctx.GenerateKeyPair(ks.session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, public, private)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate %v key on PKCS#11 token: %w", curveType, err)
+	}
+	publicKey, err := ks.publicKey(publicHandle, curveType)
+	if err != nil {
+		return nil, err
+	}
+	address := publicKey.GetAddress()
+	id := []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_ID, address.Bytes())}
+	for _, handle := range []pkcs11.ObjectHandle{publicHandle, privateHandle} {
+		err = ks.ctx.SetAttributeValue(ks.session, handle, id)
+		if err != nil {
+			return nil, fmt.Errorf("could not set CKA_ID of generated key: %w", err)
+		}
+	}
+	return &GenResponse{Address: address.String()}, nil
+}
+
+func (ks *PKCS11KeyStore) PublicKey(ctx context.Context, in *PubRequest) (*PubResponse, error) {
+	ks.Lock()
+	defer ks.Unlock()
+	key, err := ks.findKey(in.GetName(), in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	return &PubResponse{CurveType: key.publicKey.CurveType.String(), PublicKey: key.publicKey.PublicKey}, nil
+}
+
+func (ks *PKCS11KeyStore) Sign(ctx context.Context, in *SignRequest) (*SignResponse, error) {
+	ks.Lock()
+	defer ks.Unlock()
+	key, err := ks.findKey(in.GetName(), in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	switch key.publicKey.CurveType {
+	case crypto.CurveTypeEd25519:
+		signature, err := ks.sign(key.private, ckmEdDSA, in.GetMessage())
+		if err != nil {
+			return nil, err
+		}
+		return &SignResponse{Signature: &crypto.Signature{CurveType: crypto.CurveTypeEd25519, Signature: signature}}, nil
+	case crypto.CurveTypeSecp256k1:
+		hash := crypto.Keccak256(in.GetMessage())
+		rs, err := ks.sign(key.private, pkcs11.CKM_ECDSA, hash)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := compactSecp256k1Signature(rs, hash, key.publicKey)
+		if err != nil {
+			return nil, err
+		}
+		return &SignResponse{Signature: &crypto.Signature{CurveType: crypto.CurveTypeSecp256k1, Signature: signature}}, nil
+	default:
+		return nil, crypto.ErrInvalidCurve(key.publicKey.CurveType)
+	}
+}
+
+func (ks *PKCS11KeyStore) sign(private pkcs11.ObjectHandle, mechanism uint, message []byte) ([]byte, error) {
+	err := ks.ctx.SignInit(ks.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, private)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign with PKCS#11 token: %w", err)
+	}
+	signature, err := ks.ctx.Sign(ks.session, message)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign with PKCS#11 token: %w", err)
+	}
+	return signature, nil
+}
+
+type pkcs11Key struct {
+	private   pkcs11.ObjectHandle
+	publicKey *crypto.PublicKey
+}
+
+// Finds a key pair by name (its label) or, if no name is given, by address. Keys generated by other tools are found
+// by address even if their CKA_ID is not their address.
+func (ks *PKCS11KeyStore) findKey(name, addressHex string) (*pkcs11Key, error) {
+	publicClass := pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY)
+	var publicHandle pkcs11.ObjectHandle
+	var publicKey *crypto.PublicKey
+	if name != "" {
+		handles, err := ks.findObjects(publicClass, pkcs11.NewAttribute(pkcs11.CKA_LABEL, name))
+		if err != nil {
+			return nil, fmt.Errorf("could not find key named %s on PKCS#11 token: %w", name, err)
+		}
+		publicHandle = handles[0]
+		publicKey, err = ks.publicKey(publicHandle, crypto.CurveTypeUnset)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		address, err := crypto.AddressFromHexString(addressHex)
+		if err != nil {
+			return nil, err
+		}
+		handles, err := ks.findObjects(publicClass, pkcs11.NewAttribute(pkcs11.CKA_ID, address.Bytes()))
+		if err != nil {
+			handles, err = ks.findObjects(publicClass)
+			if err != nil {
+				return nil, fmt.Errorf("could not find key with address %v on PKCS#11 token: %w", address, err)
+			}
+		}
+		for _, handle := range handles {
+			pk, err := ks.publicKey(handle, crypto.CurveTypeUnset)
+			if err == nil && pk.GetAddress() == address {
+				publicHandle, publicKey = handle, pk
+				break
+			}
+		}
+		if publicKey == nil {
+			return nil, fmt.Errorf("could not find key with address %v on PKCS#11 token", address)
+		}
+	}
+	// The private key of a pair shares its CKA_ID or failing that its label
+	attrs, err := ks.ctx.GetAttributeValue(ks.session, publicHandle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_ID, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read PKCS#11 public key attributes: %w", err)
+	}
+	link := attrs[0]
+	if len(link.Value) == 0 {
+		link = attrs[1]
+	}
+	handles, err := ks.findObjects(pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY), link)
+	if err != nil {
+		return nil, fmt.Errorf("could not find private key for %v on PKCS#11 token: %w", publicKey.GetAddress(), err)
+	}
+	return &pkcs11Key{private: handles[0], publicKey: publicKey}, nil
+}
+
+// Reads a public key from the token, when curveType is unset it is determined from the key's type and parameters
+func (ks *PKCS11KeyStore) publicKey(handle pkcs11.ObjectHandle, curveType crypto.CurveType) (*crypto.PublicKey, error) {
+	attrs, err := ks.ctx.GetAttributeValue(ks.session, handle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read PKCS#11 public key: %w", err)
+	}
+	if curveType == crypto.CurveTypeUnset {
+		switch {
+		case bytes.Equal(attrs[1].Value, secp256k1ECParams):
+			curveType = crypto.CurveTypeSecp256k1
+		case bytes.Equal(attrs[1].Value, ed25519ECParams):
+			curveType = crypto.CurveTypeEd25519
+		default:
+			return nil, fmt.Errorf("PKCS#11 key has unsupported curve parameters %X", attrs[1].Value)
+		}
+	}
+	return publicKeyFromECPoint(attrs[0].Value, curveType)
+}
+
+// Returns the objects matching template or an error if there are none
+func (ks *PKCS11KeyStore) findObjects(template ...*pkcs11.Attribute) ([]pkcs11.ObjectHandle, error) {
+	err := ks.ctx.FindObjectsInit(ks.session, template)
+	if err != nil {
+		return nil, err
+	}
+	var handles []pkcs11.ObjectHandle
+	for {
+		found, _, err := ks.ctx.FindObjects(ks.session, maxObjectsPerFindRequest)
+		if err != nil {
+			ks.ctx.FindObjectsFinal(ks.session)
+			return nil, err
+		}
+		if len(found) == 0 {
+			break
+		}
+		handles = append(handles, found...)
+	}
+	err = ks.ctx.FindObjectsFinal(ks.session)
+	if err != nil {
+		return nil, err
+	}
+	if len(handles) == 0 {
+		return nil, fmt.Errorf("no matching objects")
+	}
+	return handles, nil
+}
@@ -0,0 +1,44 @@
+// PKCS#11 modules are loaded through CGO so support is optional
+// +build !pkcs11
+
+package keys
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+var errNoPKCS11 = fmt.Errorf("burrow has been built without PKCS#11 support. To use a PKCS#11 key store build " +
+	"with the 'pkcs11' build tag enabled")
+
+var _ KeyStore = (*PKCS11KeyStore)(nil)
+
+// This is a no-op version of PKCS11KeyStore
+type PKCS11KeyStore struct {
+}
+
+func NewPKCS11KeyStore(conf *PKCS11Config) (*PKCS11KeyStore, error) {
+	return nil, errNoPKCS11
+}
+
+func (ks *PKCS11KeyStore) Close() error {
+	return errNoPKCS11
+}
+
+func (ks *PKCS11KeyStore) GetAddressForKeyName(keyName string) (crypto.Address, error) {
+	return crypto.Address{}, errNoPKCS11
+}
+
+func (ks *PKCS11KeyStore) GenerateKey(ctx context.Context, in *GenRequest) (*GenResponse, error) {
+	return nil, errNoPKCS11
+}
+
+func (ks *PKCS11KeyStore) PublicKey(ctx context.Context, in *PubRequest) (*PubResponse, error) {
+	return nil, errNoPKCS11
+}
+
+func (ks *PKCS11KeyStore) Sign(ctx context.Context, in *SignRequest) (*SignResponse, error) {
+	return nil, errNoPKCS11
+}
@@ -0,0 +1,148 @@
+// +build pkcs11
+
+package keys
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Set SOFTHSM2_LIB to the SoftHSM PKCS#11 module if it is not installed in one of these locations
+var softHSMLibraries = []string{
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+	"/usr/local/opt/softhsm/lib/softhsm/libsofthsm2.so",
+}
+
+func TestPKCS11KeyStore(t *testing.T) {
+	conf := newSoftHSMToken(t)
+	ks, err := NewPKCS11KeyStore(conf)
+	require.NoError(t, err)
+	ctx := context.Background()
+	message := []byte("sign me")
+
+	for _, curveType := range []crypto.CurveType{crypto.CurveTypeSecp256k1, crypto.CurveTypeEd25519} {
+		t.Run(curveType.String(), func(t *testing.T) {
+			name := "validator-" + curveType.String()
+			gen, err := ks.GenerateKey(ctx, &GenRequest{CurveType: curveType.String(), KeyName: name})
+			require.NoError(t, err)
+			_, err = ks.GenerateKey(ctx, &GenRequest{CurveType: curveType.String(), KeyName: name})
+			require.Error(t, err, "names should be unique")
+
+			address, err := ks.GetAddressForKeyName(name)
+			require.NoError(t, err)
+			assert.Equal(t, gen.Address, address.String())
+
+			pub, err := ks.PublicKey(ctx, &PubRequest{Address: gen.Address})
+			require.NoError(t, err)
+			assert.Equal(t, curveType.String(), pub.CurveType)
+			publicKey, err := crypto.PublicKeyFromBytes(pub.PublicKey, curveType)
+			require.NoError(t, err)
+			assert.Equal(t, address, publicKey.GetAddress())
+
+			for _, request := range []*SignRequest{{Name: name, Message: message}, {Address: gen.Address, Message: message}} {
+				sig, err := ks.Sign(ctx, request)
+				require.NoError(t, err)
+				require.NoError(t, publicKey.Verify(message, sig.Signature))
+			}
+		})
+	}
+
+	// Keys are held by the token so survive the store
+	require.NoError(t, ks.Close())
+	ks, err = NewPKCS11KeyStore(conf)
+	require.NoError(t, err)
+	defer ks.Close()
+	_, err = ks.GetAddressForKeyName("validator-secp256k1")
+	require.NoError(t, err)
+	_, err = ks.GetAddressForKeyName("nope")
+	require.Error(t, err)
+
+	// As is the client used by the kernel
+	client := NewLocalKeyClient(ks, logging.NewNoopLogger())
+	address, err := client.Generate("signer", crypto.CurveTypeSecp256k1)
+	require.NoError(t, err)
+	sig, err := client.Sign(address, message)
+	require.NoError(t, err)
+	publicKey, err := client.PublicKey(address)
+	require.NoError(t, err)
+	require.NoError(t, publicKey.Verify(message, sig))
+
+	_, err = NewPKCS11KeyStore(&PKCS11Config{Library: conf.Library, TokenLabel: "missing", PIN: conf.PIN})
+	require.Error(t, err)
+}
+
+// Initialises a fresh SoftHSM token in a temporary directory
+func newSoftHSMToken(t *testing.T) *PKCS11Config {
+	library := os.Getenv("SOFTHSM2_LIB")
+	for _, lib := range softHSMLibraries {
+		if library != "" {
+			break
+		}
+		if _, err := os.Stat(lib); err == nil {
+			library = lib
+		}
+	}
+	if library == "" {
+		t.Skip("SoftHSM is not installed, set SOFTHSM2_LIB to the path of libsofthsm2.so")
+	}
+
+	dir := t.TempDir()
+	tokenDir := filepath.Join(dir, "tokens")
+	require.NoError(t, os.Mkdir(tokenDir, 0700))
+	confFile := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, ioutil.WriteFile(confFile,
+		[]byte("directories.tokendir = "+tokenDir+"\nobjectstore.backend = file\n"), 0600))
+	previous, set := os.LookupEnv("SOFTHSM2_CONF")
+	require.NoError(t, os.Setenv("SOFTHSM2_CONF", confFile))
+	t.Cleanup(func() {
+		if set {
+			os.Setenv("SOFTHSM2_CONF", previous)
+		} else {
+			os.Unsetenv("SOFTHSM2_CONF")
+		}
+	})
+
+	conf := &PKCS11Config{Library: library, TokenLabel: "burrow-test", PIN: "1234"}
+	const soPIN = "5678"
+	ctx := pkcs11.New(library)
+	require.NotNil(t, ctx)
+	defer ctx.Destroy()
+	require.NoError(t, ctx.Initialize())
+	defer ctx.Finalize()
+	slots, err := ctx.GetSlotList(true)
+	require.NoError(t, err)
+	require.NotEmpty(t, slots)
+	require.NoError(t, ctx.InitToken(slots[0], soPIN, conf.TokenLabel))
+
+	// SoftHSM moves an initialised token to a new slot
+	slots, err = ctx.GetSlotList(true)
+	require.NoError(t, err)
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		require.NoError(t, err)
+		if strings.TrimSpace(info.Label) != conf.TokenLabel {
+			continue
+		}
+		session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+		require.NoError(t, err)
+		require.NoError(t, ctx.Login(session, pkcs11.CKU_SO, soPIN))
+		require.NoError(t, ctx.InitPIN(session, conf.PIN))
+		require.NoError(t, ctx.Logout(session))
+		require.NoError(t, ctx.CloseSession(session))
+		return conf
+	}
+	t.Fatalf("could not find initialised token")
+	return nil
+}
@@ -0,0 +1,66 @@
+package keys
+
+import (
+	"crypto/rand"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactSecp256k1Signature(t *testing.T) {
+	privateKey, err := crypto.GeneratePrivateKey(rand.Reader, crypto.CurveTypeSecp256k1)
+	require.NoError(t, err)
+	publicKey := privateKey.GetPublicKey()
+	message := []byte("sign me")
+	hash := crypto.Keccak256(message)
+
+	// What a token would return from CKM_ECDSA
+	btcecKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), privateKey.PrivateKey)
+	signature, err := btcecKey.Sign(hash)
+	require.NoError(t, err)
+	rs := make([]byte, 64)
+	signature.R.FillBytes(rs[:32])
+	signature.S.FillBytes(rs[32:])
+
+	compact, err := compactSecp256k1Signature(rs, hash, publicKey)
+	require.NoError(t, err)
+	require.NoError(t, publicKey.Verify(message, &crypto.Signature{CurveType: crypto.CurveTypeSecp256k1,
+		Signature: compact}))
+	expected, err := privateKey.Sign(message)
+	require.NoError(t, err)
+	assert.Equal(t, expected.Signature, compact, "should match our own deterministic signature")
+
+	// Tokens need not return canonical signatures
+	new(big.Int).Sub(btcec.S256().N, signature.S).FillBytes(rs[32:])
+	highS, err := compactSecp256k1Signature(rs, hash, publicKey)
+	require.NoError(t, err)
+	assert.Equal(t, compact, highS)
+
+	other, err := crypto.GeneratePrivateKey(rand.Reader, crypto.CurveTypeSecp256k1)
+	require.NoError(t, err)
+	_, err = compactSecp256k1Signature(rs, hash, other.GetPublicKey())
+	require.Error(t, err)
+	_, err = compactSecp256k1Signature(rs[1:], hash, publicKey)
+	require.Error(t, err)
+}
+
+func TestPublicKeyFromECPoint(t *testing.T) {
+	privateKey, err := crypto.GeneratePrivateKey(rand.Reader, crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	expected := privateKey.GetPublicKey()
+
+	der, err := asn1.Marshal(expected.PublicKey.Bytes())
+	require.NoError(t, err)
+	publicKey, err := publicKeyFromECPoint(der, crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	assert.Equal(t, expected, publicKey)
+
+	publicKey, err = publicKeyFromECPoint(expected.PublicKey, crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	assert.Equal(t, expected, publicKey)
+}
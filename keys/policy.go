@@ -0,0 +1,127 @@
+package keys
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"path"
+
+	"github.com/BurntSushi/toml"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// Policy identifies the clients of the keys server and restricts what each may do. It is loaded from a TOML file like:
+//
+//	[[Clients]]
+//	Name = "validator"
+//	CommonName = "validator.example.com"
+//	SignKeyNames = ["validator", "validator-*"]
+//
+//	[[Clients]]
+//	Name = "admin"
+//	TokenSHA256 = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+//	SignKeyNames = ["*"]
+//	AllowKeyManagement = true
+//	AllowExport = true
+type Policy struct {
+	Clients []*ClientPolicy
+}
+
+type ClientPolicy struct {
+	// Identifies the client in the audit log
+	Name string
+	// Matches the subject common name of a client certificate verified by mutual TLS
+	CommonName string `toml:",omitempty"`
+	// Hex-encoded SHA-256 hash of the bearer token presented by the client
+	TokenSHA256 string `toml:",omitempty"`
+	// Patterns (as matched by path.Match) of the key names the client may sign with, signing by address is allowed
+	// when a name matching one of these patterns refers to the address
+	SignKeyNames []string
+	// Whether the client may generate and import keys and add or remove key names
+	AllowKeyManagement bool
+	// Whether the client may export private keys
+	AllowExport bool
+
+	tokenHash []byte
+}
+
+// LoadPolicy reads and validates a policy file
+func LoadPolicy(policyFile string) (*Policy, error) {
+	policy := new(Policy)
+	_, err := toml.DecodeFile(policyFile, policy)
+	if err != nil {
+		return nil, fmt.Errorf("could not read keys policy file %s: %w", policyFile, err)
+	}
+	err = policy.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid keys policy file %s: %w", policyFile, err)
+	}
+	return policy, nil
+}
+
+func (p *Policy) Validate() error {
+	names := make(map[string]bool)
+	for _, client := range p.Clients {
+		if client.Name == "" {
+			return fmt.Errorf("clients must have a Name")
+		}
+		if names[client.Name] {
+			return fmt.Errorf("client name %s is not unique", client.Name)
+		}
+		names[client.Name] = true
+		if client.CommonName == "" && client.TokenSHA256 == "" {
+			return fmt.Errorf("client %s must be identified by a CommonName or a TokenSHA256", client.Name)
+		}
+		if client.TokenSHA256 != "" {
+			hash, err := hex.DecodeString(client.TokenSHA256)
+			if err != nil || len(hash) != sha256.Size {
+				return fmt.Errorf("TokenSHA256 of client %s should be a hex-encoded SHA-256 hash", client.Name)
+			}
+			client.tokenHash = hash
+		}
+		for _, pattern := range client.SignKeyNames {
+			_, err := path.Match(pattern, "")
+			if err != nil {
+				return fmt.Errorf("bad SignKeyNames pattern '%s' for client %s: %w", pattern, client.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ClientForCommonName returns the client identified by the common name of its certificate or nil if there is none
+func (p *Policy) ClientForCommonName(commonName string) *ClientPolicy {
+	if commonName == "" {
+		return nil
+	}
+	for _, client := range p.Clients {
+		if client.CommonName == commonName {
+			return client
+		}
+	}
+	return nil
+}
+
+// ClientForToken returns the client identified by a bearer token or nil if there is none
+func (p *Policy) ClientForToken(token string) *ClientPolicy {
+	if token == "" {
+		return nil
+	}
+	hash := sha256.Sum256([]byte(token))
+	for _, client := range p.Clients {
+		if client.tokenHash != nil && subtle.ConstantTimeCompare(client.tokenHash, hash[:]) == 1 {
+			return client
+		}
+	}
+	return nil
+}
+
+// CanSignWith returns whether the client may sign with the key called keyName
+func (c *ClientPolicy) CanSignWith(keyName string) bool {
+	for _, pattern := range c.SignKeyNames {
+		if matched, _ := path.Match(pattern, keyName); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -9,11 +9,15 @@ import (
 	"strings"
 
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/keys/hd"
 	hex "github.com/tmthrgd/go-hex"
 	"golang.org/x/crypto/ripemd160"
 	"google.golang.org/grpc"
 )
 
+// The most keys a single ImportMnemonic request may derive (each is written to its own key file)
+const maxDerivedKeys = 1000
+
 //------------------------------------------------------------------------
 // all cli commands pass through the http KeyStore
 // the KeyStore process also maintains the unlocked accounts
@@ -67,6 +71,23 @@ func (k *FilesystemKeyStore) Export(ctx context.Context, in *ExportRequest) (*Ex
 		return nil, err
 	}
 
+	switch in.GetFormat() {
+	case "":
+	case ExportFormatV3:
+		keyJSON, err := EncryptKeyV3(in.GetPassphrase(), key)
+		if err != nil {
+			return nil, err
+		}
+		return &ExportResponse{
+			Address:   addrB[:],
+			CurveType: key.CurveType.String(),
+			Publickey: key.PublicKey.PublicKey[:],
+			JSON:      string(keyJSON),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown export format '%s'", in.GetFormat())
+	}
+
 	return &ExportResponse{
 		Address:    addrB[:],
 		CurveType:  key.CurveType.String(),
@@ -161,8 +182,18 @@ func (k *FilesystemKeyStore) Hash(ctx context.Context, in *HashRequest) (*HashRe
 
 func (k *FilesystemKeyStore) ImportJSON(ctx context.Context, in *ImportJSONRequest) (*ImportResponse, error) {
 	keyJSON := []byte(in.GetJSON())
-	addr := isValidKeyJson(keyJSON)
-	if addr != nil {
+	var addr []byte
+	// Check for a V3 keystore first since it would also unmarshal as a Burrow key
+	if IsKeyV3(keyJSON) {
+		key, err := DecryptKeyV3(in.GetPassphrase(), keyJSON)
+		if err != nil {
+			return nil, err
+		}
+		if err = k.StoreKey(in.GetPassphrase(), key); err != nil {
+			return nil, err
+		}
+		addr = key.Address[:]
+	} else if addr = isValidKeyJson(keyJSON); addr != nil {
 		_, err := writeKey(k.keysDirPath, addr, keyJSON)
 		if err != nil {
 			return nil, err
@@ -206,7 +237,13 @@ func (k *FilesystemKeyStore) ImportJSON(ctx context.Context, in *ImportJSONReque
 			return nil, err
 		}
 	}
-	return &ImportResponse{Address: hex.EncodeUpperToString(addr)}, nil
+	address := hex.EncodeUpperToString(addr)
+	if in.GetName() != "" {
+		if err := coreNameAdd(k.keysDirPath, in.GetName(), address); err != nil {
+			return nil, err
+		}
+	}
+	return &ImportResponse{Address: address}, nil
 }
 
 func (k *FilesystemKeyStore) Import(ctx context.Context, in *ImportRequest) (*ImportResponse, error) {
@@ -303,3 +340,79 @@ func (k *FilesystemKeyStore) AddName(ctx context.Context, in *AddNameRequest) (*
 
 	return &AddNameResponse{}, coreNameAdd(k.keysDirPath, in.GetKeyname(), strings.ToUpper(in.GetAddress()))
 }
+
+func (k *FilesystemKeyStore) GenerateMnemonic(ctx context.Context, in *GenerateMnemonicRequest) (*GenerateMnemonicResponse, error) {
+	bits := int(in.GetEntropyBits())
+	if bits == 0 {
+		bits = hd.DefaultEntropyBits
+	}
+	mnemonic, err := hd.NewMnemonic(bits)
+	if err != nil {
+		return nil, err
+	}
+	return &GenerateMnemonicResponse{Mnemonic: mnemonic}, nil
+}
+
+func (k *FilesystemKeyStore) ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest) (*ImportMnemonicResponse, error) {
+	curveT, err := crypto.CurveTypeFromString(in.GetCurveType())
+	if err != nil {
+		return nil, err
+	}
+	if curveT == crypto.CurveTypeUnset {
+		curveT = crypto.CurveTypeSecp256k1
+	}
+
+	seed, err := hd.Seed(in.GetMnemonic(), in.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	path := hd.DefaultPath(curveT, 0)
+	if in.GetPath() != "" {
+		path, err = hd.ParsePath(in.GetPath())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	count := in.GetCount()
+	if count == 0 {
+		count = 1
+	} else if count > maxDerivedKeys {
+		return nil, fmt.Errorf("cannot derive %d keys from a mnemonic in one request, the maximum is %d", count,
+			maxDerivedKeys)
+	}
+
+	derived := make([]*DerivedKey, count)
+	for i := uint32(0); i < count; i++ {
+		keyPath, err := path.Offset(i)
+		if err != nil {
+			return nil, err
+		}
+		privKey, err := hd.DerivePrivateKey(seed, keyPath, curveT)
+		if err != nil {
+			return nil, err
+		}
+		key, err := NewKeyFromPriv(curveT, privKey.RawBytes())
+		if err != nil {
+			return nil, err
+		}
+
+		// store the new key
+		if err = k.StoreKey(in.GetPassphrase(), key); err != nil {
+			return nil, err
+		}
+
+		if in.GetKeyName() != "" {
+			name := in.GetKeyName()
+			if count > 1 {
+				name = fmt.Sprintf("%s-%d", name, i)
+			}
+			if err := coreNameAdd(k.keysDirPath, name, key.Address.String()); err != nil {
+				return nil, err
+			}
+		}
+		derived[i] = &DerivedKey{Address: key.Address.String(), Path: keyPath.String()}
+	}
+	return &ImportMnemonicResponse{Keys: derived}, nil
+}
@@ -3,8 +3,10 @@ package rpc
 import (
 	"fmt"
 	"runtime/debug"
+	"time"
 
 	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/instrument"
 
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
@@ -31,6 +33,7 @@ func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
 			}
 		}()
 		logger.TraceMsg("GRPC unary call")
+		defer instrument.ObserveRequest(instrument.ProtocolGRPC, info.FullMethod, time.Now())
 		return handler(ctx, req)
 	}
 }
@@ -49,6 +52,7 @@ func streamInterceptor(logger *logging.Logger) grpc.StreamServerInterceptor {
 			}
 		}()
 		logger.TraceMsg("GRPC stream call")
+		defer instrument.ObserveRequest(instrument.ProtocolGRPC, info.FullMethod, time.Now())
 		return handler(srv, ss)
 	}
 }
@@ -10,7 +10,9 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/hyperledger/burrow/instrument"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc/lib/types"
 	"github.com/pkg/errors"
@@ -135,7 +137,9 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger *logging.Logger) htt
 				return
 			}
 		}
+		began := time.Now()
 		returns := rpcFunc.f.Call(args)
+		instrument.ObserveRequest(instrument.ProtocolJSONRPC, request.Method, began)
 		logger.InfoMsg("HTTP JSONRPC called", "method", request.Method, "args", args, "returns", returns)
 		result, err := unreflectResult(returns)
 		if err != nil {
@@ -234,7 +238,9 @@ func makeHTTPHandler(rpcFunc *RPCFunc, logger *logging.Logger) func(http.Respons
 			WriteRPCResponseHTTP(w, types.RPCInvalidParamsError("", errors.Wrap(err, "Error converting http params to arguments")))
 			return
 		}
+		began := time.Now()
 		returns := rpcFunc.f.Call(args)
+		instrument.ObserveRequest(instrument.ProtocolHTTP, r.URL.Path, began)
 		logger.InfoMsg("HTTP REST", "method", r.URL.Path, "args", args, "returns", returns)
 		result, err := unreflectResult(returns)
 		if err != nil {
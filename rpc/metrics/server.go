@@ -10,6 +10,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/hyperledger/burrow/instrument"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/rpc/lib/server"
@@ -28,6 +29,12 @@ func StartServer(service *rpc.Service, pattern string, listener net.Listener, bl
 	// This invokes the Collect method through the prometheus client libraries.
 	prometheus.MustRegister(exporter)
 
+	// Label the metrics accumulated throughout Burrow consistently with those of the exporter
+	prometheus.WrapRegistererWith(prometheus.Labels{
+		"chain_id": exporter.chainID,
+		"moniker":  exporter.validatorMoniker,
+	}, prometheus.DefaultRegisterer).MustRegister(instrument.Collectors()...)
+
 	mux := http.NewServeMux()
 	mux.Handle(pattern, server.RecoverAndLogHandler(promhttp.Handler(), logger))
 
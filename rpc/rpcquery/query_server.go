@@ -43,6 +43,7 @@ type QueryState interface {
 	registry.IterableReader
 	proposal.IterableReader
 	validator.History
+	AtHeight(height uint64) (*state.ImmutableState, error)
 }
 
 func NewQueryServer(state QueryState, blockchain bcm.BlockchainInfo, nodeView *tendermint.NodeView, logger *logging.Logger) *queryServer {
@@ -61,7 +62,11 @@ func (qs *queryServer) Status(ctx context.Context, param *StatusParam) (*rpc.Res
 // Account state
 
 func (qs *queryServer) GetAccount(ctx context.Context, param *GetAccountParam) (*acm.Account, error) {
-	acc, err := qs.state.GetAccount(param.Address)
+	accounts, err := qs.accountsAtHeight(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := accounts.GetAccount(param.Address)
 	if acc == nil {
 		acc = &acm.Account{}
 	}
@@ -125,10 +130,26 @@ func (qs *queryServer) GetMetadata(ctx context.Context, param *GetMetadataParam)
 }
 
 func (qs *queryServer) GetStorage(ctx context.Context, param *GetStorageParam) (*StorageValue, error) {
-	val, err := qs.state.GetStorage(param.Address, param.Key)
+	accounts, err := qs.accountsAtHeight(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	val, err := accounts.GetStorage(param.Address, param.Key)
 	return &StorageValue{Value: val}, err
 }
 
+// Returns the account state as it was after height, or the latest state for zero height
+func (qs *queryServer) accountsAtHeight(height uint64) (acmstate.Reader, error) {
+	if height == 0 {
+		return qs.state, nil
+	}
+	st, err := qs.state.AtHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return st, nil
+}
+
 func (qs *queryServer) ListAccounts(param *ListAccountsParam, stream Query_ListAccountsServer) error {
 	qry, err := query.NewOrEmpty(param.Query)
 	if err != nil {
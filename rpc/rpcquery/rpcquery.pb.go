@@ -91,10 +91,12 @@ func (*StatusParam) XXX_MessageName() string {
 }
 
 type GetAccountParam struct {
-	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Height at which to read the account, zero for the latest height
+	Height               uint64   `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetAccountParam) Reset()         { *m = GetAccountParam{} }
@@ -126,6 +128,13 @@ func (m *GetAccountParam) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetAccountParam proto.InternalMessageInfo
 
+func (m *GetAccountParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*GetAccountParam) XXX_MessageName() string {
 	return "rpcquery.GetAccountParam"
 }
@@ -219,11 +228,13 @@ func (*MetadataResult) XXX_MessageName() string {
 }
 
 type GetStorageParam struct {
-	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	Key                  github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Key     github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	// Height at which to read the storage, zero for the latest height
+	Height               uint64   `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetStorageParam) Reset()         { *m = GetStorageParam{} }
@@ -255,6 +266,13 @@ func (m *GetStorageParam) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetStorageParam proto.InternalMessageInfo
 
+func (m *GetStorageParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*GetStorageParam) XXX_MessageName() string {
 	return "rpcquery.GetStorageParam"
 }
@@ -1103,73 +1121,73 @@ func init() { proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f
 func init() { golang_proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f02) }
 
 var fileDescriptor_88e25d9b99e39f02 = []byte{
-	// 1048 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xff, 0x6f, 0xf3, 0x7d, 0xec, 0xd8, 0xed, 0x24, 0x7f, 0xd7, 0xdd, 0xb6, 0x4e, 0x19, 0x89,
-	0x34, 0x44, 0x65, 0x6d, 0x4c, 0xc3, 0x05, 0x5c, 0xa0, 0x3a, 0x80, 0x1d, 0x4a, 0xa3, 0xb0, 0x86,
-	0x56, 0x02, 0x09, 0x69, 0xb2, 0x3b, 0xb2, 0x57, 0x5d, 0xef, 0x98, 0xd9, 0xd9, 0x16, 0x3f, 0x06,
-	0x2f, 0xc0, 0x3d, 0x0f, 0xc0, 0x3d, 0x97, 0xb9, 0xe4, 0x12, 0xf5, 0x22, 0x42, 0xe9, 0x8b, 0xa0,
-	0x9d, 0x0f, 0xef, 0x47, 0xdc, 0x08, 0x10, 0xdc, 0x58, 0x73, 0xce, 0x9c, 0x0f, 0x9f, 0x33, 0xe7,
-	0xf7, 0x3b, 0x0b, 0x35, 0x3e, 0xf5, 0xbe, 0x4f, 0x28, 0x9f, 0x39, 0x53, 0xce, 0x04, 0x43, 0xeb,
-	0x46, 0xb6, 0xb7, 0x47, 0x6c, 0xc4, 0xa4, 0xb2, 0x9d, 0x9e, 0xd4, 0xbd, 0x7d, 0x47, 0xd0, 0xc8,
-	0xa7, 0x7c, 0x12, 0x44, 0xa2, 0x2d, 0x66, 0x53, 0x1a, 0xab, 0x5f, 0x7d, 0x5b, 0x89, 0xc8, 0x64,
-	0x2e, 0x6c, 0x10, 0x6f, 0xa2, 0x8f, 0xf5, 0x17, 0x24, 0x0c, 0x7c, 0x22, 0x18, 0xd7, 0x8a, 0x1a,
-	0xa7, 0xa3, 0x20, 0x16, 0x26, 0xad, 0xbd, 0xc1, 0xa7, 0x9e, 0x3e, 0x6e, 0x4e, 0xc9, 0x2c, 0x64,
-	0xc4, 0x57, 0x22, 0x0e, 0xa0, 0x32, 0x14, 0x44, 0x24, 0xf1, 0x09, 0xe1, 0x64, 0x82, 0xf6, 0xa0,
-	0xde, 0x0b, 0x99, 0xf7, 0xfc, 0xab, 0x60, 0x42, 0x9f, 0x05, 0x62, 0x1c, 0x44, 0x4d, 0xeb, 0x9e,
-	0xb5, 0xb7, 0xe1, 0x96, 0xd5, 0xa8, 0x03, 0x5b, 0x52, 0x35, 0xa4, 0x34, 0xca, 0x59, 0x5f, 0x93,
-	0xd6, 0x8b, 0xae, 0x30, 0x81, 0x7a, 0x9f, 0x8a, 0x47, 0x9e, 0xc7, 0x92, 0x48, 0xa8, 0x74, 0xc7,
-	0xb0, 0xf6, 0xc8, 0xf7, 0x39, 0x8d, 0x63, 0x99, 0xa6, 0xda, 0x7b, 0x78, 0x76, 0xbe, 0xf3, 0xbf,
-	0x57, 0xe7, 0x3b, 0x0f, 0x46, 0x81, 0x18, 0x27, 0xa7, 0x8e, 0xc7, 0x26, 0xed, 0xf1, 0x6c, 0x4a,
-	0x79, 0x48, 0xfd, 0x11, 0xe5, 0xed, 0xd3, 0x84, 0x73, 0xf6, 0xb2, 0xed, 0xf1, 0xd9, 0x54, 0x30,
-	0x47, 0xfb, 0xba, 0x26, 0x08, 0xfe, 0xc5, 0x82, 0xeb, 0x7d, 0x2a, 0x9e, 0x50, 0x41, 0x7c, 0x22,
-	0x88, 0x4a, 0xf2, 0x79, 0x39, 0x49, 0xe7, 0x1f, 0x27, 0x40, 0x5f, 0x43, 0xd5, 0x04, 0x1f, 0x90,
-	0x78, 0x2c, 0xcb, 0xad, 0xf6, 0xde, 0x7b, 0x75, 0xbe, 0xf3, 0xee, 0xd5, 0x01, 0x4f, 0x83, 0x88,
-	0xf0, 0x99, 0x33, 0xa0, 0x3f, 0xf4, 0x66, 0x82, 0xc6, 0x6e, 0x21, 0x0c, 0x7e, 0x00, 0x35, 0x23,
-	0xbb, 0x34, 0x4e, 0x42, 0x81, 0x6c, 0x58, 0x37, 0x1a, 0xfd, 0x02, 0x73, 0x19, 0xff, 0x6c, 0xc9,
-	0x4e, 0x0e, 0x05, 0xe3, 0x64, 0x44, 0xff, 0x93, 0x4e, 0xa2, 0xcf, 0x60, 0xe9, 0x31, 0x9d, 0xe9,
-	0xfa, 0xfe, 0x62, 0x2c, 0x5d, 0xe3, 0x33, 0xc6, 0xfd, 0xee, 0xc1, 0x07, 0x6e, 0x1a, 0x00, 0x7f,
-	0x0b, 0x55, 0xfd, 0x3f, 0x9f, 0x92, 0x30, 0xa1, 0xe8, 0x31, 0xac, 0xc8, 0x83, 0xfe, 0x97, 0x07,
-	0x3a, 0xf2, 0xdf, 0xec, 0x9e, 0x8a, 0x81, 0xdf, 0x81, 0x1b, 0x5f, 0x04, 0xb1, 0x19, 0x29, 0x3d,
-	0xc2, 0xdb, 0xb0, 0xf2, 0x65, 0x8a, 0x30, 0xdd, 0x36, 0x25, 0x60, 0x0c, 0xd5, 0x3e, 0x15, 0xc7,
-	0x64, 0xa2, 0xfb, 0x85, 0x60, 0x39, 0x15, 0xb4, 0x91, 0x3c, 0xe3, 0x5d, 0xa8, 0xa5, 0xe1, 0xd2,
-	0xf3, 0x95, 0xb1, 0x6e, 0xc1, 0xcd, 0x34, 0x16, 0x15, 0x2f, 0x19, 0x7f, 0xee, 0x6a, 0xa4, 0x49,
-	0x07, 0xdc, 0x80, 0xed, 0x3e, 0x15, 0x4f, 0x0d, 0x1c, 0x87, 0x54, 0x0d, 0x3a, 0xee, 0xc3, 0xed,
-	0x92, 0x7e, 0x10, 0xc4, 0x82, 0x69, 0xb7, 0x14, 0x76, 0x47, 0x91, 0x17, 0x26, 0x3e, 0x3d, 0xe1,
-	0xf4, 0x45, 0xc0, 0x12, 0xf5, 0x8a, 0x4b, 0x6e, 0x59, 0x8d, 0x7b, 0x50, 0x2f, 0x25, 0x46, 0x6d,
-	0x58, 0x1a, 0x52, 0xd1, 0xb4, 0xee, 0x2d, 0xed, 0x55, 0xba, 0x77, 0x9d, 0x39, 0xe3, 0x28, 0x03,
-	0xca, 0xa9, 0x3f, 0xcf, 0xeb, 0xa6, 0x96, 0xf8, 0x47, 0x0b, 0xb6, 0x16, 0x5c, 0xfe, 0xeb, 0x33,
-	0xb4, 0x0f, 0xcb, 0xc7, 0xcc, 0xa7, 0x72, 0x88, 0x2a, 0xdd, 0x86, 0x33, 0x27, 0xa5, 0x54, 0x7b,
-	0xe4, 0xd3, 0x48, 0x04, 0x62, 0xe6, 0x4a, 0x1b, 0xdc, 0x87, 0xad, 0x05, 0xdd, 0x41, 0x1d, 0x58,
-	0xd3, 0x47, 0x5d, 0x5f, 0x23, 0xab, 0x2f, 0x6f, 0xef, 0x1a, 0x33, 0x7c, 0x0c, 0xd5, 0xfc, 0x05,
-	0x6a, 0xc0, 0xea, 0x98, 0x06, 0xa3, 0xb1, 0x90, 0x35, 0x2d, 0xbb, 0x5a, 0x42, 0xbb, 0xaa, 0x6b,
-	0xd7, 0x64, 0xd4, 0x6d, 0x27, 0x63, 0xd0, 0x52, 0xb3, 0x76, 0x25, 0xa3, 0x9c, 0x70, 0x36, 0x65,
-	0x31, 0x09, 0xe7, 0xc3, 0x23, 0xd1, 0x2f, 0xbb, 0xe4, 0xca, 0x33, 0xee, 0x00, 0x4a, 0x87, 0xc7,
-	0x18, 0xea, 0x01, 0xb2, 0x61, 0x5d, 0x69, 0xa8, 0x2f, 0xad, 0xd7, 0xdd, 0xb9, 0x8c, 0x9f, 0x40,
-	0xcd, 0x58, 0x6b, 0xd0, 0x2f, 0x88, 0x8b, 0xee, 0xc3, 0x6a, 0x8f, 0x84, 0x21, 0x13, 0xba, 0x8d,
-	0x75, 0xc7, 0x10, 0xb8, 0x52, 0xbb, 0xfa, 0x1a, 0xd7, 0x61, 0x53, 0x92, 0x02, 0xd1, 0x40, 0xc0,
-	0x14, 0x56, 0xa4, 0x84, 0xf6, 0xe1, 0xba, 0x81, 0x48, 0x4a, 0xc5, 0x87, 0xe9, 0x9b, 0xa8, 0x66,
-	0x5c, 0xd2, 0xa7, 0xb4, 0x9e, 0xd7, 0xb1, 0x44, 0x1c, 0x9a, 0x27, 0x5c, 0x76, 0x17, 0x5d, 0xe1,
-	0xfb, 0x32, 0xaf, 0x24, 0x7c, 0x55, 0x73, 0x03, 0x56, 0x07, 0x85, 0x8e, 0x2b, 0xa9, 0xfb, 0xd3,
-	0x9a, 0x46, 0x13, 0xea, 0xc2, 0xaa, 0x5a, 0x3a, 0xe8, 0xff, 0xd9, 0x73, 0xe6, 0xd6, 0x90, 0x7d,
-	0x23, 0x55, 0x3b, 0xaa, 0x2b, 0xda, 0xf2, 0x00, 0x20, 0xdb, 0x1e, 0xe8, 0x56, 0xe6, 0x57, 0xda,
-	0x29, 0x76, 0xd5, 0x49, 0x17, 0xa3, 0x31, 0x3c, 0x84, 0x4a, 0x6e, 0x21, 0x20, 0xbb, 0xe0, 0x57,
-	0xd8, 0x13, 0x76, 0x33, 0xbb, 0x2b, 0x91, 0xf1, 0xc7, 0x32, 0xb7, 0xe6, 0xb1, 0x52, 0xee, 0x3c,
-	0x0b, 0xdb, 0x8d, 0x7c, 0x39, 0x39, 0xd6, 0xfb, 0x08, 0xaa, 0x79, 0xa2, 0x42, 0xb7, 0x33, 0xbb,
-	0x4b, 0x04, 0x56, 0x2c, 0xa0, 0x63, 0xa1, 0x36, 0xac, 0x69, 0xea, 0x42, 0x8d, 0x42, 0xea, 0x39,
-	0x9b, 0xd9, 0x55, 0x47, 0x7d, 0x19, 0x7c, 0x1a, 0xa5, 0x84, 0x70, 0x00, 0x1b, 0x73, 0x1e, 0x43,
-	0xcd, 0x62, 0xaa, 0x8c, 0xdc, 0x8a, 0x4e, 0x1d, 0x0b, 0xb9, 0x80, 0x2e, 0xd3, 0x1a, 0x7a, 0xab,
-	0x98, 0x72, 0x01, 0xe9, 0xd9, 0xb9, 0x86, 0x94, 0xbd, 0x8f, 0xe4, 0xa6, 0x2a, 0x00, 0xb2, 0x55,
-	0x08, 0x78, 0x89, 0x2a, 0xed, 0x37, 0x20, 0x1c, 0x7d, 0x07, 0x8d, 0xc5, 0x14, 0x8a, 0xde, 0x7e,
-	0x63, 0xc4, 0x3c, 0xc9, 0xda, 0x77, 0x17, 0x07, 0x36, 0x51, 0x3e, 0x94, 0x93, 0x62, 0x10, 0x59,
-	0x9a, 0x94, 0x02, 0xfe, 0xed, 0x32, 0x06, 0xd1, 0x11, 0x6c, 0x16, 0xc0, 0x8f, 0xee, 0x14, 0xbb,
-	0x5e, 0x64, 0x85, 0xfc, 0xa4, 0x15, 0x19, 0xa0, 0x63, 0xa1, 0x87, 0xb0, 0x6e, 0x60, 0x8c, 0x6e,
-	0x96, 0x26, 0xcd, 0x40, 0xdb, 0xae, 0x17, 0x61, 0x13, 0xa3, 0x43, 0xa8, 0x19, 0x10, 0x0e, 0x28,
-	0xf1, 0x29, 0x2f, 0xf9, 0x66, 0xf0, 0xb4, 0x9b, 0x4e, 0xf6, 0x8d, 0xe9, 0xa8, 0xaf, 0x4b, 0xe5,
-	0xd2, 0xfb, 0xe4, 0xec, 0xa2, 0x65, 0xfd, 0x76, 0xd1, 0xb2, 0x7e, 0xbf, 0x68, 0x59, 0x7f, 0x5c,
-	0xb4, 0xac, 0x5f, 0x5f, 0xb7, 0xac, 0xb3, 0xd7, 0x2d, 0xeb, 0x9b, 0xfd, 0xab, 0x17, 0x00, 0x9f,
-	0x7a, 0x6d, 0x93, 0xed, 0x74, 0x55, 0x7e, 0x58, 0xbe, 0xff, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe6, 0xd8, 0xa5, 0xb6, 0xfb, 0x0a, 0x00, 0x00,
+	// 1055 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x66, 0xf3, 0x9f, 0x13, 0xc7, 0x6e, 0x27, 0xc1, 0x75, 0xb7, 0xad, 0x53, 0x46, 0x22, 0x0d,
+	0x51, 0x59, 0x9b, 0xd0, 0x70, 0x01, 0x17, 0xa8, 0x0e, 0xe0, 0x84, 0xd2, 0x28, 0xac, 0xa1, 0x95,
+	0x40, 0x42, 0x9a, 0x78, 0x47, 0xf6, 0xaa, 0xeb, 0x1d, 0x33, 0x3b, 0xdb, 0xb2, 0x8f, 0xc1, 0x0b,
+	0xf0, 0x16, 0xdc, 0xc3, 0x5d, 0x2e, 0xb9, 0x44, 0xbd, 0x88, 0x50, 0xfa, 0x22, 0x68, 0xe7, 0x67,
+	0xff, 0xe2, 0x46, 0x2a, 0xa2, 0x37, 0xd6, 0x9c, 0x33, 0x67, 0xce, 0xe7, 0x39, 0x73, 0xbe, 0xef,
+	0x2c, 0xd4, 0xf9, 0x74, 0xf8, 0x73, 0x4c, 0x79, 0xe2, 0x4c, 0x39, 0x13, 0x0c, 0xad, 0x18, 0xdb,
+	0xde, 0x1c, 0xb1, 0x11, 0x93, 0xce, 0x4e, 0xba, 0x52, 0xfb, 0xf6, 0x6d, 0x41, 0x43, 0x8f, 0xf2,
+	0x89, 0x1f, 0x8a, 0x8e, 0x48, 0xa6, 0x34, 0x52, 0xbf, 0x7a, 0x77, 0x2d, 0x24, 0x93, 0xcc, 0x58,
+	0x25, 0xc3, 0x89, 0x5e, 0x36, 0x9e, 0x93, 0xc0, 0xf7, 0x88, 0x60, 0x5c, 0x3b, 0xea, 0x9c, 0x8e,
+	0xfc, 0x48, 0x18, 0x58, 0x7b, 0x95, 0x4f, 0x87, 0x7a, 0xb9, 0x3e, 0x25, 0x49, 0xc0, 0x88, 0xa7,
+	0x4c, 0xec, 0xc3, 0xda, 0x40, 0x10, 0x11, 0x47, 0x27, 0x84, 0x93, 0x09, 0xda, 0x81, 0x46, 0x2f,
+	0x60, 0xc3, 0x67, 0xdf, 0xf9, 0x13, 0xfa, 0xd4, 0x17, 0x63, 0x3f, 0x6c, 0x59, 0x77, 0xad, 0x9d,
+	0x55, 0xb7, 0xea, 0x46, 0x5d, 0xd8, 0x90, 0xae, 0x01, 0xa5, 0x61, 0x21, 0x7a, 0x4e, 0x46, 0xcf,
+	0xda, 0xc2, 0x09, 0x34, 0xfa, 0x54, 0x3c, 0x1c, 0x0e, 0x59, 0x1c, 0x0a, 0x05, 0x77, 0x0c, 0xcb,
+	0x0f, 0x3d, 0x8f, 0xd3, 0x28, 0x92, 0x30, 0xb5, 0xde, 0x83, 0xb3, 0xf3, 0xad, 0x77, 0x5e, 0x9e,
+	0x6f, 0xdd, 0x1f, 0xf9, 0x62, 0x1c, 0x9f, 0x3a, 0x43, 0x36, 0xe9, 0x8c, 0x93, 0x29, 0xe5, 0x01,
+	0xf5, 0x46, 0x94, 0x77, 0x4e, 0x63, 0xce, 0xd9, 0x8b, 0xce, 0x90, 0x27, 0x53, 0xc1, 0x1c, 0x7d,
+	0xd6, 0x35, 0x49, 0x50, 0x13, 0x96, 0x0e, 0xa9, 0x3f, 0x1a, 0x0b, 0xf9, 0x3f, 0x16, 0x5c, 0x6d,
+	0xe1, 0xdf, 0x2d, 0xb8, 0xd6, 0xa7, 0xe2, 0x31, 0x15, 0xc4, 0x23, 0x82, 0x28, 0xf0, 0xaf, 0xab,
+	0xe0, 0xdd, 0xff, 0x0e, 0xfc, 0x3d, 0xd4, 0x4c, 0xf2, 0x43, 0x12, 0x8d, 0x25, 0x7c, 0xad, 0xf7,
+	0xd1, 0xcb, 0xf3, 0xad, 0x0f, 0xaf, 0x4e, 0x78, 0xea, 0x87, 0x84, 0x27, 0xce, 0x21, 0xfd, 0xa5,
+	0x97, 0x08, 0x1a, 0xb9, 0xa5, 0x34, 0xf8, 0x3e, 0xd4, 0x8d, 0xed, 0xd2, 0x28, 0x0e, 0x04, 0xb2,
+	0x61, 0xc5, 0x78, 0xf4, 0xcb, 0x64, 0x36, 0xfe, 0xd3, 0x92, 0x15, 0x1e, 0x08, 0xc6, 0xc9, 0x88,
+	0xbe, 0x9d, 0x0a, 0x7f, 0x05, 0xf3, 0x8f, 0x68, 0xd2, 0x9a, 0x7b, 0x93, 0x5c, 0xfa, 0x8e, 0x4f,
+	0x19, 0xf7, 0xf6, 0xf6, 0x3f, 0x71, 0xd3, 0x04, 0x85, 0x97, 0x9a, 0x2f, 0xbd, 0xd4, 0x8f, 0x50,
+	0xd3, 0xff, 0xff, 0x09, 0x09, 0x62, 0x8a, 0x1e, 0xc1, 0xa2, 0x5c, 0xe8, 0x7f, 0xbf, 0xaf, 0x11,
+	0xdf, 0xb0, 0xaa, 0x2a, 0x07, 0xfe, 0x00, 0xae, 0x7f, 0xe3, 0x47, 0xa6, 0x05, 0x75, 0xcb, 0x6f,
+	0xc2, 0xe2, 0xb7, 0x29, 0x23, 0x75, 0x39, 0x95, 0x81, 0x31, 0xd4, 0xfa, 0x54, 0x1c, 0x93, 0x89,
+	0xae, 0x23, 0x82, 0x85, 0xd4, 0xd0, 0x41, 0x72, 0x8d, 0xb7, 0xa1, 0x9e, 0xa6, 0x4b, 0xd7, 0x57,
+	0xe6, 0xba, 0x09, 0x37, 0xd2, 0x5c, 0x54, 0xbc, 0x60, 0xfc, 0x99, 0xab, 0x99, 0x29, 0x0f, 0xe0,
+	0x26, 0x6c, 0xf6, 0xa9, 0x78, 0x62, 0xe8, 0x3b, 0xa0, 0x8a, 0x18, 0xb8, 0x0f, 0xb7, 0x2a, 0xfe,
+	0x43, 0x3f, 0x12, 0x8c, 0x27, 0x19, 0x4d, 0x8f, 0xc2, 0x61, 0x10, 0x7b, 0xf4, 0x84, 0xd3, 0xe7,
+	0x3e, 0x8b, 0xd5, 0xeb, 0xce, 0xbb, 0x55, 0x37, 0xee, 0x41, 0xa3, 0x02, 0x8c, 0x3a, 0x30, 0x3f,
+	0xa0, 0xa2, 0x65, 0xdd, 0x9d, 0xdf, 0x59, 0xdb, 0xbb, 0xe3, 0x64, 0x0a, 0xa5, 0x02, 0x28, 0xa7,
+	0x5e, 0x86, 0xeb, 0xa6, 0x91, 0xf8, 0x57, 0x0b, 0x36, 0x66, 0x6c, 0xfe, 0xef, 0xbd, 0xb5, 0x0b,
+	0x0b, 0xc7, 0xcc, 0xa3, 0xb2, 0xb9, 0xd6, 0xf6, 0x9a, 0x4e, 0x26, 0x62, 0xa9, 0xf7, 0xc8, 0xa3,
+	0xa1, 0xf0, 0x45, 0xe2, 0xca, 0x18, 0xdc, 0x87, 0x8d, 0x19, 0xd5, 0x41, 0x5d, 0x58, 0xd6, 0x4b,
+	0x7d, 0xbf, 0x66, 0x7e, 0xbf, 0x62, 0xbc, 0x6b, 0xc2, 0xf0, 0x31, 0xd4, 0x8a, 0x1b, 0x69, 0x63,
+	0x8e, 0x55, 0x63, 0x5a, 0xaa, 0x31, 0x95, 0x85, 0xb6, 0x55, 0xd5, 0xe6, 0x64, 0xd6, 0x4d, 0x27,
+	0x57, 0xdc, 0x4a, 0xb1, 0xb6, 0xa5, 0xd2, 0x9c, 0x70, 0x36, 0x65, 0x11, 0x09, 0xb2, 0xe6, 0x91,
+	0xaa, 0x20, 0xab, 0xe4, 0xca, 0x35, 0xee, 0x02, 0x4a, 0x9b, 0xc7, 0x04, 0xea, 0x06, 0xb2, 0x61,
+	0x45, 0x79, 0xa8, 0x27, 0xa3, 0x57, 0xdc, 0xcc, 0xc6, 0x8f, 0xa1, 0x6e, 0xa2, 0xb5, 0x18, 0xcc,
+	0xc8, 0x8b, 0xee, 0xc1, 0x52, 0x8f, 0x04, 0x01, 0x13, 0xba, 0x8c, 0x0d, 0xc7, 0x08, 0xbe, 0x72,
+	0xbb, 0x7a, 0x1b, 0x37, 0x60, 0x5d, 0x8a, 0x05, 0xd1, 0x44, 0xc0, 0x14, 0x16, 0xa5, 0x85, 0x76,
+	0xe1, 0x9a, 0xa1, 0x48, 0x2a, 0xdd, 0x07, 0xe9, 0x9b, 0xa8, 0x62, 0x5c, 0xf2, 0xa7, 0x63, 0xa0,
+	0xe8, 0x63, 0xb1, 0x38, 0x30, 0x4f, 0xb8, 0xe0, 0xce, 0xda, 0xc2, 0xf7, 0x24, 0xae, 0x1c, 0x10,
+	0xea, 0xce, 0xb9, 0x14, 0x58, 0x45, 0x29, 0xd8, 0xfb, 0x6d, 0x59, 0xb3, 0x09, 0xed, 0xc1, 0x92,
+	0x1a, 0x52, 0xe8, 0xdd, 0xfc, 0x39, 0x0b, 0x63, 0xcb, 0xbe, 0x9e, 0xba, 0x1d, 0x55, 0x15, 0x1d,
+	0xb9, 0x0f, 0x90, 0x4f, 0x1b, 0x74, 0x33, 0x3f, 0x57, 0x99, 0x41, 0x76, 0xcd, 0x49, 0x07, 0xa9,
+	0x09, 0x3c, 0x80, 0xb5, 0xc2, 0xa0, 0x40, 0x76, 0xe9, 0x5c, 0x69, 0x7e, 0xd8, 0xad, 0x7c, 0xaf,
+	0x22, 0xd2, 0x9f, 0x4b, 0x6c, 0xad, 0x63, 0x15, 0xec, 0xa2, 0x3a, 0xdb, 0xcd, 0xe2, 0x75, 0x0a,
+	0xaa, 0xf7, 0x19, 0xd4, 0x8a, 0x42, 0x85, 0x6e, 0xe5, 0x71, 0x97, 0x04, 0xac, 0x7c, 0x81, 0xae,
+	0x85, 0x3a, 0xb0, 0xac, 0xa5, 0x0b, 0x35, 0x4b, 0xd0, 0x99, 0x9a, 0xd9, 0x35, 0x47, 0x7d, 0x49,
+	0x7c, 0x19, 0xa6, 0x82, 0xb0, 0x0f, 0xab, 0x99, 0x8e, 0xa1, 0x56, 0x19, 0x2a, 0x17, 0xb7, 0xf2,
+	0xa1, 0xae, 0x85, 0x5c, 0x40, 0x97, 0x65, 0x0d, 0xbd, 0x57, 0x86, 0x9c, 0x21, 0x7a, 0x76, 0xa1,
+	0x20, 0xd5, 0xd3, 0x47, 0x72, 0x82, 0x95, 0x08, 0xd9, 0x2e, 0x25, 0xbc, 0x24, 0x95, 0xf6, 0x6b,
+	0x18, 0x8e, 0x7e, 0x82, 0xe6, 0x6c, 0x09, 0x45, 0xef, 0xbf, 0x36, 0x63, 0x51, 0x64, 0xed, 0x3b,
+	0xb3, 0x13, 0x9b, 0x2c, 0x9f, 0xca, 0x4e, 0x31, 0x8c, 0xac, 0x74, 0x4a, 0x89, 0xff, 0x76, 0x95,
+	0x83, 0xe8, 0x08, 0xd6, 0x4b, 0xe4, 0x47, 0xb7, 0xcb, 0x55, 0x2f, 0xab, 0x42, 0xb1, 0xd3, 0xca,
+	0x0a, 0xd0, 0xb5, 0xd0, 0x03, 0x58, 0x31, 0x34, 0x46, 0x37, 0x2a, 0x9d, 0x66, 0xa8, 0x6d, 0x37,
+	0xca, 0xb4, 0x89, 0xd0, 0x01, 0xd4, 0x0d, 0x09, 0x0f, 0x29, 0xf1, 0x28, 0xaf, 0x9c, 0xcd, 0xe9,
+	0x69, 0xb7, 0x9c, 0xfc, 0x9b, 0xd4, 0x51, 0x5f, 0xa3, 0xea, 0x48, 0xef, 0x8b, 0xb3, 0x8b, 0xb6,
+	0xf5, 0xd7, 0x45, 0xdb, 0xfa, 0xfb, 0xa2, 0x6d, 0xfd, 0x73, 0xd1, 0xb6, 0xfe, 0x78, 0xd5, 0xb6,
+	0xce, 0x5e, 0xb5, 0xad, 0x1f, 0x76, 0xaf, 0x1e, 0x00, 0x7c, 0x3a, 0xec, 0x18, 0xb4, 0xd3, 0x25,
+	0xf9, 0x21, 0xfa, 0xf1, 0xbf, 0x03, 0x00, 0x58, 0x7b, 0x94, 0x77, 0x2b, 0x0b, 0x00, 0x00,
 }
 
 func (m *StatusParam) Marshal() (dAtA []byte, err error) {
@@ -1237,6 +1255,11 @@ func (m *GetAccountParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Height != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.Address.Size()
 		i -= size
@@ -1359,6 +1382,11 @@ func (m *GetStorageParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Height != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.Key.Size()
 		i -= size
@@ -2036,6 +2064,9 @@ func (m *GetAccountParam) Size() (n int) {
 	_ = l
 	l = m.Address.Size()
 	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2088,6 +2119,9 @@ func (m *GetStorageParam) Size() (n int) {
 	n += 1 + l + sovRpcquery(uint64(l))
 	l = m.Key.Size()
 	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2549,6 +2583,25 @@ func (m *GetAccountParam) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -2870,6 +2923,25 @@ func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
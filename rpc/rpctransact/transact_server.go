@@ -11,6 +11,7 @@ import (
 	"github.com/hyperledger/burrow/bcm"
 
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
@@ -25,16 +26,19 @@ type transactServer struct {
 	stateSnapshot func() (acmstate.Reader, error)
 	blockchain    bcm.BlockchainInfo
 	transactor    *execution.Transactor
+	vmOptions     engine.Options
 	txCodec       txs.Codec
 	logger        *logging.Logger
 }
 
 func NewTransactServer(stateSnapshotter func() (acmstate.Reader, error), blockchain bcm.BlockchainInfo,
-	transactor *execution.Transactor, txCodec txs.Codec, logger *logging.Logger) TransactServer {
+	transactor *execution.Transactor, vmOptions engine.Options, txCodec txs.Codec,
+	logger *logging.Logger) TransactServer {
 	return &transactServer{
 		stateSnapshot: stateSnapshotter,
 		blockchain:    blockchain,
 		transactor:    transactor,
+		vmOptions:     vmOptions,
 		txCodec:       txCodec,
 		logger:        logger.WithScope("NewTransactServer()"),
 	}
@@ -107,7 +111,7 @@ func (ts *transactServer) CallTxSim(ctx context.Context, param *payload.CallTx)
 	if err != nil {
 		return nil, err
 	}
-	return execution.CallSim(st, ts.blockchain, param.Input.Address, *param.Address, param.Data, ts.logger)
+	return execution.CallSim(st, ts.blockchain, ts.vmOptions, param.Input.Address, *param.Address, param.Data, ts.logger)
 }
 
 func (ts *transactServer) CallCodeSim(ctx context.Context, param *CallCodeParam) (*exec.TxExecution, error) {
@@ -116,7 +120,7 @@ func (ts *transactServer) CallCodeSim(ctx context.Context, param *CallCodeParam)
 	if err != nil {
 		return nil, err
 	}
-	return execution.CallCodeSim(st, ts.blockchain, param.FromAddress, param.FromAddress, param.Code, param.Data,
+	return execution.CallCodeSim(st, ts.blockchain, ts.vmOptions, param.FromAddress, param.FromAddress, param.Code, param.Data,
 		ts.logger)
 }
 
@@ -0,0 +1,97 @@
+package web3
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+)
+
+// EthServer serves an EthService over JSON-RPC. It extends the generated Server with log filters whose address may be
+// a list of addresses and whose topics may each be a list of alternatives, which the generated Filter cannot decode.
+type EthServer struct {
+	*Server
+	service *EthService
+}
+
+func NewEthServer(service *EthService) *EthServer {
+	return &EthServer{
+		Server:  NewServer(service),
+		service: service,
+	}
+}
+
+func (srv *EthServer) HandleHTTP(rpcPath string) {
+	http.Handle(rpcPath, srv)
+}
+
+func (srv *EthServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		// Preflight and invalid requests are answered in the same way as by the generated server
+		srv.Server.ServeHTTP(w, r)
+		return
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		WriteData(w, ErrInvalidRequest.RPCError().AsRPCErrorResponse(nil))
+		return
+	}
+	r.Body.Close()
+
+	requests := make([]RPCRequest, 0)
+	err = json.Unmarshal(data, &requests)
+	if err != nil {
+		request := new(RPCRequest)
+		err = json.Unmarshal(data, request)
+		if err != nil {
+			WriteData(w, ErrCouldNotParse.RPCError().AsRPCErrorResponse(nil))
+			return
+		}
+		requests = []RPCRequest{*request}
+	}
+
+	responses := make([]interface{}, 0)
+	for _, req := range requests {
+		responses = append(responses, srv.Do(req))
+	}
+
+	if len(responses) == 1 {
+		WriteData(w, responses[0])
+	} else {
+		WriteData(w, responses)
+	}
+}
+
+// Do handles eth_getLogs and eth_newFilter, passing all other methods to the generated Server
+func (srv *EthServer) Do(in RPCRequest) interface{} {
+	var out interface{}
+	var err error
+
+	switch in.Method {
+	case "eth_getLogs", "eth_newFilter":
+		if in.JSONRPC != JSONRPC || in.ID == nil {
+			return ErrInvalidParams.RPCError().AsRPCErrorResponse(nil)
+		}
+		req := new(LogFilterParams)
+		err = ParamsToStruct(in.Params, req)
+		if err == nil {
+			if in.Method == "eth_getLogs" {
+				out, err = srv.service.GetLogs(&req.LogFilter)
+			} else {
+				out, err = srv.service.NewFilter(&req.LogFilter)
+			}
+		}
+	default:
+		return srv.Server.Do(in)
+	}
+
+	if err != nil {
+		return ErrInternal.RPCErrorWithMessage(err.Error()).AsRPCErrorResponse(in.ID)
+	}
+
+	return RPCResultResponse{
+		JSONRPC: JSONRPC,
+		ID:      in.ID,
+		Result:  StructToResult(out),
+	}
+}
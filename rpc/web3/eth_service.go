@@ -10,19 +10,23 @@ import (
 	"github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/encoding/rlp"
 	"github.com/hyperledger/burrow/encoding/web3hex"
+	"github.com/hyperledger/burrow/event/query"
 
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/balance"
 	"github.com/hyperledger/burrow/acm/validator"
 	bcm "github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/project"
+	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	tmConfig "github.com/tendermint/tendermint/config"
@@ -40,12 +44,15 @@ const (
 type EthService struct {
 	accounts   acmstate.IterableStatsReader
 	events     EventsReader
+	states     StateReader
 	blockchain bcm.BlockchainInfo
 	validators validator.History
 	nodeView   *tendermint.NodeView
 	trans      *execution.Transactor
 	keyClient  keys.KeyClient
 	keyStore   *keys.FilesystemKeyStore
+	vmOptions  engine.Options
+	filters    *filterRegistry
 	config     *tmConfig.Config
 	chainID    *big.Int
 	logger     *logging.Logger
@@ -55,11 +62,13 @@ type EthService struct {
 func NewEthService(
 	accounts acmstate.IterableStatsReader,
 	events EventsReader,
+	states StateReader,
 	blockchain bcm.BlockchainInfo,
 	validators validator.History,
 	nodeView *tendermint.NodeView,
 	trans *execution.Transactor,
 	keyStore *keys.FilesystemKeyStore,
+	vmOptions engine.Options,
 	logger *logging.Logger,
 ) *EthService {
 
@@ -68,12 +77,15 @@ func NewEthService(
 	return &EthService{
 		accounts:   accounts,
 		events:     events,
+		states:     states,
 		blockchain: blockchain,
 		validators: validators,
 		nodeView:   nodeView,
 		trans:      trans,
 		keyClient:  keyClient,
 		keyStore:   keyStore,
+		vmOptions:  vmOptions,
+		filters:    newFilterRegistry(DefaultFilterTimeout),
 		config:     tmConfig.DefaultConfig(),
 		// Ethereum expects ChainID to be an integer value
 		chainID: encoding.GetEthChainID(blockchain.ChainID()),
@@ -86,10 +98,19 @@ var _ Service = &EthService{}
 type EventsReader interface {
 	TxsAtHeight(height uint64) ([]*exec.TxExecution, error)
 	TxByHash(txHash []byte) (*exec.TxExecution, error)
+	IterateStreamEvents(startHeight, endHeight *uint64, sortOrder storage.SortOrder,
+		consumer func(*exec.StreamEvent) error) error
 }
 
 var _ EventsReader = &state.State{}
 
+// StateReader provides the merklised state as it was after each height
+type StateReader interface {
+	AtHeight(height uint64) (*state.ImmutableState, error)
+}
+
+var _ StateReader = &state.State{}
+
 // Web3ClientVersion returns the version of burrow
 func (srv *EthService) Web3ClientVersion() (*Web3ClientVersionResult, error) {
 	return &Web3ClientVersionResult{
@@ -160,7 +181,7 @@ func (srv *EthService) EthCall(req *EthCallParams) (*EthCallResult, error) {
 	if d.Err() != nil {
 		return nil, d.Err()
 	}
-	txe, err := execution.CallSim(srv.accounts, srv.blockchain, from, to, data, srv.logger)
+	txe, err := execution.CallSim(srv.accounts, srv.blockchain, srv.vmOptions, from, to, data, srv.logger)
 	if err != nil {
 		return nil, err
 	} else if txe.Exception != nil {
@@ -185,8 +206,11 @@ func (srv *EthService) EthGetBalance(req *EthGetBalanceParams) (*EthGetBalanceRe
 		return nil, d.Err()
 	}
 
-	// TODO: read account state at height
-	acc, err := srv.accounts.GetAccount(addr)
+	accounts, err := srv.accountsAtBlock(req.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := accounts.GetAccount(addr)
 	if err != nil {
 		return nil, err
 	} else if acc == nil {
@@ -274,7 +298,11 @@ func (srv *EthService) EthGetCode(req *EthGetCodeParams) (*EthGetCodeResult, err
 		return nil, d.Err()
 	}
 
-	acc, err := srv.accounts.GetAccount(addr)
+	accounts, err := srv.accountsAtBlock(req.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := accounts.GetAccount(addr)
 	if err != nil {
 		return nil, err
 	} else if acc == nil {
@@ -286,9 +314,30 @@ func (srv *EthService) EthGetCode(req *EthGetCodeParams) (*EthGetCodeResult, err
 	}, nil
 }
 
+// EthGetStorageAt returns the word stored at position in the storage of a contract, which is zero if unset
 func (srv *EthService) EthGetStorageAt(req *EthGetStorageAtParams) (*EthGetStorageAtResult, error) {
-	// TODO
-	return nil, ErrNotFound
+	d := new(web3hex.Decoder)
+	addr := d.Address(req.Address)
+	position := d.Bytes(req.Position)
+	if d.Err() != nil {
+		return nil, d.Err()
+	}
+	if len(position) > binary.Word256Bytes {
+		return nil, fmt.Errorf("storage position %s is longer than %d bytes", req.Position, binary.Word256Bytes)
+	}
+
+	accounts, err := srv.accountsAtBlock(req.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	value, err := accounts.GetStorage(addr, binary.LeftPadWord256(position))
+	if err != nil {
+		return nil, err
+	}
+
+	return &EthGetStorageAtResult{
+		DataWord: web3hex.Encoder.Bytes(binary.LeftPadWord256(value).Bytes()),
+	}, nil
 }
 
 func (srv *EthService) EthGetTransactionByBlockHashAndIndex(req *EthGetTransactionByBlockHashAndIndexParams) (*EthGetTransactionByBlockHashAndIndexResult, error) {
@@ -402,8 +451,11 @@ func (srv *EthService) EthGetTransactionCount(req *EthGetTransactionCountParams)
 		return nil, d.Err()
 	}
 
-	// TODO: get tx count at height
-	acc, err := srv.accounts.GetAccount(addr)
+	accounts, err := srv.accountsAtBlock(req.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := accounts.GetAccount(addr)
 	if err != nil {
 		return nil, err
 	}
@@ -461,18 +513,30 @@ func (srv *EthService) EthGetTransactionReceipt(req *EthGetTransactionReceiptPar
 		status = web3hex.Encoder.Uint64(0)
 	}
 
+	txes, err := srv.events.TxsAtHeight(txe.Height)
+	if err != nil {
+		return nil, err
+	}
+	// Logs are numbered by their position in the block so we need all preceding transactions
+	logs := make([]Logs, 0)
+	for _, log := range blockLogs(block, txes, query.Empty{}) {
+		if log.TransactionHash == web3hex.Encoder.Bytes(hash) {
+			logs = append(logs, log)
+		}
+	}
+
 	result := &EthGetTransactionReceiptResult{
 		Receipt: Receipt{
 			Status:            status,
 			TransactionIndex:  web3hex.Encoder.Uint64(txe.GetIndex()),
 			BlockNumber:       web3hex.Encoder.Uint64(uint64(block.Height)),
-			BlockHash:         web3hex.Encoder.Bytes(block.Hash()),
+			BlockHash:         hexKeccak(block.Hash().Bytes()),
 			From:              web3hex.Encoder.Bytes(tx.GetInput().Address.Bytes()),
 			GasUsed:           web3hex.Encoder.Uint64(txe.Result.GetGasUsed()),
 			TransactionHash:   web3hex.Encoder.Bytes(hash),
 			CumulativeGasUsed: hexZero,
 			LogsBloom:         hexZero,
-			Logs:              []Logs{},
+			Logs:              logs,
 		},
 	}
 
@@ -486,6 +550,246 @@ func (srv *EthService) EthGetTransactionReceipt(req *EthGetTransactionReceiptPar
 	return result, nil
 }
 
+// EthGetLogs returns the logs matching the filter's address and topics within its block range
+func (srv *EthService) EthGetLogs(req *EthGetLogsParams) (*EthGetLogsResult, error) {
+	return srv.GetLogs(NewLogFilter(&req.Filter))
+}
+
+// GetLogs is EthGetLogs for a filter that may list several addresses and alternatives for each topic
+func (srv *EthService) GetLogs(filter *LogFilter) (*EthGetLogsResult, error) {
+	qry, err := LogQuery(filter)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := srv.getLogRange(filter)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := srv.getLogs(qry, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &EthGetLogsResult{
+		Logs: logs,
+	}, nil
+}
+
+// EthNewFilter installs a log filter on the node that can be polled for new logs with eth_getFilterChanges
+func (srv *EthService) EthNewFilter(req *EthNewFilterParams) (*EthNewFilterResult, error) {
+	return srv.NewFilter(NewLogFilter(&req.Filter))
+}
+
+// NewFilter is EthNewFilter for a filter that may list several addresses and alternatives for each topic
+func (srv *EthService) NewFilter(filter *LogFilter) (*EthNewFilterResult, error) {
+	qry, err := LogQuery(filter)
+	if err != nil {
+		return nil, err
+	}
+	// Check the block range is valid now rather than on first poll
+	_, _, err = srv.getLogRange(filter)
+	if err != nil {
+		return nil, err
+	}
+	id, err := srv.filters.install(&logFilter{
+		LogFilter: filter,
+		query:     qry,
+		// Changes are reported from the first block committed after the filter is installed
+		cursor: srv.blockchain.LastBlockHeight() + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &EthNewFilterResult{
+		FilterId: id,
+	}, nil
+}
+
+// EthGetFilterChanges returns the logs matching the filter that have been committed since it was last polled
+func (srv *EthService) EthGetFilterChanges(req *EthGetFilterChangesParams) (*EthGetFilterChangesResult, error) {
+	filter, err := srv.filters.poll(req.FilterId)
+	if err != nil {
+		return nil, err
+	}
+	filter.Lock()
+	defer filter.Unlock()
+
+	start, end, err := srv.getLogRange(filter.LogFilter)
+	if err != nil {
+		return nil, err
+	}
+	// A filter from the latest block reports every block committed since it was installed
+	if start < filter.cursor || isLatest(filter.FromBlock) {
+		start = filter.cursor
+	}
+	logs := make([]Logs, 0)
+	if start <= end {
+		logs, err = srv.getLogs(filter.query, start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if last := srv.blockchain.LastBlockHeight(); end > last {
+		end = last
+	}
+	if end >= filter.cursor {
+		filter.cursor = end + 1
+	}
+	results := make([]LogResult, len(logs))
+	for i, log := range logs {
+		results[i] = LogResult{
+			LogIndex:         log.LogIndex,
+			TransactionIndex: log.TransactionIndex,
+			TransactionHash:  log.TransactionHash,
+			Address:          log.Address,
+			BlockHash:        log.BlockHash,
+			BlockNumber:      log.BlockNumber,
+			Data:             log.Data,
+			Topics:           log.Topics,
+		}
+	}
+	return &EthGetFilterChangesResult{
+		LogResult: results,
+	}, nil
+}
+
+// EthGetFilterLogs returns all logs matching the filter over its entire block range
+func (srv *EthService) EthGetFilterLogs(req *EthGetFilterLogsParams) (*EthGetFilterLogsResult, error) {
+	filter, err := srv.filters.poll(req.FilterId)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := srv.getLogRange(filter.LogFilter)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := srv.getLogs(filter.query, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &EthGetFilterLogsResult{
+		Logs: logs,
+	}, nil
+}
+
+// EthUninstallFilter removes a filter, filters are also removed if they are not polled within DefaultFilterTimeout
+func (srv *EthService) EthUninstallFilter(req *EthUninstallFilterParams) (*EthUninstallFilterResult, error) {
+	return &EthUninstallFilterResult{
+		FilterUninstalledSuccess: srv.filters.uninstall(req.FilterId),
+	}, nil
+}
+
+// Resolves the inclusive block range of a filter, where fromBlock and toBlock default to the latest block
+func (srv *EthService) getLogRange(filter *LogFilter) (uint64, uint64, error) {
+	if filter.BlockHash != "" {
+		if filter.FromBlock != "" || filter.ToBlock != "" {
+			return 0, 0, fmt.Errorf("filter cannot specify both blockHash and fromBlock/toBlock")
+		}
+		height, err := srv.getBlockHeightByHash(filter.BlockHash)
+		if err != nil {
+			return 0, 0, err
+		}
+		return height, height, nil
+	}
+	start, err := srv.getHeightByWordOrNumber(orLatest(filter.FromBlock))
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not parse fromBlock: %w", err)
+	}
+	end, err := srv.getHeightByWordOrNumber(orLatest(filter.ToBlock))
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not parse toBlock: %w", err)
+	}
+	return start, end, nil
+}
+
+func orLatest(blockNumber string) string {
+	if blockNumber == "" {
+		return "latest"
+	}
+	return blockNumber
+}
+
+func isLatest(blockNumber string) bool {
+	switch blockNumber {
+	case "", "latest", "pending":
+		return true
+	default:
+		return false
+	}
+}
+
+// Returns the logs matching qry from the blocks in the closed interval [start, end]
+func (srv *EthService) getLogs(qry query.Query, start, end uint64) ([]Logs, error) {
+	logs := make([]Logs, 0)
+	if start > end {
+		return logs, nil
+	}
+	// Empty blocks are not stored so we expect gaps between heights
+	accumulator := exec.NewBlockAccumulator(exec.NonConsecutiveBlocks)
+	err := srv.events.IterateStreamEvents(&start, &end, storage.AscendingSort, func(ev *exec.StreamEvent) error {
+		be, err := accumulator.Consume(ev)
+		if err != nil || be == nil {
+			return err
+		}
+		head, err := srv.blockchain.GetBlockHeader(be.Height)
+		if err != nil {
+			return err
+		}
+		logs = append(logs, blockLogs(head, be.TxExecutions, qry)...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// Returns the logs from txes (the transactions of the block with head) that match qry, where logs are numbered by
+// their position in the block including those that do not match. Logs of reverted transactions are excluded.
+func blockLogs(head *types.Header, txes []*exec.TxExecution, qry query.Query) []Logs {
+	var logs []Logs
+	var logIndex uint64
+	var appendLogs func(txIndex uint64, txe *exec.TxExecution)
+	appendLogs = func(txIndex uint64, txe *exec.TxExecution) {
+		if txe.Exception != nil {
+			return
+		}
+		for _, ev := range txe.Events {
+			if ev.Log == nil {
+				continue
+			}
+			if qry.Matches(ev) {
+				logs = append(logs, getLog(head, txIndex, logIndex, ev))
+			}
+			logIndex++
+		}
+		// Transactions nested by a proposal are reported against their parent's position in the block
+		for _, child := range txe.TxExecutions {
+			appendLogs(txIndex, child)
+		}
+	}
+	for _, txe := range txes {
+		appendLogs(txe.GetIndex(), txe)
+	}
+	return logs
+}
+
+func getLog(head *types.Header, txIndex, logIndex uint64, ev *exec.Event) Logs {
+	topics := make([]Topics, len(ev.Log.Topics))
+	for i, topic := range ev.Log.Topics {
+		topics[i] = Topics{DataWord: web3hex.Encoder.Bytes(topic.Bytes())}
+	}
+	return Logs{
+		LogIndex:         web3hex.Encoder.Uint64(logIndex),
+		TransactionIndex: web3hex.Encoder.Uint64(txIndex),
+		TransactionHash:  web3hex.Encoder.Bytes(ev.Header.TxHash),
+		Address:          web3hex.Encoder.Bytes(ev.Log.Address.Bytes()),
+		BlockHash:        hexKeccak(head.Hash().Bytes()),
+		BlockNumber:      web3hex.Encoder.Uint64(uint64(head.Height)),
+		Data:             web3hex.Encoder.Bytes(ev.Log.Data),
+		Topics:           topics,
+	}
+}
+
 // EthHashrate returns the configured tendermint commit timeout
 func (srv *EthService) EthHashrate() (*EthHashrateResult, error) {
 	return &EthHashrateResult{
@@ -535,10 +839,19 @@ func (srv *EthService) EthPendingTransactions() (*EthPendingTransactionsResult,
 	}, nil
 }
 
+// EthEstimateGas simulates the transaction against the latest state to find the lowest gas limit under which it
+// succeeds, returning the exception (including any revert reason) if it fails with the maximum gas limit
 func (srv *EthService) EthEstimateGas(req *EthEstimateGasParams) (*EthEstimateGasResult, error) {
-	// TODO
+	tx, err := getCallTx(&req.Transaction)
+	if err != nil {
+		return nil, err
+	}
+	gas, err := execution.EstimateGas(srv.accounts, srv.blockchain, srv.vmOptions, tx, srv.logger)
+	if err != nil {
+		return nil, err
+	}
 	return &EthEstimateGasResult{
-		GasUsed: hexZero,
+		GasUsed: web3hex.Encoder.Uint64(gas),
 	}, nil
 }
 
@@ -644,7 +957,7 @@ func (srv *EthService) EthSyncing() (*EthSyncingResult, error) {
 }
 
 func (srv *EthService) getBlockHeightByHash(hash string) (uint64, error) {
-	for i := uint64(1); i < srv.blockchain.LastBlockHeight(); i++ {
+	for i := uint64(1); i <= srv.blockchain.LastBlockHeight(); i++ {
 		head, err := srv.blockchain.GetBlockHeader(i)
 		if err != nil {
 			return 0, err
@@ -724,7 +1037,7 @@ func (srv *EthService) getBlockInfoAtHeight(height uint64, includeTxs bool) (Blo
 		Hash:             hexKeccak(block.Hash().Bytes()),
 		ParentHash:       hexKeccak(block.Hash().Bytes()),
 		TransactionsRoot: hexKeccak(block.Hash().Bytes()),
-		StateRoot:        hexKeccak(block.Hash().Bytes()),
+		StateRoot:        web3hex.Encoder.Bytes(block.AppHash),
 		ReceiptsRoot:     hexKeccak(block.Hash().Bytes()),
 		Nonce:            hexZeroNonce,
 		Size:             web3hex.Encoder.Uint64(uint64(numTxs)),
@@ -786,6 +1099,18 @@ func (srv *EthService) getHeightByWord(height string) (uint64, bool) {
 	}
 }
 
+// Returns the account state as it was after the block with the given number or tag, which defaults to the latest
+func (srv *EthService) accountsAtBlock(blockNumber string) (acmstate.Reader, error) {
+	if isLatest(blockNumber) {
+		return srv.accounts, nil
+	}
+	height, err := srv.getHeightByWordOrNumber(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return srv.states.AtHeight(height)
+}
+
 func getHeightByNumber(height string) (uint64, error) {
 	d := new(web3hex.Decoder)
 	return d.Uint64(height), d.Err()
@@ -806,13 +1131,34 @@ func (srv *EthService) getHeightByWordOrNumber(i string) (uint64, error) {
 // EthSendTransaction constructs, signs and broadcasts a tx from the local node
 // Note: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1767.md#rationale
 func (srv *EthService) EthSendTransaction(req *EthSendTransactionParams) (*EthSendTransactionResult, error) {
+	tx, err := getCallTx(&req.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	txEnv := txs.Enclose(srv.blockchain.ChainID(), tx)
+
+	ctx := context.Background()
+	txe, err := srv.trans.BroadcastTxSync(ctx, txEnv)
+	if err != nil {
+		return nil, err
+	} else if txe.Exception != nil {
+		return nil, txe.Exception.AsError()
+	}
+
+	return &EthSendTransactionResult{
+		TransactionHash: web3hex.Encoder.Bytes(txe.GetTxHash().Bytes()),
+	}, nil
+}
+
+func getCallTx(transaction *Transaction) (*payload.CallTx, error) {
 	tx := &payload.CallTx{
 		Input: new(payload.TxInput),
 	}
 
 	var err error
 	d := new(web3hex.Decoder)
-	if from := req.Transaction.From; from != "" {
+	if from := transaction.From; from != "" {
 		tx.Input.Address = d.Address(from)
 		if d.Err() != nil {
 			return nil, fmt.Errorf("failed to parse from address: %v", d.Err())
@@ -821,14 +1167,14 @@ func (srv *EthService) EthSendTransaction(req *EthSendTransactionParams) (*EthSe
 		return nil, fmt.Errorf("no from address specified")
 	}
 
-	if value := req.Transaction.Value; value != "" {
+	if value := transaction.Value; value != "" {
 		tx.Input.Amount, err = strconv.ParseUint(value, 0, 64)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse amount: %v", err)
 		}
 	}
 
-	if to := req.Transaction.To; to != "" {
+	if to := transaction.To; to != "" {
 		addr := d.Address(to)
 		if d.Err() != nil {
 			return nil, fmt.Errorf("failed to parse to address: %v", d.Err())
@@ -837,41 +1183,28 @@ func (srv *EthService) EthSendTransaction(req *EthSendTransactionParams) (*EthSe
 	}
 
 	// gas provided for the transaction execution
-	if gasLimit := req.Transaction.Gas; gasLimit != "" {
+	if gasLimit := transaction.Gas; gasLimit != "" {
 		tx.GasLimit, err = strconv.ParseUint(gasLimit, 0, 64)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse gasLimit: %v", err)
 		}
 	}
 
-	if gasPrice := req.Transaction.GasPrice; gasPrice != "" {
+	if gasPrice := transaction.GasPrice; gasPrice != "" {
 		tx.GasPrice, err = strconv.ParseUint(gasPrice, 0, 64)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse gasPrice: %v", err)
 		}
 	}
 
-	if data := req.Transaction.Data; data != "" {
+	if data := transaction.Data; data != "" {
 		bs := d.Bytes(data)
 		if d.Err() != nil {
 			return nil, fmt.Errorf("failed to parse data: %v", d.Err())
 		}
 		tx.Data = bs
 	}
-
-	txEnv := txs.Enclose(srv.blockchain.ChainID(), tx)
-
-	ctx := context.Background()
-	txe, err := srv.trans.BroadcastTxSync(ctx, txEnv)
-	if err != nil {
-		return nil, err
-	} else if txe.Exception != nil {
-		return nil, txe.Exception.AsError()
-	}
-
-	return &EthSendTransactionResult{
-		TransactionHash: web3hex.Encoder.Bytes(txe.GetTxHash().Bytes()),
-	}, nil
+	return tx, nil
 }
 
 // EthAccounts returns all accounts signable from the local node
@@ -933,10 +1266,6 @@ func (srv *EthService) EthSign(req *EthSignParams) (*EthSignResult, error) {
 
 // N / A
 
-func (srv *EthService) EthUninstallFilter(*EthUninstallFilterParams) (*EthUninstallFilterResult, error) {
-	return nil, ErrNotFound
-}
-
 func (srv *EthService) EthSubmitHashrate(req *EthSubmitHashrateParams) (*EthSubmitHashrateResult, error) {
 	return nil, ErrNotFound
 }
@@ -949,10 +1278,6 @@ func (srv *EthService) EthNewBlockFilter() (*EthNewBlockFilterResult, error) {
 	return nil, ErrNotFound
 }
 
-func (srv *EthService) EthNewFilter(req *EthNewFilterParams) (*EthNewFilterResult, error) {
-	return nil, ErrNotFound
-}
-
 func (srv *EthService) EthNewPendingTransactionFilter() (*EthNewPendingTransactionFilterResult, error) {
 	return nil, ErrNotFound
 }
@@ -973,26 +1298,88 @@ func (srv *EthService) EthGetUncleCountByBlockNumber(req *EthGetUncleCountByBloc
 	return nil, ErrNotFound
 }
 
+// EthGetProof returns merkle proofs of an account and some of its storage as of the requested block. The proofs are of
+// the state after the block so can be checked against the AppHash of the next block (the stateRoot of that block).
 func (srv *EthService) EthGetProof(req *EthGetProofParams) (*EthGetProofResult, error) {
-	return nil, ErrNotFound
-}
+	d := new(web3hex.Decoder)
+	address := d.Address(req.Address)
+	storageKeys := make([]binary.Word256, len(req.StorageKeys))
+	for i, k := range req.StorageKeys {
+		bs := d.Bytes(k)
+		if len(bs) > binary.Word256Bytes {
+			return nil, fmt.Errorf("storage key %s is longer than %d bytes", k, binary.Word256Bytes)
+		}
+		storageKeys[i] = binary.LeftPadWord256(bs)
+	}
+	if d.Err() != nil {
+		return nil, d.Err()
+	}
 
-func (srv *EthService) EthGetWork() (*EthGetWorkResult, error) {
-	return nil, ErrNotFound
-}
+	height, err := srv.getHeightByWordOrNumber(orLatest(req.BlockNumber))
+	if err != nil {
+		return nil, err
+	}
+	st, err := srv.states.AtHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("could not get state at height %d: %w", height, err)
+	}
 
-func (srv *EthService) EthGetFilterChanges(req *EthGetFilterChangesParams) (*EthGetFilterChangesResult, error) {
-	return nil, ErrNotFound
-}
+	acc, accountProof, err := st.GetAccountWithProof(address)
+	if err != nil {
+		return nil, err
+	}
+	result := ProofAccount{
+		Address:      web3hex.Encoder.Address(address),
+		Balance:      hexZero,
+		Nonce:        hexZero,
+		CodeHash:     hexKeccak(nil),
+		StorageProof: make([]StorageProof, len(storageKeys)),
+	}
+	if acc != nil {
+		result.Balance = hexQuantity(balance.NativeToWei(acc.Balance))
+		result.Nonce = web3hex.Encoder.Uint64(acc.Sequence)
+		result.CodeHash = hexKeccak(acc.EVMCode)
+	}
+	result.AccountProof, err = encodeProofNodes(accountProof)
+	if err != nil {
+		return nil, err
+	}
 
-func (srv *EthService) EthGetFilterLogs(req *EthGetFilterLogsParams) (*EthGetFilterLogsResult, error) {
-	return nil, ErrNotFound
+	for i, key := range storageKeys {
+		value, storageProof, err := st.GetStorageWithProof(address, key)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := encodeProofNodes(storageProof)
+		if err != nil {
+			return nil, err
+		}
+		result.StorageProof[i] = StorageProof{
+			Key:   web3hex.Encoder.BytesTrim(key.Bytes()),
+			Value: web3hex.Encoder.BytesTrim(binary.LeftPadWord256(value).Bytes()),
+			Proof: nodes,
+		}
+	}
+	// Every key of an account is proved against the same storage tree so we may take its hash from any proof
+	_, storageProof, err := st.GetStorageWithProof(address, binary.Zero256)
+	if err != nil {
+		return nil, err
+	}
+	storageHash, err := storageProof.TreeHash()
+	if err != nil {
+		return nil, err
+	}
+	result.StorageHash = web3hex.Encoder.Bytes(storageHash)
+
+	return &EthGetProofResult{
+		ProofAccountOrNull: result,
+	}, nil
 }
 
-func (srv *EthService) EthCoinbase() (*EthCoinbaseResult, error) {
+func (srv *EthService) EthGetWork() (*EthGetWorkResult, error) {
 	return nil, ErrNotFound
 }
 
-func (srv *EthService) EthGetLogs(req *EthGetLogsParams) (*EthGetLogsResult, error) {
+func (srv *EthService) EthCoinbase() (*EthCoinbaseResult, error) {
 	return nil, ErrNotFound
 }
@@ -2,6 +2,8 @@ package web3_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"strings"
@@ -9,16 +11,21 @@ import (
 	"time"
 
 	"github.com/hyperledger/burrow/acm/balance"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/encoding/web3hex"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/evm/abi"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
 	"github.com/hyperledger/burrow/integration"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/project"
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/rpc/web3"
+	"github.com/hyperledger/burrow/rpc/web3/ethclient"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/require"
@@ -63,8 +70,8 @@ func TestWeb3Service(t *testing.T) {
 	accountState := kern.State
 	eventsState := kern.State
 	validatorState := kern.State
-	eth := web3.NewEthService(accountState, eventsState, kern.Blockchain, validatorState,
-		nodeView, kern.Transactor, store, kern.Logger)
+	eth := web3.NewEthService(accountState, eventsState, kern.State, kern.Blockchain, validatorState,
+		nodeView, kern.Transactor, store, engine.Options{}, kern.Logger)
 
 	t.Run("Web3Sha3", func(t *testing.T) {
 		result, err := eth.Web3Sha3(&web3.Web3Sha3Params{"0x68656c6c6f20776f726c64"}) // hello world
@@ -242,6 +249,20 @@ func TestWeb3Service(t *testing.T) {
 			require.Equal(t, "Hello, World", vars[0].Value)
 		})
 
+		t.Run("EthEstimateGas", func(t *testing.T) {
+			transaction := web3.Transaction{
+				From: web3hex.Encoder.BytesTrim(genesisAccounts[3].GetAddress().Bytes()),
+				Data: web3hex.Encoder.BytesTrim(rpc.Bytecode_HelloWorld),
+			}
+			estimate, err := eth.EthEstimateGas(&web3.EthEstimateGasParams{Transaction: transaction})
+			require.NoError(t, err)
+			require.NotEqual(t, "0x0", estimate.GasUsed)
+
+			transaction.Gas = estimate.GasUsed
+			_, err = eth.EthSendTransaction(&web3.EthSendTransactionParams{Transaction: transaction})
+			require.NoError(t, err)
+		})
+
 		t.Run("EthGetCode", func(t *testing.T) {
 			require.NotEmpty(t, contractAddress, "need contract address get code")
 			result, err := eth.EthGetCode(&web3.EthGetCodeParams{
@@ -252,6 +273,181 @@ func TestWeb3Service(t *testing.T) {
 		})
 	})
 
+	t.Run("EthLogs", func(t *testing.T) {
+		eventID := binary.LeftPadWord256([]byte("Event"))
+		other := binary.LeftPadWord256([]byte("Other"))
+		// Constructor that emits a single LOG2 with data 0x2a and topics [eventID, other]
+		emitter := bc.MustSplice(PUSH1, 0x2a, PUSH1, 0, MSTORE,
+			PUSH32, other, PUSH32, eventID, PUSH1, 0x20, PUSH1, 0, LOG2, STOP)
+
+		filterResult, err := eth.EthNewFilter(&web3.EthNewFilterParams{
+			Filter: web3.Filter{Topics: []string{web3hex.Encoder.Bytes(eventID.Bytes())}},
+		})
+		require.NoError(t, err)
+
+		deploy := func() *web3.EthGetTransactionReceiptResult {
+			sendResult, err := eth.EthSendTransaction(&web3.EthSendTransactionParams{
+				Transaction: web3.Transaction{
+					From: web3hex.Encoder.BytesTrim(genesisAccounts[3].GetAddress().Bytes()),
+					Gas:  web3hex.Encoder.Uint64(100),
+					Data: web3hex.Encoder.BytesTrim(emitter),
+				},
+			})
+			require.NoError(t, err)
+			receipt, err := eth.EthGetTransactionReceipt(&web3.EthGetTransactionReceiptParams{
+				TransactionHash: sendResult.TransactionHash,
+			})
+			require.NoError(t, err)
+			return receipt
+		}
+		first := deploy()
+		second := deploy()
+
+		require.Len(t, first.Receipt.Logs, 1)
+		log := first.Receipt.Logs[0]
+		require.Equal(t, first.Receipt.ContractAddress, log.Address)
+		require.Equal(t, first.Receipt.BlockHash, log.BlockHash)
+		require.Equal(t, first.Receipt.TransactionIndex, log.TransactionIndex)
+		require.Equal(t, first.Receipt.TransactionHash, log.TransactionHash)
+		require.Equal(t, []web3.Topics{{DataWord: web3hex.Encoder.Bytes(eventID.Bytes())},
+			{DataWord: web3hex.Encoder.Bytes(other.Bytes())}}, log.Topics)
+		require.Equal(t, web3hex.Encoder.Bytes(binary.Int64ToWord256(0x2a).Bytes()), log.Data)
+
+		block, err := eth.EthGetBlockByNumber(&web3.EthGetBlockByNumberParams{BlockNumber: log.BlockNumber})
+		require.NoError(t, err)
+		require.Equal(t, block.GetBlockByNumberResult.Hash, log.BlockHash)
+
+		t.Run("EthGetLogs", func(t *testing.T) {
+			result, err := eth.GetLogs(&web3.LogFilter{
+				FromBlock: first.Receipt.BlockNumber,
+				Address:   web3.FilterAddresses{first.Receipt.ContractAddress, second.Receipt.ContractAddress},
+				// Any first topic, either of these for the second
+				Topics: []web3.FilterTopic{nil, {web3hex.Encoder.Bytes(eventID.Bytes()),
+					web3hex.Encoder.BytesTrim(other.Bytes())}},
+			})
+			require.NoError(t, err)
+			require.Equal(t, []web3.Logs{first.Receipt.Logs[0], second.Receipt.Logs[0]}, result.Logs)
+
+			result, err = eth.EthGetLogs(&web3.EthGetLogsParams{
+				Filter: web3.Filter{
+					FromBlock: first.Receipt.BlockNumber,
+					Address:   second.Receipt.ContractAddress,
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, []web3.Logs{second.Receipt.Logs[0]}, result.Logs)
+
+			result, err = eth.GetLogs(&web3.LogFilter{
+				BlockHash: first.Receipt.BlockHash,
+				Topics:    []web3.FilterTopic{{web3hex.Encoder.Bytes(other.Bytes())}},
+			})
+			require.NoError(t, err)
+			require.Empty(t, result.Logs)
+
+			// Lists of addresses and topics are decoded by the server
+			response := web3.NewEthServer(eth).Do(web3.RPCRequest{
+				JSONRPC: web3.JSONRPC,
+				ID:      1,
+				Method:  "eth_getLogs",
+				Params: json.RawMessage(fmt.Sprintf(`[{"fromBlock": "%s", "address": ["%s", "%s"], "topics": [null, ["%s"]]}]`,
+					first.Receipt.BlockNumber, first.Receipt.ContractAddress, second.Receipt.ContractAddress,
+					web3hex.Encoder.Bytes(other.Bytes()))),
+			})
+			require.IsType(t, web3.RPCResultResponse{}, response)
+			bs, err := json.Marshal(response.(web3.RPCResultResponse).Result)
+			require.NoError(t, err)
+			expected, err := json.Marshal([]web3.Logs{first.Receipt.Logs[0], second.Receipt.Logs[0]})
+			require.NoError(t, err)
+			require.JSONEq(t, string(expected), string(bs))
+		})
+
+		t.Run("EthGetFilterChanges", func(t *testing.T) {
+			changes, err := eth.EthGetFilterChanges(&web3.EthGetFilterChangesParams{FilterId: filterResult.FilterId})
+			require.NoError(t, err)
+			require.Equal(t, logResults(first.Receipt.Logs[0], second.Receipt.Logs[0]), changes.LogResult)
+
+			changes, err = eth.EthGetFilterChanges(&web3.EthGetFilterChangesParams{FilterId: filterResult.FilterId})
+			require.NoError(t, err)
+			require.Empty(t, changes.LogResult)
+
+			third := deploy()
+			changes, err = eth.EthGetFilterChanges(&web3.EthGetFilterChangesParams{FilterId: filterResult.FilterId})
+			require.NoError(t, err)
+			require.Equal(t, logResults(third.Receipt.Logs...), changes.LogResult)
+
+			uninstalled, err := eth.EthUninstallFilter(&web3.EthUninstallFilterParams{FilterId: filterResult.FilterId})
+			require.NoError(t, err)
+			require.True(t, uninstalled.FilterUninstalledSuccess)
+
+			_, err = eth.EthGetFilterLogs(&web3.EthGetFilterLogsParams{FilterId: filterResult.FilterId})
+			require.Error(t, err)
+		})
+	})
+
+	t.Run("EthGetProof", func(t *testing.T) {
+		// Constructor that stores 0x2a at key 1
+		storer := bc.MustSplice(PUSH1, 0x2a, PUSH1, 1, SSTORE, STOP)
+		sendResult, err := eth.EthSendTransaction(&web3.EthSendTransactionParams{
+			Transaction: web3.Transaction{
+				From: web3hex.Encoder.BytesTrim(genesisAccounts[3].GetAddress().Bytes()),
+				Gas:  web3hex.Encoder.Uint64(100),
+				Data: web3hex.Encoder.BytesTrim(storer),
+			},
+		})
+		require.NoError(t, err)
+		receipt, err := eth.EthGetTransactionReceipt(&web3.EthGetTransactionReceiptParams{
+			TransactionHash: sendResult.TransactionHash,
+		})
+		require.NoError(t, err)
+
+		result, err := eth.EthGetProof(&web3.EthGetProofParams{
+			Address:     receipt.Receipt.ContractAddress,
+			StorageKeys: []string{"0x1", "0x2"},
+			BlockNumber: receipt.Receipt.BlockNumber,
+		})
+		require.NoError(t, err)
+		proof := result.ProofAccountOrNull
+		require.Equal(t, "0x2a", proof.StorageProof[0].Value)
+		require.Equal(t, "0x0", proof.StorageProof[1].Value)
+
+		// The state after a block is committed in the AppHash of the next
+		var next *web3.EthGetBlockByNumberResult
+		require.Eventually(t, func() bool {
+			next, err = eth.EthGetBlockByNumber(&web3.EthGetBlockByNumberParams{
+				BlockNumber: web3hex.Encoder.Uint64(d.Uint64(receipt.Receipt.BlockNumber) + 1),
+			})
+			return err == nil
+		}, 10*time.Second, 100*time.Millisecond)
+		appHash := d.Bytes(next.GetBlockByNumberResult.StateRoot)
+		require.NoError(t, ethclient.VerifyProof(appHash, &proof))
+
+		proof.StorageProof[0].Value = "0x2b"
+		require.Error(t, ethclient.VerifyProof(appHash, &proof))
+
+		result, err = eth.EthGetProof(&web3.EthGetProofParams{
+			Address:     web3hex.Encoder.Address(crypto.Address{1, 2, 3}),
+			BlockNumber: receipt.Receipt.BlockNumber,
+		})
+		require.NoError(t, err)
+		require.NoError(t, ethclient.VerifyProof(appHash, &result.ProofAccountOrNull))
+
+		storageAt, err := eth.EthGetStorageAt(&web3.EthGetStorageAtParams{
+			Address:     receipt.Receipt.ContractAddress,
+			Position:    "0x1",
+			BlockNumber: receipt.Receipt.BlockNumber,
+		})
+		require.NoError(t, err)
+		require.Equal(t, web3hex.Encoder.Bytes(binary.LeftPadWord256([]byte{0x2a}).Bytes()), storageAt.DataWord)
+
+		storageAt, err = eth.EthGetStorageAt(&web3.EthGetStorageAtParams{
+			Address:     receipt.Receipt.ContractAddress,
+			Position:    "0x1",
+			BlockNumber: web3hex.Encoder.Uint64(d.Uint64(receipt.Receipt.BlockNumber) - 1),
+		})
+		require.NoError(t, err)
+		require.Equal(t, web3hex.Encoder.Bytes(binary.Zero256.Bytes()), storageAt.DataWord)
+	})
+
 	t.Run("EthMining", func(t *testing.T) {
 		result, err := eth.EthMining()
 		require.NoError(t, err)
@@ -287,3 +483,20 @@ func TestWeb3Service(t *testing.T) {
 	})
 
 }
+
+func logResults(logs ...web3.Logs) []web3.LogResult {
+	results := make([]web3.LogResult, len(logs))
+	for i, log := range logs {
+		results[i] = web3.LogResult{
+			LogIndex:         log.LogIndex,
+			TransactionIndex: log.TransactionIndex,
+			TransactionHash:  log.TransactionHash,
+			Address:          log.Address,
+			BlockHash:        log.BlockHash,
+			BlockNumber:      log.BlockNumber,
+			Data:             log.Data,
+			Topics:           log.Topics,
+		}
+	}
+	return results
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/encoding/web3hex"
 	"github.com/hyperledger/burrow/rpc"
@@ -23,6 +24,8 @@ const (
 	EthGetBlockByNumberMethod      = "eth_getBlockByNumber"
 	EthGetTransactionByHashMethod  = "eth_getTransactionByHash"
 	EthGetTransactionReceiptMethod = "eth_getTransactionReceipt"
+	EthGetProofMethod              = "eth_getProof"
+	EthGetStorageAtMethod          = "eth_getStorageAt"
 	EthGasPriceMethod              = "eth_gasPrice"
 	NetVersionMethod               = "net_version"
 	Web3ClientVersionMethod        = "web3_clientVersion"
@@ -111,6 +114,32 @@ func (c *EthClient) GetTransactionReceipt(txHash string) (*Receipt, error) {
 	return tx, nil
 }
 
+// Get proofs of the account at address and its storage at storageKeys which may be checked with VerifyProof
+func (c *EthClient) GetProof(address crypto.Address, storageKeys []binary.Word256, height string) (*web3.ProofAccount, error) {
+	keys := make([]string, len(storageKeys))
+	for i, k := range storageKeys {
+		keys[i] = web3hex.Encoder.BytesTrim(k.Bytes())
+	}
+	proof := new(web3.ProofAccount)
+	err := c.Call(EthGetProofMethod, []interface{}{web3hex.Encoder.Address(address), keys, height}, proof)
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// Get the word stored at key in the storage of the contract at address
+func (c *EthClient) GetStorageAt(address crypto.Address, key binary.Word256, height string) ([]byte, error) {
+	word := new(string)
+	err := c.Call(EthGetStorageAtMethod, []string{web3hex.Encoder.Address(address),
+		web3hex.Encoder.BytesTrim(key.Bytes()), height}, word)
+	if err != nil {
+		return nil, err
+	}
+	d := new(web3hex.Decoder)
+	return d.Bytes(*word), d.Err()
+}
+
 func (c *EthClient) Syncing() (bool, error) {
 	syncing := new(bool)
 	err := c.Call(EthSyncingMethod, nil, syncing)
@@ -0,0 +1,78 @@
+package ethclient
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/balance"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding/web3hex"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/rpc/web3"
+)
+
+// VerifyProof checks an eth_getProof result against appHash without trusting the node that produced it. Burrow proves
+// the state after a block so appHash should be the AppHash of the following block - which is reported as its stateRoot.
+// The storage hash is only established by the storage proofs so is not checked when there are none.
+func VerifyProof(appHash []byte, proof *web3.ProofAccount) error {
+	d := new(web3hex.Decoder)
+	address := d.Address(proof.Address)
+	storageHash := d.Bytes(proof.StorageHash)
+	if d.Err() != nil {
+		return d.Err()
+	}
+
+	accountProof, err := web3.DecodeProofNodes(proof.AccountProof)
+	if err != nil {
+		return fmt.Errorf("could not decode account proof: %w", err)
+	}
+	acc, err := state.VerifyAccountProof(appHash, address, accountProof)
+	if err != nil {
+		return err
+	}
+	var bal, nonce uint64
+	var code []byte
+	if acc != nil {
+		bal = acc.Balance
+		nonce = acc.Sequence
+		code = acc.EVMCode
+	}
+	if d.BigInt(proof.Balance).Cmp(balance.NativeToWei(bal)) != 0 {
+		return fmt.Errorf("balance %s does not match proven balance %d", proof.Balance, bal)
+	}
+	if d.Uint64(proof.Nonce) != nonce {
+		return fmt.Errorf("nonce %s does not match proven sequence %d", proof.Nonce, nonce)
+	}
+	if !bytes.Equal(d.Bytes(proof.CodeHash), crypto.Keccak256(code)) {
+		return fmt.Errorf("code hash %s does not match hash of proven code", proof.CodeHash)
+	}
+
+	for _, sp := range proof.StorageProof {
+		key := binary.LeftPadWord256(d.Bytes(sp.Key))
+		value := binary.LeftPadWord256(d.Bytes(sp.Value))
+		if d.Err() != nil {
+			return d.Err()
+		}
+		storageProof, err := web3.DecodeProofNodes(sp.Proof)
+		if err != nil {
+			return fmt.Errorf("could not decode storage proof for key %s: %w", sp.Key, err)
+		}
+		treeHash, err := storageProof.TreeHash()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(treeHash, storageHash) {
+			return fmt.Errorf("storage proof for key %s is not against storage hash %s", sp.Key, proof.StorageHash)
+		}
+		provenValue, err := state.VerifyStorageProof(appHash, address, key, storageProof)
+		if err != nil {
+			return err
+		}
+		if binary.LeftPadWord256(provenValue) != value {
+			return fmt.Errorf("value %s of storage key %s does not match proven value %X", sp.Value, sp.Key,
+				provenValue)
+		}
+	}
+	return d.Err()
+}
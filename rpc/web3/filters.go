@@ -0,0 +1,264 @@
+package web3
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding/web3hex"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/tmthrgd/go-hex"
+)
+
+const (
+	// DefaultFilterTimeout is how long a filter may go without being polled before it is uninstalled
+	DefaultFilterTimeout = 5 * time.Minute
+	// Ethereum logs carry at most four topics (LOG0 - LOG4)
+	maxLogTopics = 4
+)
+
+// LogFilter selects logs by block range, address and topics for eth_getLogs and eth_newFilter. It stands in for the
+// generated Filter, which cannot represent the lists of addresses and topic alternatives that clients send.
+type LogFilter struct {
+	// The hex representation of the block's height
+	FromBlock string `json:"fromBlock"`
+	// The hex representation of the block's height
+	ToBlock string `json:"toBlock"`
+	// Contract address or a list of addresses from which logs should originate
+	Address FilterAddresses `json:"address"`
+	// Array of 32 Bytes DATA topics. Topics are order-dependent. Each topic can also be an array of DATA with 'or' options
+	Topics []FilterTopic `json:"topics"`
+	// Restricts the logs returned to the single block with this hash, cannot be used with fromBlock or toBlock
+	BlockHash string `json:"blockHash"`
+}
+
+// LogFilterParams are the parameters of eth_getLogs and eth_newFilter as decoded by EthServer
+type LogFilterParams struct {
+	LogFilter
+}
+
+// Converts a generated Filter, which holds at most one address and one value for each topic (empty for any topic)
+func NewLogFilter(filter *Filter) *LogFilter {
+	logFilter := &LogFilter{
+		FromBlock: filter.FromBlock,
+		ToBlock:   filter.ToBlock,
+		Topics:    make([]FilterTopic, len(filter.Topics)),
+	}
+	if filter.Address != "" {
+		logFilter.Address = FilterAddresses{filter.Address}
+	}
+	for i, topic := range filter.Topics {
+		if topic != "" {
+			logFilter.Topics[i] = FilterTopic{topic}
+		}
+	}
+	return logFilter
+}
+
+// Topics are encoded as plain data words rather than the object the generated type would produce
+func (t Topics) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.DataWord)
+}
+
+func (t *Topics) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &t.DataWord)
+}
+
+// Logs report whether they were removed by a chain reorganisation, which never happens since blocks are final
+func (l Logs) MarshalJSON() ([]byte, error) {
+	type logs Logs
+	return json.Marshal(struct {
+		logs
+		Removed bool `json:"removed"`
+	}{logs: logs(l)})
+}
+
+func (l LogResult) MarshalJSON() ([]byte, error) {
+	type logResult LogResult
+	return json.Marshal(struct {
+		logResult
+		Removed bool `json:"removed"`
+	}{logResult: logResult(l)})
+}
+
+// FilterAddresses is the address criterion of a log filter, which may be given as either a single address or an array
+type FilterAddresses []string
+
+func (fa *FilterAddresses) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalStringOrArray(data)
+	if err != nil {
+		return fmt.Errorf("could not decode filter address: %w", err)
+	}
+	*fa = values
+	return nil
+}
+
+// FilterTopic is the set of acceptable values for a single topic position, an empty set matches any topic
+type FilterTopic []string
+
+func (ft *FilterTopic) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalStringOrArray(data)
+	if err != nil {
+		return fmt.Errorf("could not decode filter topic: %w", err)
+	}
+	*ft = values
+	return nil
+}
+
+func unmarshalStringOrArray(data []byte) ([]string, error) {
+	var value interface{}
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected string but got %v", e)
+			}
+			values[i] = s
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("expected string or array of strings but got %v", v)
+	}
+}
+
+// LogQuery compiles the address and topic criteria of an Ethereum log filter into an event query over LogEvents
+func LogQuery(filter *LogFilter) (query.Query, error) {
+	d := new(web3hex.Decoder)
+	qb := query.NewBuilder().AndEquals(event.EventTypeKey, exec.TypeLog)
+
+	addresses := make([]string, len(filter.Address))
+	for i, a := range filter.Address {
+		// Clients may trim leading zeros as for any other quantity
+		bs := d.Bytes(a)
+		if len(bs) > crypto.AddressLength {
+			return nil, fmt.Errorf("filter address %s is longer than %d bytes", a, crypto.AddressLength)
+		}
+		addresses[i] = crypto.AddressFromWord256(binary.LeftPadWord256(bs)).String()
+	}
+	qb = qb.And(anyOf(event.AddressKey, addresses))
+
+	if len(filter.Topics) > maxLogTopics {
+		return nil, fmt.Errorf("filter specifies %d topics but logs have at most %d", len(filter.Topics), maxLogTopics)
+	}
+	for i, topic := range filter.Topics {
+		words := make([]string, len(topic))
+		for j, t := range topic {
+			words[j] = hex.EncodeUpperToString(binary.LeftPadWord256(d.Bytes(t)).Bytes())
+		}
+		qb = qb.And(anyOf(exec.LogNKey(i), words))
+	}
+
+	if d.Err() != nil {
+		return nil, d.Err()
+	}
+	return qb.Query()
+}
+
+// Matches tag against any of values, grouped so it can be conjoined with other conditions. No values matches anything.
+func anyOf(tag string, values []string) *query.Builder {
+	if len(values) == 0 {
+		return query.NewBuilder()
+	}
+	alternatives := make([]*query.Builder, len(values))
+	for i, v := range values {
+		alternatives[i] = query.NewBuilder().AndEquals(tag, v)
+	}
+	return query.NewBuilder("(" + query.NewBuilder().Or(alternatives...).String() + ")")
+}
+
+type logFilter struct {
+	// Serialises polling of the cursor
+	sync.Mutex
+	*LogFilter
+	query query.Query
+	// The next height from which eth_getFilterChanges should return logs
+	cursor uint64
+	// Guarded by the registry lock
+	lastPolled time.Time
+}
+
+// filterRegistry holds installed server-side filters, those that are not polled within the timeout are dropped
+type filterRegistry struct {
+	sync.Mutex
+	filters map[string]*logFilter
+	timeout time.Duration
+	now     func() time.Time
+}
+
+func newFilterRegistry(timeout time.Duration) *filterRegistry {
+	return &filterRegistry{
+		filters: make(map[string]*logFilter),
+		timeout: timeout,
+		now:     time.Now,
+	}
+}
+
+func (fr *filterRegistry) install(filter *logFilter) (string, error) {
+	bs := make([]byte, 16)
+	_, err := rand.Read(bs)
+	if err != nil {
+		return "", fmt.Errorf("could not generate filter ID: %w", err)
+	}
+	id := web3hex.Encoder.Bytes(bs)
+
+	fr.Lock()
+	defer fr.Unlock()
+	fr.expire()
+	filter.lastPolled = fr.now()
+	fr.filters[id] = filter
+	return id, nil
+}
+
+// Returns the filter with id, refreshing its timeout
+func (fr *filterRegistry) poll(id string) (*logFilter, error) {
+	fr.Lock()
+	defer fr.Unlock()
+	fr.expire()
+	filter, ok := fr.filters[normaliseFilterID(id)]
+	if !ok {
+		return nil, fmt.Errorf("filter %s not found", id)
+	}
+	filter.lastPolled = fr.now()
+	return filter, nil
+}
+
+func (fr *filterRegistry) uninstall(id string) bool {
+	fr.Lock()
+	defer fr.Unlock()
+	fr.expire()
+	id = normaliseFilterID(id)
+	_, ok := fr.filters[id]
+	delete(fr.filters, id)
+	return ok
+}
+
+// Must be called with lock held
+func (fr *filterRegistry) expire() {
+	now := fr.now()
+	for id, filter := range fr.filters {
+		if now.Sub(filter.lastPolled) > fr.timeout {
+			delete(fr.filters, id)
+		}
+	}
+}
+
+func normaliseFilterID(id string) string {
+	return strings.ToLower(id)
+}
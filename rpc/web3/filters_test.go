@@ -0,0 +1,121 @@
+package web3
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogFilter_UnmarshalJSON(t *testing.T) {
+	filter := new(LogFilter)
+	err := json.Unmarshal([]byte(`{"address": "0x01", "topics": [null, "0x02", ["0x03", "0x04"]]}`), filter)
+	require.NoError(t, err)
+	assert.Equal(t, FilterAddresses{"0x01"}, filter.Address)
+	assert.Equal(t, []FilterTopic{nil, {"0x02"}, {"0x03", "0x04"}}, filter.Topics)
+
+	err = json.Unmarshal([]byte(`{"address": ["0x01", "0x02"]}`), filter)
+	require.NoError(t, err)
+	assert.Equal(t, FilterAddresses{"0x01", "0x02"}, filter.Address)
+
+	err = json.Unmarshal([]byte(`{"address": 1}`), filter)
+	require.Error(t, err)
+}
+
+func TestLogQuery(t *testing.T) {
+	address := crypto.Address{1, 2, 3}
+	topic := binary.LeftPadWord256([]byte{0xab})
+	ev := &exec.Event{
+		Header: &exec.Header{EventType: exec.TypeLog},
+		Log: &exec.LogEvent{
+			Address: address,
+			Topics:  []binary.Word256{topic, binary.One256},
+		},
+	}
+
+	matches := func(filter *LogFilter) bool {
+		qry, err := LogQuery(filter)
+		require.NoError(t, err)
+		return qry.Matches(ev)
+	}
+
+	assert.True(t, matches(&LogFilter{}))
+	assert.True(t, matches(&LogFilter{Address: FilterAddresses{"0x00", address.String()}}))
+	assert.False(t, matches(&LogFilter{Address: FilterAddresses{"0x00"}}))
+	assert.True(t, matches(&LogFilter{Topics: []FilterTopic{{"0xab"}}}))
+	assert.True(t, matches(&LogFilter{Topics: []FilterTopic{nil, {"0x00", "0x01"}}}))
+	assert.False(t, matches(&LogFilter{Topics: []FilterTopic{nil, {"0x00"}}}))
+	assert.False(t, matches(&LogFilter{Address: FilterAddresses{address.String()}, Topics: []FilterTopic{{"0xac"}}}))
+
+	_, err := LogQuery(&LogFilter{Topics: make([]FilterTopic, 5)})
+	require.Error(t, err)
+}
+
+func TestNewLogFilter(t *testing.T) {
+	assert.Equal(t, &LogFilter{FromBlock: "0x1", Topics: []FilterTopic{}}, NewLogFilter(&Filter{FromBlock: "0x1"}))
+	assert.Equal(t, &LogFilter{Address: FilterAddresses{"0x01"}, Topics: []FilterTopic{nil, {"0x02"}}},
+		NewLogFilter(&Filter{Address: "0x01", Topics: []string{"", "0x02"}}))
+}
+
+func TestLogs_MarshalJSON(t *testing.T) {
+	log := Logs{
+		LogIndex:         "0x0",
+		TransactionIndex: "0x1",
+		TransactionHash:  "0x02",
+		Address:          "0x03",
+		BlockHash:        "0x04",
+		BlockNumber:      "0x5",
+		Data:             "0x06",
+		Topics:           []Topics{{DataWord: "0x07"}},
+	}
+	bs, err := json.Marshal(log)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"logIndex": "0x0", "transactionIndex": "0x1", "transactionHash": "0x02", "address": "0x03",
+		"blockHash": "0x04", "blockNumber": "0x5", "data": "0x06", "topics": ["0x07"], "removed": false}`, string(bs))
+
+	result, err := json.Marshal(LogResult{
+		LogIndex:         log.LogIndex,
+		TransactionIndex: log.TransactionIndex,
+		TransactionHash:  log.TransactionHash,
+		Address:          log.Address,
+		BlockHash:        log.BlockHash,
+		BlockNumber:      log.BlockNumber,
+		Data:             log.Data,
+		Topics:           log.Topics,
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, string(bs), string(result))
+}
+
+func TestFilterRegistry(t *testing.T) {
+	now := time.Now()
+	registry := newFilterRegistry(time.Minute)
+	registry.now = func() time.Time { return now }
+
+	id, err := registry.install(&logFilter{})
+	require.NoError(t, err)
+
+	now = now.Add(50 * time.Second)
+	_, err = registry.poll(id)
+	require.NoError(t, err)
+
+	// Polling extends the lifetime of the filter
+	now = now.Add(50 * time.Second)
+	_, err = registry.poll(id)
+	require.NoError(t, err)
+
+	now = now.Add(2 * time.Minute)
+	_, err = registry.poll(id)
+	require.Error(t, err)
+	assert.False(t, registry.uninstall(id))
+
+	id, err = registry.install(&logFilter{})
+	require.NoError(t, err)
+	assert.True(t, registry.uninstall(id))
+	assert.False(t, registry.uninstall(id))
+}
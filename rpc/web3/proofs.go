@@ -0,0 +1,73 @@
+package web3
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/encoding/web3hex"
+	"github.com/hyperledger/burrow/storage"
+)
+
+// Burrow state is an IAVL forest rather than a patricia trie so the proof nodes of eth_getProof are, in order from the
+// AppHash down: the proof of the account or storage tree's CommitID in the commits tree, that CommitID, the proof of
+// the key in the tree the CommitID identifies, and the value stored at the key (empty when absent).
+const proofNodesLength = 4
+
+func encodeProofNodes(proof *storage.ForestProof) ([]string, error) {
+	commitProof, err := storage.MarshalRangeProof(proof.CommitProof)
+	if err != nil {
+		return nil, err
+	}
+	treeProof, err := storage.MarshalRangeProof(proof.TreeProof)
+	if err != nil {
+		return nil, err
+	}
+	return []string{
+		web3hex.Encoder.Bytes(commitProof),
+		web3hex.Encoder.Bytes(proof.CommitID),
+		web3hex.Encoder.Bytes(treeProof),
+		web3hex.Encoder.Bytes(proof.Value),
+	}, nil
+}
+
+// DecodeProofNodes reads the proof nodes of an eth_getProof account or storage proof into a ForestProof, the prefix
+// and key of which should be set from the account address and storage key before verification
+func DecodeProofNodes(nodes []string) (*storage.ForestProof, error) {
+	if len(nodes) != proofNodesLength {
+		return nil, fmt.Errorf("expected %d proof nodes but got %d", proofNodesLength, len(nodes))
+	}
+	d := new(web3hex.Decoder)
+	commitProof := d.Bytes(nodes[0])
+	commitID := d.Bytes(nodes[1])
+	treeProof := d.Bytes(nodes[2])
+	value := d.Bytes(nodes[3])
+	if d.Err() != nil {
+		return nil, d.Err()
+	}
+	proof := new(storage.ForestProof)
+	var err error
+	proof.CommitProof, err = storage.UnmarshalRangeProof(commitProof)
+	if err != nil {
+		return nil, err
+	}
+	proof.TreeProof, err = storage.UnmarshalRangeProof(treeProof)
+	if err != nil {
+		return nil, err
+	}
+	// Absence is represented by nil
+	if len(commitID) > 0 {
+		proof.CommitID = commitID
+	}
+	if len(value) > 0 {
+		proof.Value = value
+	}
+	return proof, nil
+}
+
+// Encode a quantity (unlike web3hex.Encoder.BigInt zero is encoded as 0x0)
+func hexQuantity(x *big.Int) string {
+	if x.Sign() == 0 {
+		return hexZero
+	}
+	return web3hex.Encoder.BigInt(x)
+}
@@ -19,6 +19,8 @@ const (
 // Access the read path of a forest
 type ForestReader interface {
 	Reader(prefix []byte) (KVCallbackIterableReader, error)
+	// Get the value at key of the tree at prefix along with a merkle proof against the forest hash
+	GetWithProof(prefix, key []byte) (*ForestProof, error)
 }
 
 // MutableForest is a collection of versioned lazily-loaded RWTrees organised by prefix. It maintains a global state hash
@@ -81,7 +83,7 @@ type ImmutableForest struct {
 	// Synchronises tree loading
 	sync.Mutex
 	// Store of tree prefix -> last commitID (version + hash) - serves as a set of all known trees and provides a global hash
-	commitsTree KVCallbackIterableReader
+	commitsTree KVCallbackIterableProver
 	treeDB      dbm.DB
 	// Cache for frequently used trees
 	treeCache *lru.Cache
@@ -119,7 +121,7 @@ func NewMutableForest(db dbm.DB, cacheSize int) (*MutableForest, error) {
 	}, nil
 }
 
-func NewImmutableForest(commitsTree KVCallbackIterableReader, treeDB dbm.DB, cacheSize int,
+func NewImmutableForest(commitsTree KVCallbackIterableProver, treeDB dbm.DB, cacheSize int,
 	options ...ForestOption) (*ImmutableForest, error) {
 	cache, err := lru.New(cacheSize)
 	if err != nil {
@@ -166,7 +168,7 @@ func (muf *MutableForest) Save() (hash []byte, version int64, _ error) {
 func (muf *MutableForest) GetImmutable(version int64) (*ImmutableForest, error) {
 	commitsTree, err := muf.commitsTree.GetImmutable(version)
 	if err != nil {
-		return nil, fmt.Errorf("MutableForest.GetImmutable() could not get commits tree for version %d: %v",
+		return nil, fmt.Errorf("MutableForest.GetImmutable() could not get commits tree for version %d: %w",
 			version, err)
 	}
 	return NewImmutableForest(commitsTree, muf.treeDB, muf.cacheSize)
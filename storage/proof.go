@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/cosmos/iavl"
+	iavlproto "github.com/cosmos/iavl/proto"
+)
+
+// ForestProof is a merkle proof of the value of a key (or its absence) in one of the trees of a forest. Since a forest
+// is a two-layer tree the proof is in two parts: CommitProof proves the CommitID of the tree at Prefix against the
+// forest's root hash (the commits tree hash) and TreeProof proves Key against the hash recorded in that CommitID.
+type ForestProof struct {
+	Prefix []byte
+	Key    []byte
+	// The value stored at Key or nil if absent
+	Value []byte
+	// The marshalled CommitID of the tree at Prefix or nil if there is no such tree
+	CommitID    []byte
+	CommitProof *iavl.RangeProof
+	// Nil if the tree is absent or empty
+	TreeProof *iavl.RangeProof
+}
+
+// Verify checks the proof against root - the hash of a forest (e.g. a Burrow AppHash)
+func (fp *ForestProof) Verify(root []byte) error {
+	err := verifyTreeProof(root, fp.CommitProof, fp.Prefix, fp.CommitID)
+	if err != nil {
+		return fmt.Errorf("could not verify commit of tree %X: %w", fp.Prefix, err)
+	}
+	treeHash, err := fp.TreeHash()
+	if err != nil {
+		return err
+	}
+	err = verifyTreeProof(treeHash, fp.TreeProof, fp.Key, fp.Value)
+	if err != nil {
+		return fmt.Errorf("could not verify key %X in tree %X: %w", fp.Key, fp.Prefix, err)
+	}
+	return nil
+}
+
+// TreeHash returns the root hash of the tree at Prefix according to the proof's CommitID, or nil if the tree is absent
+func (fp *ForestProof) TreeHash() ([]byte, error) {
+	if fp.CommitID == nil {
+		return nil, nil
+	}
+	commitID, err := unmarshalCommitID(fp.CommitID)
+	if err != nil {
+		return nil, err
+	}
+	return commitID.Hash, nil
+}
+
+// Verify that key has value (or is absent when value is nil) in a tree with root hash
+func verifyTreeProof(root []byte, proof *iavl.RangeProof, key, value []byte) error {
+	if proof == nil {
+		// We can only get a nil proof from an empty tree
+		if len(root) != 0 {
+			return fmt.Errorf("proof is missing for non-empty tree with root %X", root)
+		}
+		if value != nil {
+			return fmt.Errorf("value %X cannot be in empty tree", value)
+		}
+		return nil
+	}
+	err := proof.Verify(root)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		return proof.VerifyAbsence(key)
+	}
+	return proof.VerifyItem(key, value)
+}
+
+// GetWithProof returns the value (nil if absent) at key in the tree at prefix along with a ForestProof
+func (imf *ImmutableForest) GetWithProof(prefix, key []byte) (*ForestProof, error) {
+	commitID, commitProof, err := imf.commitsTree.GetWithProof(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("ImmutableForest.GetWithProof() could not get proof for tree %X: %v", prefix, err)
+	}
+	fp := &ForestProof{
+		Prefix:      prefix,
+		Key:         key,
+		CommitID:    commitID,
+		CommitProof: commitProof,
+	}
+	if commitID == nil {
+		return fp, nil
+	}
+	tree, err := imf.loadOrCreateTree(prefix)
+	if err != nil {
+		return nil, err
+	}
+	fp.Value, fp.TreeProof, err = tree.GetWithProof(key)
+	if err != nil {
+		return nil, fmt.Errorf("ImmutableForest.GetWithProof() could not get proof for key %X in tree %X: %v",
+			key, prefix, err)
+	}
+	return fp, nil
+}
+
+// MarshalRangeProof serialises an IAVL proof using its protobuf representation
+func MarshalRangeProof(proof *iavl.RangeProof) ([]byte, error) {
+	if proof == nil {
+		return []byte{}, nil
+	}
+	return proof.ToProto().Marshal()
+}
+
+// UnmarshalRangeProof is the inverse of MarshalRangeProof, an empty encoding decodes to a nil proof
+func UnmarshalRangeProof(bs []byte) (*iavl.RangeProof, error) {
+	if len(bs) == 0 {
+		return nil, nil
+	}
+	pb := new(iavlproto.RangeProof)
+	err := pb.Unmarshal(bs)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal RangeProof: %w", err)
+	}
+	proof, err := iavl.RangeProofFromProto(pb)
+	if err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
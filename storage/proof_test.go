@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestForestProof(t *testing.T) {
+	forest, err := NewMutableForest(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	prefix := []byte("fooos")
+	key := []byte("bar")
+	err = forest.Write(prefix, func(tree *RWTree) error {
+		tree.Set(key, []byte("nog"))
+		tree.Set([]byte("baz"), []byte("zab"))
+		return nil
+	})
+	require.NoError(t, err)
+	hash1, version1, err := forest.Save()
+	require.NoError(t, err)
+
+	err = forest.Write(prefix, func(tree *RWTree) error {
+		tree.Set(key, []byte("flip"))
+		return nil
+	})
+	require.NoError(t, err)
+	hash2, _, err := forest.Save()
+	require.NoError(t, err)
+
+	t.Run("Present", func(t *testing.T) {
+		fp, err := forest.GetWithProof(prefix, key)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("flip"), fp.Value)
+		require.NoError(t, fp.Verify(hash2))
+		require.Error(t, fp.Verify(hash1))
+
+		fp.Value = []byte("nog")
+		require.Error(t, fp.Verify(hash2))
+	})
+
+	t.Run("Historical", func(t *testing.T) {
+		imf, err := forest.GetImmutable(version1)
+		require.NoError(t, err)
+		fp, err := imf.GetWithProof(prefix, key)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("nog"), fp.Value)
+		require.NoError(t, fp.Verify(hash1))
+	})
+
+	t.Run("AbsentKey", func(t *testing.T) {
+		fp, err := forest.GetWithProof(prefix, []byte("zzz"))
+		require.NoError(t, err)
+		assert.Nil(t, fp.Value)
+		require.NoError(t, fp.Verify(hash2))
+
+		fp.Value = []byte("nog")
+		require.Error(t, fp.Verify(hash2))
+	})
+
+	t.Run("AbsentTree", func(t *testing.T) {
+		fp, err := forest.GetWithProof([]byte("nope"), key)
+		require.NoError(t, err)
+		assert.Nil(t, fp.Value)
+		assert.Nil(t, fp.CommitID)
+		assert.Nil(t, fp.TreeProof)
+		require.NoError(t, fp.Verify(hash2))
+
+		// Cannot claim the tree is absent when it is present
+		fp.Prefix = prefix
+		require.Error(t, fp.Verify(hash2))
+	})
+
+	t.Run("Marshal", func(t *testing.T) {
+		fp, err := forest.GetWithProof(prefix, key)
+		require.NoError(t, err)
+		bs, err := MarshalRangeProof(fp.TreeProof)
+		require.NoError(t, err)
+		fp.TreeProof, err = UnmarshalRangeProof(bs)
+		require.NoError(t, err)
+		bs, err = MarshalRangeProof(fp.CommitProof)
+		require.NoError(t, err)
+		fp.CommitProof, err = UnmarshalRangeProof(bs)
+		require.NoError(t, err)
+		require.NoError(t, fp.Verify(hash2))
+	})
+}
@@ -24,8 +24,8 @@ type RWTree struct {
 	updated bool
 }
 
-var _ KVCallbackIterableReader = &RWTree{}
 var _ Versioned = &RWTree{}
+var _ KVCallbackIterableProver = &RWTree{}
 
 // Creates a concurrency safe version of an IAVL tree whereby writes go a latest working tree and reads are routed to
 // the last saved tree. All methods are safe for multiple readers and writers.
@@ -139,6 +139,10 @@ func (rwt *RWTree) Has(key []byte) (bool, error) {
 	return rwt.readTree.Load().(*ImmutableTree).Has(key)
 }
 
+func (rwt *RWTree) GetWithProof(key []byte) ([]byte, *iavl.RangeProof, error) {
+	return rwt.readTree.Load().(*ImmutableTree).GetWithProof(key)
+}
+
 func (rwt *RWTree) Iterate(low, high []byte, ascending bool, fn func(key []byte, value []byte) error) error {
 	return rwt.readTree.Load().(*ImmutableTree).Iterate(low, high, ascending, fn)
 }
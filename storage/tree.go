@@ -7,6 +7,9 @@ import (
 	dbm "github.com/tendermint/tm-db"
 )
 
+// Returned when reading a version of a tree that has not been saved or has since been deleted
+var ErrVersionDoesNotExist = iavl.ErrVersionDoesNotExist
+
 // We wrap IAVL's tree types in order to implement standard DB interface and iteration helpers
 type MutableTree struct {
 	*iavl.MutableTree
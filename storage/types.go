@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"github.com/cosmos/iavl"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -54,6 +55,17 @@ type KVCallbackIterableReader interface {
 	KVCallbackIterable
 }
 
+// Provides merkle proofs against the root hash of a tree
+type KVProver interface {
+	// GetWithProof returns the value at key (nil if absent) with a proof of its existence or absence
+	GetWithProof(key []byte) ([]byte, *iavl.RangeProof, error)
+}
+
+type KVCallbackIterableProver interface {
+	KVCallbackIterableReader
+	KVProver
+}
+
 // KVStore is a simple interface to get/set data
 type KVReaderWriter interface {
 	KVReader
@@ -38,18 +38,22 @@ const (
 	Ballot_PROPOSED Ballot_ProposalState = 0
 	Ballot_EXECUTED Ballot_ProposalState = 1
 	Ballot_FAILED   Ballot_ProposalState = 2
+	// EXPIRED proposals reached their ExpiryHeight without being executed
+	Ballot_EXPIRED Ballot_ProposalState = 3
 )
 
 var Ballot_ProposalState_name = map[int32]string{
 	0: "PROPOSED",
 	1: "EXECUTED",
 	2: "FAILED",
+	3: "EXPIRED",
 }
 
 var Ballot_ProposalState_value = map[string]int32{
 	"PROPOSED": 0,
 	"EXECUTED": 1,
 	"FAILED":   2,
+	"EXPIRED":  3,
 }
 
 func (x Ballot_ProposalState) String() string {
@@ -725,13 +729,15 @@ func (*GovTx) XXX_MessageName() string {
 }
 
 type ProposalTx struct {
-	Input                *TxInput                                       `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
-	VotingWeight         int64                                          `protobuf:"varint,2,opt,name=VotingWeight,proto3" json:"VotingWeight,omitempty"`
-	ProposalHash         *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=ProposalHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"ProposalHash,omitempty"`
-	Proposal             *Proposal                                      `protobuf:"bytes,4,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+	Input        *TxInput                                       `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
+	VotingWeight int64                                          `protobuf:"varint,2,opt,name=VotingWeight,proto3" json:"VotingWeight,omitempty"`
+	ProposalHash *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=ProposalHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"ProposalHash,omitempty"`
+	Proposal     *Proposal                                      `protobuf:"bytes,4,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
+	// Withdraw the vote previously cast by Input for the proposal identified by ProposalHash
+	Withdraw             bool     `protobuf:"varint,5,opt,name=Withdraw,proto3" json:"Withdraw,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProposalTx) Reset()      { *m = ProposalTx{} }
@@ -889,9 +895,11 @@ func (*Vote) XXX_MessageName() string {
 }
 
 type Proposal struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	BatchTx              *BatchTx `protobuf:"bytes,3,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
+	Name        string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	BatchTx     *BatchTx `protobuf:"bytes,3,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
+	// The block height from which the proposal no longer accepts votes, zero for no expiry
+	ExpiryHeight         uint64   `protobuf:"varint,4,opt,name=ExpiryHeight,proto3" json:"ExpiryHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1035,76 +1043,78 @@ func init() { proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d5
 func init() { golang_proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d56) }
 
 var fileDescriptor_678c914f1bee6d56 = []byte{
-	// 1096 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0x66, 0x37, 0xb6, 0xfb, 0xe2, 0xe4, 0xeb, 0xce, 0xb7, 0xad, 0xac, 0x48, 0xd8, 0x91,
-	0x41, 0x90, 0x96, 0xd6, 0x86, 0x94, 0x1f, 0x22, 0x37, 0xff, 0x4a, 0x1a, 0xd4, 0x26, 0x66, 0xbc,
-	0x69, 0x11, 0x88, 0xc3, 0x7a, 0x3d, 0xac, 0x57, 0xb2, 0x77, 0x96, 0xdd, 0x71, 0xd9, 0xe5, 0xc4,
-	0x81, 0x03, 0x77, 0x2e, 0x1c, 0xf3, 0x1f, 0x20, 0xfe, 0x03, 0x4e, 0x28, 0x47, 0x8e, 0x88, 0x43,
-	0x84, 0xd2, 0x0b, 0xe2, 0xaf, 0x40, 0x33, 0x3b, 0xbb, 0x1e, 0xbb, 0x55, 0xeb, 0x44, 0x88, 0xdb,
-	0xcc, 0x7b, 0x9f, 0x79, 0xef, 0xcd, 0x7b, 0x9f, 0x79, 0x6f, 0x60, 0xc3, 0xb7, 0xe2, 0x31, 0xb5,
-	0x86, 0x75, 0x3f, 0xa0, 0x8c, 0xa2, 0xbc, 0xdc, 0x6e, 0xdd, 0x70, 0xa8, 0x43, 0x85, 0xac, 0xc1,
-	0x57, 0x89, 0x7a, 0xab, 0xe4, 0x93, 0x60, 0xe2, 0x86, 0xa1, 0x4b, 0x3d, 0x29, 0xd9, 0x0c, 0x88,
-	0xe3, 0x86, 0x2c, 0x88, 0xe5, 0x1e, 0x42, 0x9f, 0xd8, 0xc9, 0xba, 0xf6, 0xab, 0x0e, 0x7a, 0xd3,
-	0x8b, 0xd1, 0x5b, 0x90, 0x6b, 0x5b, 0xe3, 0xb1, 0x19, 0x95, 0xb5, 0x6d, 0x6d, 0x67, 0x7d, 0xf7,
-	0x7f, 0xf5, 0xd4, 0x69, 0x22, 0xc6, 0x52, 0xcd, 0x81, 0x7d, 0xe2, 0x0d, 0xcd, 0xa8, 0xbc, 0xba,
-	0x00, 0x4c, 0xc4, 0x58, 0xaa, 0x39, 0xf0, 0xc8, 0x9a, 0x10, 0x33, 0x2a, 0xeb, 0x0b, 0xc0, 0x44,
-	0x8c, 0xa5, 0x1a, 0xdd, 0x81, 0x7c, 0x8f, 0x04, 0x93, 0xd0, 0x8c, 0xca, 0x86, 0x40, 0x96, 0x32,
-	0xa4, 0x94, 0xe3, 0x14, 0x80, 0xde, 0x80, 0xb5, 0x03, 0xfa, 0xd4, 0x8c, 0xca, 0x6b, 0x02, 0xb9,
-	0x99, 0x21, 0x85, 0x14, 0x27, 0x4a, 0xee, 0xba, 0x45, 0x45, 0x8c, 0xb9, 0x05, 0xd7, 0x89, 0x18,
-	0x4b, 0x35, 0xba, 0x07, 0x85, 0x13, 0x6f, 0x90, 0x40, 0xf3, 0x02, 0x7a, 0x3d, 0x83, 0xa6, 0x0a,
-	0x9c, 0x41, 0x78, 0xa4, 0x2d, 0x8b, 0xd9, 0x23, 0x33, 0x2a, 0x17, 0x16, 0x22, 0x95, 0x72, 0x9c,
-	0x02, 0xd0, 0x7d, 0x80, 0x5e, 0x40, 0x7d, 0x1a, 0x5a, 0x3c, 0xa9, 0xd7, 0x04, 0xfc, 0xff, 0xb3,
-	0x8b, 0x65, 0x2a, 0xac, 0xc0, 0xf8, 0xa1, 0xc3, 0x21, 0xf1, 0x98, 0xfb, 0x65, 0x6c, 0x46, 0x65,
-	0x58, 0x38, 0x34, 0x53, 0x61, 0x05, 0xb6, 0x67, 0x9c, 0x9d, 0x56, 0xb5, 0xda, 0x0f, 0x1a, 0xe4,
-	0xcd, 0xe8, 0xd0, 0xf3, 0xa7, 0x0c, 0x1d, 0x41, 0xbe, 0x39, 0x1c, 0x06, 0x24, 0x0c, 0x45, 0x35,
-	0x8b, 0xad, 0xf7, 0xce, 0xce, 0xab, 0x2b, 0x7f, 0x9c, 0x57, 0xef, 0x3a, 0x2e, 0x1b, 0x4d, 0x07,
-	0x75, 0x9b, 0x4e, 0x1a, 0xa3, 0xd8, 0x27, 0xc1, 0x98, 0x0c, 0x1d, 0x12, 0x34, 0x06, 0xd3, 0x20,
-	0xa0, 0x5f, 0x37, 0xec, 0x20, 0xf6, 0x19, 0xad, 0xcb, 0xb3, 0x38, 0x35, 0x82, 0x6e, 0x41, 0xae,
-	0x39, 0xa1, 0x53, 0x8f, 0x89, 0x9a, 0x1b, 0x58, 0xee, 0xd0, 0x16, 0x14, 0xfa, 0xe4, 0xab, 0x29,
-	0xf1, 0x6c, 0x22, 0x8a, 0x6c, 0xe0, 0x6c, 0xbf, 0x67, 0xfc, 0x78, 0x5a, 0x5d, 0xa9, 0x45, 0x50,
-	0x30, 0xa3, 0xe3, 0x29, 0xfb, 0x0f, 0xa3, 0x92, 0x9e, 0x7f, 0xd2, 0x53, 0x46, 0xa3, 0x37, 0x61,
-	0x4d, 0xe4, 0x45, 0x52, 0x7b, 0x56, 0x34, 0x99, 0x2f, 0x9c, 0xa8, 0xd1, 0xc7, 0xb3, 0x00, 0x57,
-	0x45, 0x80, 0xef, 0x5c, 0x3d, 0xb8, 0x2d, 0x28, 0x1c, 0x58, 0xe1, 0x43, 0x77, 0xe2, 0xb2, 0x34,
-	0x35, 0xe9, 0x1e, 0x95, 0x40, 0xdf, 0x27, 0x44, 0x90, 0xdd, 0xc0, 0x7c, 0x89, 0x0e, 0xc1, 0xe8,
-	0x58, 0xcc, 0x12, 0xac, 0x2e, 0xb6, 0xde, 0x97, 0x79, 0xb9, 0xf7, 0x72, 0xd7, 0x03, 0xd7, 0xb3,
-	0x82, 0xb8, 0xfe, 0x80, 0x44, 0xad, 0x98, 0x91, 0x10, 0x0b, 0x13, 0xe8, 0x73, 0x30, 0x9e, 0x34,
-	0xfb, 0x8f, 0x04, 0xf3, 0x8b, 0xad, 0x83, 0x2b, 0x99, 0xfa, 0xfb, 0xbc, 0xba, 0xc9, 0x2c, 0x27,
-	0xbc, 0x4b, 0x27, 0x2e, 0x23, 0x13, 0x9f, 0xc5, 0x58, 0x18, 0x45, 0x1f, 0x41, 0xb1, 0x4d, 0x3d,
-	0x16, 0x58, 0x36, 0x7b, 0x44, 0x98, 0x55, 0xce, 0x6f, 0xeb, 0x3b, 0xeb, 0xbb, 0x37, 0x67, 0xbd,
-	0x42, 0x51, 0xe2, 0x39, 0xa8, 0x4c, 0x48, 0x2f, 0x70, 0x6d, 0x22, 0x1e, 0x4f, 0x92, 0x10, 0xb1,
-	0x97, 0x15, 0x9b, 0xce, 0x1b, 0x47, 0x9f, 0x40, 0xa1, 0x4d, 0x87, 0xe4, 0x81, 0x15, 0x8e, 0x24,
-	0x61, 0xae, 0x98, 0x98, 0xcc, 0x0c, 0x42, 0x60, 0x88, 0xb8, 0x79, 0x79, 0xaf, 0x61, 0xb1, 0xae,
-	0xb9, 0x69, 0x43, 0x43, 0x3b, 0x90, 0x13, 0x44, 0xe0, 0xfc, 0xd4, 0x5f, 0x48, 0x14, 0xa9, 0x47,
-	0x6f, 0x43, 0x3e, 0x21, 0x35, 0x67, 0x8a, 0x3e, 0xd7, 0x36, 0x52, 0xba, 0xe3, 0x14, 0xb1, 0x57,
-	0xf8, 0xfe, 0xb4, 0xba, 0x22, 0x6e, 0x48, 0xb3, 0x4e, 0xb7, 0x34, 0x27, 0x3f, 0x80, 0x02, 0x3f,
-	0xd2, 0x0c, 0x9c, 0x50, 0x36, 0xdc, 0x1b, 0x75, 0xa5, 0xc1, 0xa7, 0xba, 0x96, 0xc1, 0x53, 0x83,
-	0x33, 0xac, 0x4c, 0xa9, 0x9f, 0xf6, 0xe0, 0xa5, 0xfd, 0x21, 0x30, 0xf8, 0x89, 0x34, 0x43, 0x7c,
-	0xcd, 0x65, 0x82, 0x9d, 0x7a, 0x22, 0x13, 0x34, 0x7b, 0x8e, 0xc3, 0xd2, 0xe3, 0x5e, 0xda, 0x7a,
-	0x97, 0xf5, 0xa8, 0xa4, 0xc7, 0x99, 0x75, 0xe3, 0xa5, 0xe3, 0xbd, 0x0d, 0xb9, 0x24, 0xcf, 0x32,
-	0x3b, 0x2f, 0x28, 0x84, 0x04, 0x28, 0x8e, 0xbe, 0xd5, 0xe4, 0x18, 0xb9, 0x44, 0xc9, 0xdb, 0xb0,
-	0xd9, 0xb4, 0x6d, 0xde, 0x60, 0x4e, 0xfc, 0xa1, 0xc5, 0x48, 0x5a, 0xf9, 0x9b, 0x75, 0x31, 0x4d,
-	0x4d, 0x32, 0xf1, 0xc7, 0x16, 0x23, 0x12, 0x23, 0xea, 0xa1, 0xe1, 0x85, 0x23, 0x4a, 0x08, 0x7f,
-	0x69, 0xea, 0x7c, 0x58, 0xfa, 0xba, 0x35, 0x28, 0x3e, 0xa6, 0xcc, 0xf5, 0x9c, 0x27, 0xc4, 0x75,
-	0x46, 0xc9, 0xa5, 0x75, 0x3c, 0x27, 0x43, 0x27, 0x50, 0x4c, 0x2d, 0x8b, 0xb7, 0xa3, 0x8b, 0xb7,
-	0xf3, 0xee, 0xe5, 0xdf, 0xcd, 0x9c, 0x19, 0x3e, 0x2b, 0xd3, 0xbd, 0x9c, 0xd3, 0xd7, 0x9f, 0x1b,
-	0x67, 0x38, 0x83, 0x28, 0x57, 0x1d, 0xab, 0x43, 0xed, 0x12, 0x19, 0xbf, 0x03, 0xc6, 0x11, 0x1d,
-	0x12, 0x59, 0xd8, 0x5b, 0xf5, 0xec, 0x17, 0xc3, 0xa5, 0x89, 0x45, 0xde, 0x98, 0xf8, 0x4e, 0xf1,
-	0xf6, 0x45, 0x36, 0xa3, 0x2f, 0xe1, 0xaa, 0x02, 0xba, 0x19, 0xa5, 0x15, 0x2d, 0x66, 0xb0, 0xa6,
-	0x17, 0x63, 0xae, 0x50, 0xcc, 0x7f, 0xa7, 0x81, 0xf1, 0x98, 0x32, 0xf2, 0xaf, 0x4f, 0xb3, 0x25,
-	0x2a, 0xab, 0x84, 0xf1, 0x74, 0x56, 0x8c, 0xec, 0xc9, 0x6a, 0xca, 0x93, 0xdd, 0x86, 0xf5, 0x0e,
-	0x09, 0xed, 0xc0, 0xf5, 0x99, 0x4b, 0x3d, 0xf9, 0x9a, 0x55, 0x91, 0xfa, 0x97, 0xd1, 0x5f, 0xf1,
-	0x97, 0x51, 0xfc, 0xfe, 0xbc, 0x0a, 0xb9, 0x96, 0x35, 0x1e, 0x53, 0x36, 0xc7, 0x07, 0xed, 0x95,
-	0x7c, 0xe0, 0xac, 0xdc, 0x77, 0x3d, 0x6b, 0xec, 0x7e, 0xe3, 0x7a, 0x8e, 0xfc, 0x3d, 0x5e, 0x8d,
-	0x95, 0xaa, 0x19, 0xd4, 0x86, 0x0d, 0x5f, 0xba, 0xe8, 0x33, 0x8b, 0x25, 0x1d, 0x69, 0x73, 0xf7,
-	0x35, 0xe5, 0x32, 0x3c, 0xda, 0x2c, 0x22, 0x01, 0xc2, 0xf3, 0x67, 0xd0, 0xeb, 0xb0, 0xc6, 0x6b,
-	0x1a, 0x96, 0xd7, 0x04, 0x01, 0x36, 0xb2, 0xc3, 0x5c, 0x8a, 0x13, 0x5d, 0xed, 0x43, 0xd8, 0x98,
-	0x33, 0x82, 0x8a, 0x50, 0xe8, 0xe1, 0xe3, 0xde, 0x71, 0xbf, 0xdb, 0x29, 0xad, 0xf0, 0x5d, 0xf7,
-	0xd3, 0x6e, 0xfb, 0xc4, 0xec, 0x76, 0x4a, 0x1a, 0x02, 0xc8, 0xed, 0x37, 0x0f, 0x1f, 0x76, 0x3b,
-	0xa5, 0xd5, 0x56, 0xfb, 0xec, 0xa2, 0xa2, 0xfd, 0x76, 0x51, 0xd1, 0x7e, 0xbf, 0xa8, 0x68, 0x7f,
-	0x5e, 0x54, 0xb4, 0x5f, 0x9e, 0x55, 0xb4, 0xb3, 0x67, 0x15, 0xed, 0xb3, 0xdb, 0x2f, 0xbf, 0x39,
-	0x8b, 0xc2, 0x86, 0x8c, 0x64, 0x90, 0x13, 0xdf, 0xf5, 0xfb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x94, 0xcd, 0x56, 0xb9, 0x0c, 0x0c, 0x00, 0x00,
+	// 1128 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x66, 0x37, 0xf6, 0xf6, 0xc5, 0x09, 0x66, 0x68, 0xab, 0x55, 0x24, 0xec, 0xc8, 0x20,
+	0x48, 0x4b, 0xeb, 0x40, 0x0a, 0x48, 0xe4, 0xe6, 0x7f, 0xf9, 0x83, 0xda, 0xc4, 0x4c, 0x36, 0x4d,
+	0x05, 0xe2, 0xb0, 0xb1, 0x87, 0xf5, 0x4a, 0xf6, 0xce, 0xb2, 0x3b, 0xa6, 0xbb, 0x9c, 0x38, 0x70,
+	0xe0, 0xce, 0x05, 0x71, 0xca, 0x37, 0xe0, 0x1b, 0x20, 0x4e, 0x28, 0x47, 0x8e, 0x88, 0x43, 0x84,
+	0xd2, 0x1b, 0x9f, 0x02, 0xcd, 0xec, 0xec, 0x7a, 0x6c, 0xaa, 0xd6, 0x09, 0x88, 0xdb, 0xbc, 0xf7,
+	0x7e, 0xf3, 0xde, 0x9b, 0xf7, 0x7e, 0xf3, 0x66, 0x60, 0x25, 0x70, 0x92, 0x21, 0x75, 0xfa, 0xf5,
+	0x20, 0xa4, 0x8c, 0xa2, 0xa2, 0x14, 0xd7, 0x6e, 0xba, 0xd4, 0xa5, 0x42, 0xb7, 0xc9, 0x57, 0xa9,
+	0x79, 0xad, 0x1c, 0x90, 0x70, 0xe4, 0x45, 0x91, 0x47, 0x7d, 0xa9, 0x59, 0x0d, 0x89, 0xeb, 0x45,
+	0x2c, 0x4c, 0xa4, 0x0c, 0x51, 0x40, 0x7a, 0xe9, 0xba, 0xf6, 0xab, 0x0e, 0x7a, 0xc3, 0x4f, 0xd0,
+	0xdb, 0x50, 0x68, 0x39, 0xc3, 0xa1, 0x1d, 0x5b, 0xda, 0xba, 0xb6, 0xb1, 0xbc, 0xf5, 0x4a, 0x3d,
+	0x0b, 0x9a, 0xaa, 0xb1, 0x34, 0x73, 0xe0, 0x11, 0xf1, 0xfb, 0x76, 0x6c, 0x2d, 0xce, 0x00, 0x53,
+	0x35, 0x96, 0x66, 0x0e, 0x3c, 0x70, 0x46, 0xc4, 0x8e, 0x2d, 0x7d, 0x06, 0x98, 0xaa, 0xb1, 0x34,
+	0xa3, 0xbb, 0x50, 0xec, 0x92, 0x70, 0x14, 0xd9, 0xb1, 0x65, 0x08, 0x64, 0x39, 0x47, 0x4a, 0x3d,
+	0xce, 0x00, 0xe8, 0x4d, 0x58, 0xda, 0xa5, 0x5f, 0xd9, 0xb1, 0xb5, 0x24, 0x90, 0xab, 0x39, 0x52,
+	0x68, 0x71, 0x6a, 0xe4, 0xa1, 0x9b, 0x54, 0xe4, 0x58, 0x98, 0x09, 0x9d, 0xaa, 0xb1, 0x34, 0xa3,
+	0xfb, 0x60, 0x1e, 0xfb, 0xa7, 0x29, 0xb4, 0x28, 0xa0, 0xaf, 0xe6, 0xd0, 0xcc, 0x80, 0x73, 0x08,
+	0xcf, 0xb4, 0xe9, 0xb0, 0xde, 0xc0, 0x8e, 0x2d, 0x73, 0x26, 0x53, 0xa9, 0xc7, 0x19, 0x00, 0x3d,
+	0x00, 0xe8, 0x86, 0x34, 0xa0, 0x91, 0xc3, 0x8b, 0x7a, 0x43, 0xc0, 0x5f, 0x9b, 0x1c, 0x2c, 0x37,
+	0x61, 0x05, 0xc6, 0x37, 0xed, 0xf7, 0x89, 0xcf, 0xbc, 0x2f, 0x12, 0x3b, 0xb6, 0x60, 0x66, 0xd3,
+	0xc4, 0x84, 0x15, 0xd8, 0xb6, 0x71, 0x7e, 0x56, 0xd5, 0x6a, 0xdf, 0x6b, 0x50, 0xb4, 0xe3, 0x7d,
+	0x3f, 0x18, 0x33, 0x74, 0x00, 0xc5, 0x46, 0xbf, 0x1f, 0x92, 0x28, 0x12, 0xdd, 0x2c, 0x35, 0xdf,
+	0x3f, 0xbf, 0xa8, 0x2e, 0xfc, 0x71, 0x51, 0xbd, 0xe7, 0x7a, 0x6c, 0x30, 0x3e, 0xad, 0xf7, 0xe8,
+	0x68, 0x73, 0x90, 0x04, 0x24, 0x1c, 0x92, 0xbe, 0x4b, 0xc2, 0xcd, 0xd3, 0x71, 0x18, 0xd2, 0xa7,
+	0x9b, 0xbd, 0x30, 0x09, 0x18, 0xad, 0xcb, 0xbd, 0x38, 0x73, 0x82, 0x6e, 0x43, 0xa1, 0x31, 0xa2,
+	0x63, 0x9f, 0x89, 0x9e, 0x1b, 0x58, 0x4a, 0x68, 0x0d, 0xcc, 0x23, 0xf2, 0xe5, 0x98, 0xf8, 0x3d,
+	0x22, 0x9a, 0x6c, 0xe0, 0x5c, 0xde, 0x36, 0x7e, 0x38, 0xab, 0x2e, 0xd4, 0x62, 0x30, 0xed, 0xf8,
+	0x70, 0xcc, 0xfe, 0xc7, 0xac, 0x64, 0xe4, 0x9f, 0xf4, 0x8c, 0xd1, 0xe8, 0x2d, 0x58, 0x12, 0x75,
+	0xb1, 0xb4, 0x99, 0xa6, 0xc9, 0x7a, 0xe1, 0xd4, 0x8c, 0x3e, 0x9e, 0x24, 0xb8, 0x28, 0x12, 0x7c,
+	0xf7, 0xfa, 0xc9, 0xad, 0x81, 0xb9, 0xeb, 0x44, 0x0f, 0xbd, 0x91, 0xc7, 0xb2, 0xd2, 0x64, 0x32,
+	0x2a, 0x83, 0xbe, 0x43, 0x88, 0x20, 0xbb, 0x81, 0xf9, 0x12, 0xed, 0x83, 0xd1, 0x76, 0x98, 0x23,
+	0x58, 0x5d, 0x6a, 0x7e, 0x20, 0xeb, 0x72, 0xff, 0xc5, 0xa1, 0x4f, 0x3d, 0xdf, 0x09, 0x93, 0xfa,
+	0x1e, 0x89, 0x9b, 0x09, 0x23, 0x11, 0x16, 0x2e, 0xd0, 0x67, 0x60, 0x9c, 0x34, 0x8e, 0x1e, 0x09,
+	0xe6, 0x97, 0x9a, 0xbb, 0xd7, 0x72, 0xf5, 0xd7, 0x45, 0x75, 0x95, 0x39, 0x6e, 0x74, 0x8f, 0x8e,
+	0x3c, 0x46, 0x46, 0x01, 0x4b, 0xb0, 0x70, 0x8a, 0x3e, 0x82, 0x52, 0x8b, 0xfa, 0x2c, 0x74, 0x7a,
+	0xec, 0x11, 0x61, 0x8e, 0x55, 0x5c, 0xd7, 0x37, 0x96, 0xb7, 0x6e, 0x4d, 0x66, 0x85, 0x62, 0xc4,
+	0x53, 0x50, 0x59, 0x90, 0x6e, 0xe8, 0xf5, 0x88, 0x65, 0xe6, 0x05, 0x11, 0xb2, 0xec, 0xd8, 0x78,
+	0xda, 0x39, 0xfa, 0x04, 0xcc, 0x16, 0xed, 0x93, 0x3d, 0x27, 0x1a, 0x58, 0xda, 0xbf, 0x29, 0x4c,
+	0xee, 0x06, 0x21, 0x30, 0x44, 0xde, 0xbc, 0xbd, 0x37, 0xb0, 0x58, 0xd7, 0xbc, 0x6c, 0xa0, 0xa1,
+	0x0d, 0x28, 0x08, 0x22, 0x70, 0x7e, 0xea, 0xcf, 0x25, 0x8a, 0xb4, 0xa3, 0x77, 0xa0, 0x98, 0x92,
+	0x9a, 0x33, 0x45, 0x9f, 0x1a, 0x1b, 0x19, 0xdd, 0x71, 0x86, 0xd8, 0x36, 0xbf, 0x3b, 0xab, 0x2e,
+	0x88, 0x13, 0xd2, 0x7c, 0xd2, 0xcd, 0xcd, 0xc9, 0x0f, 0xc1, 0xe4, 0x5b, 0x1a, 0xa1, 0x1b, 0xc9,
+	0x81, 0x7b, 0xb3, 0xae, 0x0c, 0xf8, 0xcc, 0xd6, 0x34, 0x78, 0x69, 0x70, 0x8e, 0x95, 0x25, 0x0d,
+	0xb2, 0x19, 0x3c, 0x77, 0x3c, 0x04, 0x06, 0xdf, 0x91, 0x55, 0x88, 0xaf, 0xb9, 0x4e, 0xb0, 0x53,
+	0x4f, 0x75, 0x7c, 0xfd, 0x4f, 0x0e, 0xcb, 0x88, 0xdb, 0xd9, 0xe8, 0x9d, 0x37, 0xa2, 0x52, 0x1e,
+	0x77, 0x32, 0x8d, 0xe7, 0xce, 0xf7, 0x0e, 0x14, 0xd2, 0x3a, 0xcb, 0xea, 0x3c, 0xa7, 0x11, 0x12,
+	0xa0, 0x04, 0xfa, 0x46, 0x93, 0xcf, 0xc8, 0x15, 0x5a, 0xde, 0x82, 0xd5, 0x46, 0xaf, 0xc7, 0x07,
+	0xcc, 0x71, 0xd0, 0x77, 0x18, 0xc9, 0x3a, 0x7f, 0xab, 0x2e, 0x5e, 0x53, 0x9b, 0x8c, 0x82, 0xa1,
+	0xc3, 0x88, 0xc4, 0x88, 0x7e, 0x68, 0x78, 0x66, 0x8b, 0x92, 0xc2, 0xb7, 0x8b, 0xea, 0xfb, 0x30,
+	0xf7, 0x71, 0x6b, 0x50, 0x7a, 0x4c, 0x99, 0xe7, 0xbb, 0x27, 0xc4, 0x73, 0x07, 0xe9, 0xa1, 0x75,
+	0x3c, 0xa5, 0x43, 0xc7, 0x50, 0xca, 0x3c, 0x8b, 0xbb, 0xa3, 0x8b, 0xbb, 0xf3, 0xde, 0xd5, 0xef,
+	0xcd, 0x94, 0x1b, 0xfe, 0x56, 0x66, 0xb2, 0x65, 0xcc, 0xd4, 0x3a, 0x33, 0xe0, 0x1c, 0xc2, 0xef,
+	0xfb, 0x89, 0xc7, 0x06, 0xfd, 0xd0, 0x79, 0x2a, 0xc6, 0x9a, 0x89, 0x73, 0x59, 0x29, 0xc3, 0x50,
+	0x7d, 0xf0, 0xae, 0xd0, 0x8d, 0xbb, 0x60, 0x1c, 0xd0, 0x3e, 0x91, 0x4d, 0xbf, 0x5d, 0xcf, 0x7f,
+	0x38, 0x5c, 0x9b, 0x7a, 0xe4, 0x43, 0x8b, 0x4b, 0x4a, 0xb4, 0xcf, 0xf3, 0xf7, 0xfb, 0x0a, 0xa1,
+	0x2a, 0xa0, 0xdb, 0x71, 0xd6, 0xed, 0x52, 0x0e, 0x6b, 0xf8, 0x09, 0xe6, 0x06, 0xb5, 0xa7, 0x1a,
+	0x18, 0x8f, 0x29, 0x23, 0xff, 0xf9, 0x4b, 0x37, 0x47, 0xd7, 0x95, 0x34, 0x7e, 0xd4, 0x26, 0x9d,
+	0xca, 0xef, 0xb3, 0xa6, 0xdc, 0xe7, 0x75, 0x58, 0x6e, 0x93, 0xa8, 0x17, 0x7a, 0x01, 0xf3, 0xa8,
+	0x2f, 0xaf, 0xba, 0xaa, 0x52, 0x3f, 0x3a, 0xfa, 0xcb, 0x3e, 0x3a, 0x35, 0x28, 0x75, 0xe2, 0xc0,
+	0x0b, 0x93, 0xbd, 0x34, 0xb9, 0x74, 0x24, 0x4c, 0xe9, 0x94, 0xe4, 0x7e, 0x5e, 0x84, 0x42, 0xd3,
+	0x19, 0x0e, 0x29, 0x9b, 0x22, 0x94, 0xf6, 0x72, 0x42, 0x1d, 0x43, 0x69, 0xc7, 0xf3, 0x9d, 0xa1,
+	0xf7, 0xb5, 0xe7, 0xbb, 0xf2, 0xfb, 0x79, 0x3d, 0x5a, 0xab, 0x6e, 0x50, 0x0b, 0x56, 0x02, 0x19,
+	0xe2, 0x88, 0x39, 0x2c, 0x1d, 0x69, 0xab, 0x5b, 0xaf, 0x2b, 0x07, 0xe6, 0xd9, 0xd6, 0xbb, 0x2a,
+	0x08, 0x4f, 0xef, 0x41, 0x6f, 0xc0, 0x12, 0x6f, 0x7c, 0x64, 0x2d, 0x09, 0x96, 0xac, 0xe4, 0x9b,
+	0xb9, 0x16, 0xa7, 0xb6, 0x5a, 0x1b, 0x56, 0xa6, 0x9c, 0xa0, 0x12, 0x98, 0x5d, 0x7c, 0xd8, 0x3d,
+	0x3c, 0xea, 0xb4, 0xcb, 0x0b, 0x5c, 0xea, 0x3c, 0xe9, 0xb4, 0x8e, 0xed, 0x4e, 0xbb, 0xac, 0x21,
+	0x80, 0xc2, 0x4e, 0x63, 0xff, 0x61, 0xa7, 0x5d, 0x5e, 0x44, 0xcb, 0x50, 0xec, 0x3c, 0xe9, 0xee,
+	0xe3, 0x4e, 0xbb, 0xac, 0x37, 0x5b, 0xe7, 0x97, 0x15, 0xed, 0xb7, 0xcb, 0x8a, 0xf6, 0xfb, 0x65,
+	0x45, 0xfb, 0xf3, 0xb2, 0xa2, 0xfd, 0xf2, 0xac, 0xa2, 0x9d, 0x3f, 0xab, 0x68, 0x9f, 0xde, 0x79,
+	0x71, 0x19, 0x58, 0x1c, 0x6d, 0xca, 0xb4, 0x4e, 0x0b, 0xe2, 0xf3, 0xff, 0xe0, 0xef, 0x01, 0x00,
+	0x5c, 0xba, 0x6e, 0x93, 0x5a, 0x0c, 0x00, 0x00,
 }
 
 func (m *Any) Marshal() (dAtA []byte, err error) {
@@ -1818,6 +1828,16 @@ func (m *ProposalTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Withdraw {
+		i--
+		if m.Withdraw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Proposal != nil {
 		{
 			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
@@ -2036,6 +2056,11 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BatchTx != nil {
 		{
 			size, err := m.BatchTx.MarshalToSizedBuffer(dAtA[:i])
@@ -2442,6 +2467,9 @@ func (m *ProposalTx) Size() (n int) {
 		l = m.Proposal.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
+	if m.Withdraw {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2529,6 +2557,9 @@ func (m *Proposal) Size() (n int) {
 		l = m.BatchTx.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovPayload(uint64(m.ExpiryHeight))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4555,6 +4586,26 @@ func (m *ProposalTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Withdraw = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -5049,6 +5100,25 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
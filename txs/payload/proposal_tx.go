@@ -35,3 +35,8 @@ func (p *Proposal) String() string {
 func (v *Vote) String() string {
 	return v.Address.String()
 }
+
+// Expired returns whether the proposal no longer accepts votes in a block at height
+func (p *Proposal) Expired(height uint64) bool {
+	return p.ExpiryHeight > 0 && height >= p.ExpiryHeight
+}
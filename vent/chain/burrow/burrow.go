@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"strconv"
 
 	"github.com/hyperledger/burrow/event"
@@ -96,7 +97,9 @@ func (b *Chain) StatusMessage(ctx context.Context, lastProcessedHeight uint64) [
 	}
 }
 
-func (b *Chain) ConsumeBlocks(ctx context.Context, in *rpcevents.BlockRange, consumer func(chain.Block) error) error {
+// Burrow has instant finality so never reorganises and we have no need of blockLog
+func (b *Chain) ConsumeBlocks(ctx context.Context, in *rpcevents.BlockRange, consumer func(chain.Block) error,
+	blockLog chain.BlockLog) error {
 	stream, err := b.exec.Stream(ctx, &rpcevents.BlocksRequest{
 		BlockRange: in,
 		Query:      b.filter.String(),
@@ -124,6 +127,19 @@ func (b *Chain) GetABI(ctx context.Context, address crypto.Address) (string, err
 	return result.Metadata, nil
 }
 
+func (b *Chain) GetStorage(ctx context.Context, address crypto.Address, key binary.Word256,
+	height uint64) ([]byte, error) {
+	result, err := b.query.GetStorage(ctx, &rpcquery.GetStorageParam{
+		Address: address,
+		Key:     key,
+		Height:  height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Value, nil
+}
+
 func (b *Chain) Close() error {
 	return b.conn.Close()
 }
@@ -153,6 +169,11 @@ func (b *Block) GetHeight() uint64 {
 	return b.Height
 }
 
+func (b *Block) GetHash() binary.HexBytes {
+	// Burrow blocks are final
+	return nil
+}
+
 func (b *Block) GetTxs() []chain.Transaction {
 	txs := make([]chain.Transaction, len(b.TxExecutions))
 	for i, tx := range b.TxExecutions {
@@ -242,6 +263,44 @@ func (tx *Transaction) GetEvents() []chain.Event {
 	return events
 }
 
+func (tx *Transaction) GetAddresses() []crypto.Address {
+	var addresses []crypto.Address
+	if tx.Receipt != nil && tx.Receipt.CreatesContract {
+		addresses = append(addresses, tx.Receipt.ContractAddress)
+	}
+	for _, ev := range tx.Events {
+		switch {
+		case ev.Call != nil:
+			addresses = append(addresses, ev.Call.CallData.Callee)
+		case ev.Log != nil:
+			addresses = append(addresses, ev.Log.Address)
+		}
+	}
+	return addresses
+}
+
+func (tx *Transaction) GetCalls() []*chain.Call {
+	var calls []*chain.Call
+	for _, ev := range tx.Events {
+		// Calls reverted within an otherwise successful transaction carry an exception
+		if ev.Call == nil || ev.Header.Exception != nil {
+			continue
+		}
+		calls = append(calls, &chain.Call{
+			Index:      ev.Header.Index,
+			CallType:   ev.Call.CallType.String(),
+			Caller:     ev.Call.CallData.Caller,
+			Callee:     ev.Call.CallData.Callee,
+			Origin:     ev.Call.Origin,
+			Input:      ev.Call.CallData.Data,
+			Return:     ev.Call.Return,
+			Value:      new(big.Int).SetBytes(ev.Call.CallData.Value),
+			StackDepth: ev.Call.StackDepth,
+		})
+	}
+	return calls
+}
+
 type Event exec.Event
 
 var _ chain.Event = (*Event)(nil)
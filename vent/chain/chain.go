@@ -4,6 +4,7 @@ package chain
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	"github.com/hyperledger/burrow/binary"
@@ -22,20 +23,27 @@ const (
 	defaultMaxRetires        = 5
 	defaultBackoffBase       = time.Second
 	defaultMaxBlockBatchSize = 100
+	defaultMaxReorgDepth     = 128
 )
 
 type Chain interface {
 	GetChainID() string
 	GetVersion() string
-	ConsumeBlocks(ctx context.Context, in *rpcevents.BlockRange, consumer func(Block) error) error
+	// ConsumeBlocks passes each block in range to consumer. For chains that may reorganise blockLog is used to detect
+	// when blocks already consumed have been replaced and to discard them before consuming their replacements.
+	ConsumeBlocks(ctx context.Context, in *rpcevents.BlockRange, consumer func(Block) error, blockLog BlockLog) error
 	StatusMessage(ctx context.Context, lastProcessedHeight uint64) []interface{}
 	Connectivity() connectivity.State
 	GetABI(ctx context.Context, address crypto.Address) (string, error)
+	// GetStorage returns the value stored at key in the storage of the contract at address as of height
+	GetStorage(ctx context.Context, address crypto.Address, key binary.Word256, height uint64) ([]byte, error)
 	Close() error
 }
 
 type Block interface {
 	GetHeight() uint64
+	// GetHash returns the hash of the block if it may be replaced by a chain reorganisation, otherwise nil
+	GetHash() binary.HexBytes
 	GetTxs() []Transaction
 	GetMetadata(columns types.SQLColumnNames) (map[string]interface{}, error)
 }
@@ -44,6 +52,10 @@ type Transaction interface {
 	GetHash() binary.HexBytes
 	GetIndex() uint64
 	GetEvents() []Event
+	// GetAddresses returns the addresses of contracts known to have been called or created by the transaction
+	GetAddresses() []crypto.Address
+	// GetCalls returns the successful calls made by the transaction, including internal calls between contracts
+	GetCalls() []*Call
 	GetException() *errors.Exception
 	GetOrigin() *Origin
 	GetMetadata(columns types.SQLColumnNames) (map[string]interface{}, error)
@@ -58,6 +70,28 @@ type Event interface {
 	GetData() []byte
 }
 
+// Call is a (possibly internal) call to a contract made by a transaction
+type Call struct {
+	// Index of the call amongst the events of the transaction
+	Index      uint64
+	CallType   string
+	Caller     crypto.Address
+	Callee     crypto.Address
+	Origin     crypto.Address
+	Input      []byte
+	Return     []byte
+	Value      *big.Int
+	StackDepth uint64
+}
+
+// BlockLog records the blocks a consumer has already consumed
+type BlockLog interface {
+	// LastBlocks returns up to limit of the most recently consumed blocks in descending order of height
+	LastBlocks(limit uint64) ([]types.BlockRecord, error)
+	// Rewind discards everything consumed from blocks above height
+	Rewind(height uint64) error
+}
+
 type Filter struct {
 	Addresses []crypto.Address
 	Topics    []binary.Word256
@@ -86,6 +120,12 @@ type BlockConsumerConfig struct {
 	// The default and maximum batch size for block requests, we will reduce it logarithmically to a single block
 	// when backing off
 	MaxBlockBatchSize uint64
+	// The number of blocks by which to trail the head of a chain that may reorganise, so that blocks are only consumed
+	// once they have this many confirmations
+	ConfirmationDepth uint64
+	// The maximum number of consumed blocks to search back through for a common ancestor when a reorganisation is
+	// detected - a deeper reorganisation is an error
+	MaxReorgDepth uint64
 }
 
 func (config *BlockConsumerConfig) Complete() {
@@ -104,4 +144,7 @@ func (config *BlockConsumerConfig) Complete() {
 	if config.MaxRetries == 0 {
 		config.MaxRetries = defaultMaxRetires
 	}
+	if config.MaxReorgDepth == 0 {
+		config.MaxReorgDepth = defaultMaxReorgDepth
+	}
 }
@@ -5,14 +5,16 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hyperledger/burrow/encoding/web3hex"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
-	"github.com/hyperledger/burrow/rpc/lib/types"
+	rpcTypes "github.com/hyperledger/burrow/rpc/lib/types"
 	"github.com/pkg/errors"
 
 	"github.com/hyperledger/burrow/rpc/rpcevents"
 	"github.com/hyperledger/burrow/rpc/web3/ethclient"
 	"github.com/hyperledger/burrow/vent/chain"
+	"github.com/hyperledger/burrow/vent/types"
 )
 
 const ConsumerScope = "EthereumConsumer"
@@ -21,10 +23,17 @@ type consumer struct {
 	client     ThrottleClient
 	filter     *chain.Filter
 	blockRange *rpcevents.BlockRange
+	blockLog   chain.BlockLog
 	logger     *logging.Logger
 	consumer   func(block chain.Block) error
 	// Next unconsumed height
-	nextBlockHeight     uint64
+	nextBlockHeight uint64
+	// The last block we consumed which we check is still part of the canonical chain
+	lastBlock *types.BlockRecord
+	// Whether we have rewound nextBlockHeight to before our original start
+	rewound             bool
+	confirmationDepth   uint64
+	maxReorgDepth       uint64
 	retries             uint64
 	baseBackoffDuration time.Duration
 	backoffDuration     time.Duration
@@ -33,14 +42,19 @@ type consumer struct {
 	blockBatchSize      uint64
 }
 
+// Consume blocks in blockRange from client. If blockLog is not nil it is used to detect and rewind blocks that have
+// been replaced by a chain reorganisation since they were consumed.
 func Consume(client ThrottleClient, filter *chain.Filter, blockRange *rpcevents.BlockRange, config *chain.BlockConsumerConfig,
-	logger *logging.Logger, consume func(block chain.Block) error) error {
+	blockLog chain.BlockLog, logger *logging.Logger, consume func(block chain.Block) error) error {
 	c := consumer{
 		client:              client,
 		filter:              filter,
 		blockRange:          blockRange,
+		blockLog:            blockLog,
 		logger:              logger.WithScope(ConsumerScope),
 		consumer:            consume,
+		confirmationDepth:   config.ConfirmationDepth,
+		maxReorgDepth:       config.MaxReorgDepth,
 		baseBackoffDuration: config.BaseBackoffDuration,
 		backoffDuration:     config.BaseBackoffDuration,
 		maxRetries:          config.MaxRetries,
@@ -51,6 +65,19 @@ func Consume(client ThrottleClient, filter *chain.Filter, blockRange *rpcevents.
 }
 
 func (c *consumer) Consume() error {
+	if c.blockLog != nil {
+		lastBlocks, err := c.blockLog.LastBlocks(1)
+		if err != nil {
+			return fmt.Errorf("could not get last consumed block: %w", err)
+		}
+		if len(lastBlocks) > 0 {
+			c.lastBlock = &lastBlocks[0]
+		}
+	}
+	err := c.checkReorg()
+	if err != nil {
+		return err
+	}
 	start, end, streaming, err := c.bounds()
 	if err != nil {
 		return err
@@ -62,6 +89,10 @@ func (c *consumer) Consume() error {
 		if err != nil {
 			return err
 		}
+		err = c.checkReorg()
+		if err != nil {
+			return err
+		}
 		start, end, streaming, err = c.bounds()
 		if err != nil {
 			return err
@@ -75,14 +106,24 @@ func (c *consumer) Consume() error {
 
 func (c *consumer) ConsumeInBatches(start, end uint64) error {
 	c.logger.TraceMsg("ConsumeInBatches", "start", start, "end", end)
-	for batchStart := start; batchStart <= end; batchStart += c.blockBatchSize {
+	var batchEnd uint64
+	for batchStart := start; batchStart <= end; batchStart = batchEnd + 1 {
 		// Avoid breaching requests limit
 		c.client.Throttle()
-		batchEnd := batchStart + c.blockBatchSize
+		batchEnd = batchStart + c.blockBatchSize - 1
 		c.logger.TraceMsg("Consuming batch", "batch_start", batchStart, "batch_end", batchEnd)
 		if batchEnd > end {
 			batchEnd = end
 		}
+		linked, err := c.followsLastBlock(batchStart)
+		if err != nil {
+			return err
+		}
+		if !linked {
+			// Return so that the reorganisation is handled before we consume any more blocks
+			c.logger.InfoMsg("Parent hash does not match last consumed block", "height", batchStart)
+			return nil
+		}
 		logs, err := c.client.GetLogs(&ethclient.Filter{
 			BlockRange: rpcevents.AbsoluteRange(batchStart, batchEnd),
 			Addresses:  c.filter.Addresses,
@@ -98,18 +139,137 @@ func (c *consumer) ConsumeInBatches(start, end uint64) error {
 		}
 		// Request was successful
 		c.recover()
-		lastBlock, err := consumeBlocksFromLogs(c.client, logs, c.consumer)
+		lastBlock, err := consumeBlocksFromLogs(c.client, logs, c.consumeBlock)
 		if err != nil {
 			return fmt.Errorf("could not consume ethereum logs: %w", err)
 		}
-		if lastBlock != nil {
-			c.nextBlockHeight = lastBlock.GetHeight() + 1
+		if lastBlock == nil || lastBlock.GetHeight() < batchEnd {
+			// Record the last block we scanned, even though it has no logs, so that we can detect a reorganisation
+			// of the blocks in this batch
+			err = c.consumeEmptyBlock(batchEnd)
+			if err != nil {
+				return err
+			}
 		}
+		c.nextBlockHeight = batchEnd + 1
 		c.logger.TraceMsg("Finished consuming batch", "next_block_height", c.nextBlockHeight)
 	}
 	return nil
 }
 
+func (c *consumer) consumeEmptyBlock(height uint64) error {
+	header, err := c.blockHeader(height)
+	if err != nil {
+		return err
+	}
+	if header == nil {
+		return fmt.Errorf("no block at height %d to record as scanned", height)
+	}
+	d := new(web3hex.Decoder)
+	hash := d.Bytes(header.Hash)
+	if d.Err() != nil {
+		return fmt.Errorf("could not decode hash of block at height %d: %w", height, d.Err())
+	}
+	return c.consumeBlock(&Block{
+		client: c.client,
+		Height: height,
+		Hash:   hash,
+	})
+}
+
+func (c *consumer) consumeBlock(block chain.Block) error {
+	err := c.consumer(block)
+	if err != nil {
+		return err
+	}
+	c.lastBlock = &types.BlockRecord{
+		Height: block.GetHeight(),
+		Hash:   block.GetHash(),
+	}
+	return nil
+}
+
+// checkReorg checks that the last block we consumed is still part of the canonical chain. If it is not we search back
+// through the blocks we have consumed for the most recent one that is, rewind to it, and resume from the block after.
+func (c *consumer) checkReorg() error {
+	if c.blockLog == nil || c.lastBlock == nil {
+		return nil
+	}
+	canonical, err := c.isCanonical(*c.lastBlock)
+	if err != nil || canonical {
+		return err
+	}
+	c.logger.InfoMsg("Chain reorganisation detected, searching for common ancestor",
+		"height", c.lastBlock.Height, "consumed_hash", c.lastBlock.Hash)
+	blocks, err := c.blockLog.LastBlocks(c.maxReorgDepth)
+	if err != nil {
+		return fmt.Errorf("could not get consumed blocks: %w", err)
+	}
+	for _, block := range blocks {
+		canonical, err = c.isCanonical(block)
+		if err != nil {
+			return err
+		}
+		if canonical {
+			c.logger.InfoMsg("Rewinding to common ancestor of chain reorganisation", "height", block.Height)
+			err = c.blockLog.Rewind(block.Height)
+			if err != nil {
+				return fmt.Errorf("could not rewind to height %d after chain reorganisation: %w", block.Height, err)
+			}
+			c.lastBlock = &block
+			c.nextBlockHeight = block.Height + 1
+			c.rewound = true
+			return nil
+		}
+	}
+	return fmt.Errorf("chain reorganisation detected at height %d but could not find a common ancestor in the "+
+		"last %d consumed blocks", c.lastBlock.Height, len(blocks))
+}
+
+func (c *consumer) isCanonical(block types.BlockRecord) (bool, error) {
+	header, err := c.blockHeader(block.Height)
+	if err != nil || header == nil {
+		return false, err
+	}
+	d := new(web3hex.Decoder)
+	hash := d.Bytes(header.Hash)
+	if d.Err() != nil {
+		return false, fmt.Errorf("could not decode hash of block at height %d: %w", block.Height, d.Err())
+	}
+	return bytes.Equal(hash, block.Hash), nil
+}
+
+// followsLastBlock checks that the parent of the block at height is the last block we consumed, if that is its parent
+func (c *consumer) followsLastBlock(height uint64) (bool, error) {
+	if c.blockLog == nil || c.lastBlock == nil || c.lastBlock.Height+1 != height {
+		return true, nil
+	}
+	header, err := c.blockHeader(height)
+	if err != nil || header == nil {
+		return false, err
+	}
+	d := new(web3hex.Decoder)
+	parentHash := d.Bytes(header.ParentHash)
+	if d.Err() != nil {
+		return false, fmt.Errorf("could not decode parent hash of block at height %d: %w", height, d.Err())
+	}
+	return bytes.Equal(parentHash, c.lastBlock.Hash), nil
+}
+
+// blockHeader returns the header of the block at height or nil if there is no such block
+func (c *consumer) blockHeader(height uint64) (*ethclient.Block, error) {
+	c.client.Throttle()
+	header, err := c.client.GetBlockByNumber(web3hex.Encoder.Uint64(height))
+	if err != nil {
+		return nil, fmt.Errorf("could not get block at height %d: %w", height, err)
+	}
+	// A chain that has become shorter will not have a block at this height
+	if header == nil || header.Hash == "" {
+		return nil, nil
+	}
+	return header, nil
+}
+
 func (c *consumer) bounds() (start uint64, end uint64, streaming bool, err error) {
 	var latestHeight uint64
 
@@ -118,16 +278,23 @@ func (c *consumer) bounds() (start uint64, end uint64, streaming bool, err error
 		err = fmt.Errorf("could not get latest height: %w", err)
 		return
 	}
+	// Only consume blocks with enough confirmations
+	if latestHeight > c.confirmationDepth {
+		latestHeight -= c.confirmationDepth
+	} else {
+		latestHeight = 0
+	}
 	start, end, streaming = c.blockRange.Bounds(latestHeight)
 
-	if start < c.nextBlockHeight {
+	// After a rewind we must re-consume from the common ancestor even if that is before our original start
+	if start < c.nextBlockHeight || c.rewound {
 		start = c.nextBlockHeight
 	}
 	return
 }
 
 func (c *consumer) handleError(end uint64, err error) error {
-	var rpcError *types.RPCError
+	var rpcError *rpcTypes.RPCError
 	if errors.As(err, &rpcError) {
 		// If we have a custom server error maybe our batch size is too large or maybe we should wait
 		if rpcError.IsServerError() {
@@ -2,10 +2,18 @@ package ethereum
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding/web3hex"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
 	"github.com/hyperledger/burrow/rpc/web3/ethclient"
 	"github.com/hyperledger/burrow/vent/chain"
+	"github.com/hyperledger/burrow/vent/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -34,6 +42,231 @@ func TestConsumer_ConsumeInBatches(t *testing.T) {
 	// TODO
 }
 
+func TestConsumer_Reorg(t *testing.T) {
+	client := newForkingClient("a", 6)
+	blockLog := new(testBlockLog)
+
+	require.NoError(t, consumeLatest(client, blockLog, 0))
+	assert.Equal(t, []string{"1a", "2a", "3a", "4a", "5a", "6a"}, blockLog.consumed())
+
+	// Blocks from 4 are replaced by a longer fork
+	client.fork("b", 4, 7)
+	require.NoError(t, consumeLatest(client, blockLog, 0))
+	assert.Equal(t, []uint64{3}, blockLog.rewinds)
+	assert.Equal(t, []string{"1a", "2a", "3a", "4b", "5b", "6b", "7b"}, blockLog.consumed())
+
+	// Nothing to do if the chain has not reorganised
+	require.NoError(t, consumeLatest(client, blockLog, 0))
+	assert.Equal(t, []uint64{3}, blockLog.rewinds)
+
+	// A shorter fork replacing our last block
+	client.fork("c", 7, 7)
+	require.NoError(t, consumeLatest(client, blockLog, 0))
+	assert.Equal(t, []uint64{3, 6}, blockLog.rewinds)
+	assert.Equal(t, []string{"1a", "2a", "3a", "4b", "5b", "6b", "7c"}, blockLog.consumed())
+}
+
+func TestConsumer_ReorgTooDeep(t *testing.T) {
+	client := newForkingClient("a", 6)
+	blockLog := new(testBlockLog)
+	require.NoError(t, consumeLatest(client, blockLog, 0))
+
+	client.fork("b", 1, 6)
+	err := consumeLatest(client, blockLog, 0)
+	require.Error(t, err)
+	assert.Empty(t, blockLog.rewinds)
+}
+
+func TestConsumer_ReorgWithoutLogs(t *testing.T) {
+	client := newForkingClient("a", 8)
+	// Only the first two blocks have logs
+	client.logHeights = map[uint64]bool{1: true, 2: true}
+	blockLog := new(testBlockLog)
+
+	// The last block scanned in each batch is recorded even though it has no logs
+	require.NoError(t, consumeLatest(client, blockLog, 0))
+	assert.Equal(t, []string{"1a", "2a", "4a", "6a", "8a"}, blockLog.consumed())
+
+	// The fork replaces only blocks without logs
+	client.fork("b", 7, 9)
+	require.NoError(t, consumeLatest(client, blockLog, 0))
+	assert.Equal(t, []uint64{6}, blockLog.rewinds)
+	assert.Equal(t, []string{"1a", "2a", "4a", "6a", "8b", "9b"}, blockLog.consumed())
+}
+
+func TestConsumer_FollowsLastBlock(t *testing.T) {
+	client := newForkingClient("a", 6)
+	c := &consumer{
+		client:    client,
+		blockLog:  new(testBlockLog),
+		lastBlock: &types.BlockRecord{Height: 6, Hash: []byte("6a")},
+	}
+
+	client.fork("b", 7, 8)
+	linked, err := c.followsLastBlock(7)
+	require.NoError(t, err)
+	assert.True(t, linked)
+
+	// Our last block has been replaced so is not the parent of the next
+	client.fork("c", 6, 8)
+	linked, err = c.followsLastBlock(7)
+	require.NoError(t, err)
+	assert.False(t, linked)
+}
+
+func TestConsumer_ConfirmationDepth(t *testing.T) {
+	client := newForkingClient("a", 6)
+	blockLog := new(testBlockLog)
+
+	require.NoError(t, consumeLatest(client, blockLog, 2))
+	assert.Equal(t, []string{"1a", "2a", "3a", "4a"}, blockLog.consumed())
+
+	// A reorganisation shallower than our confirmation depth never reaches us
+	client.fork("b", 5, 8)
+	require.NoError(t, consumeLatest(client, blockLog, 2))
+	assert.Empty(t, blockLog.rewinds)
+	assert.Equal(t, []string{"1a", "2a", "3a", "4a", "5b", "6b"}, blockLog.consumed())
+}
+
+func consumeLatest(client ThrottleClient, blockLog *testBlockLog, confirmationDepth uint64) error {
+	config := &chain.BlockConsumerConfig{
+		BaseBackoffDuration: time.Millisecond,
+		MaxBlockBatchSize:   2,
+		ConfirmationDepth:   confirmationDepth,
+		MaxReorgDepth:       5,
+	}
+	config.Complete()
+	return Consume(client, new(chain.Filter), rpcevents.NewBlockRange(rpcevents.AbsoluteBound(1), rpcevents.LatestBound()),
+		config, blockLog, logging.NewNoopLogger(), blockLog.consume)
+}
+
+// Simulates a chain whose blocks each contain a single log and whose recent blocks can be replaced by a fork
+type forkingClient struct {
+	// Fork label of the block at each height
+	forks []string
+	// If not nil only blocks at these heights contain a log
+	logHeights map[uint64]bool
+}
+
+var _ ThrottleClient = (*forkingClient)(nil)
+
+func newForkingClient(label string, height uint64) *forkingClient {
+	client := &forkingClient{forks: []string{label}}
+	client.fork(label, 1, height)
+	return client
+}
+
+// fork replaces the blocks from height with a new fork up to and including newHeight
+func (fc *forkingClient) fork(label string, height, newHeight uint64) {
+	fc.forks = fc.forks[:height]
+	for h := height; h <= newHeight; h++ {
+		fc.forks = append(fc.forks, label)
+	}
+}
+
+// We use a readable 'hash' identifying the height and fork
+func (fc *forkingClient) blockHash(height uint64) string {
+	return web3hex.Encoder.Bytes([]byte(fmt.Sprintf("%d%s", height, fc.forks[height])))
+}
+
+func (fc *forkingClient) GetLogs(filter *ethclient.Filter) ([]*ethclient.EthLog, error) {
+	start, end, _ := filter.Bounds(fc.head())
+	var logs []*ethclient.EthLog
+	for h := start; h <= end && h <= fc.head(); h++ {
+		if fc.logHeights != nil && !fc.logHeights[h] {
+			continue
+		}
+		logs = append(logs, &ethclient.EthLog{
+			TransactionHash: fc.blockHash(h),
+			BlockHash:       fc.blockHash(h),
+			BlockNumber:     web3hex.Encoder.Uint64(h),
+			LogIndex:        web3hex.Encoder.Uint64(0),
+		})
+	}
+	return logs, nil
+}
+
+func (fc *forkingClient) BlockNumber() (uint64, error) {
+	return fc.head(), nil
+}
+
+func (fc *forkingClient) GetBlockByNumber(height string) (*ethclient.Block, error) {
+	d := new(web3hex.Decoder)
+	h := d.Uint64(height)
+	if h > fc.head() {
+		// As returned for a null result
+		return new(ethclient.Block), d.Err()
+	}
+	block := &ethclient.Block{Number: height, Hash: fc.blockHash(h)}
+	if h > 0 {
+		block.ParentHash = fc.blockHash(h - 1)
+	}
+	return block, d.Err()
+}
+
+func (fc *forkingClient) GetStorageAt(address crypto.Address, key binary.Word256, height string) ([]byte, error) {
+	return nil, nil
+}
+
+func (fc *forkingClient) NetVersion() (string, error) {
+	return "ForkingChain", nil
+}
+
+func (fc *forkingClient) Web3ClientVersion() (string, error) {
+	return "ForkingClient", nil
+}
+
+func (fc *forkingClient) Syncing() (bool, error) {
+	return false, nil
+}
+
+func (fc *forkingClient) Throttle() {}
+
+func (fc *forkingClient) head() uint64 {
+	return uint64(len(fc.forks) - 1)
+}
+
+// Stands in for the projection of consumed blocks
+type testBlockLog struct {
+	blocks  []types.BlockRecord
+	rewinds []uint64
+}
+
+func (bl *testBlockLog) consume(block chain.Block) error {
+	// Batches may overlap by a block
+	bl.truncate(block.GetHeight() - 1)
+	bl.blocks = append(bl.blocks, types.BlockRecord{Height: block.GetHeight(), Hash: block.GetHash()})
+	return nil
+}
+
+func (bl *testBlockLog) consumed() []string {
+	labels := make([]string, len(bl.blocks))
+	for i, block := range bl.blocks {
+		labels[i] = string(block.Hash)
+	}
+	return labels
+}
+
+func (bl *testBlockLog) LastBlocks(limit uint64) ([]types.BlockRecord, error) {
+	var blocks []types.BlockRecord
+	for i := len(bl.blocks) - 1; i >= 0 && uint64(len(blocks)) < limit; i-- {
+		blocks = append(blocks, bl.blocks[i])
+	}
+	return blocks, nil
+}
+
+func (bl *testBlockLog) Rewind(height uint64) error {
+	bl.rewinds = append(bl.rewinds, height)
+	bl.truncate(height)
+	return nil
+}
+
+func (bl *testBlockLog) truncate(height uint64) {
+	for len(bl.blocks) > 0 && bl.blocks[len(bl.blocks)-1].Height > height {
+		bl.blocks = bl.blocks[:len(bl.blocks)-1]
+	}
+}
+
 // From running TestGetEthLogs over in ethclient tests against truffle in vent/test/eth (and subsequently fiddled with)
 // language=JSON
 const ethLogsJSON = `[
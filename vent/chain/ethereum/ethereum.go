@@ -40,6 +40,7 @@ type EthClient interface {
 	GetLogs(filter *ethclient.Filter) ([]*ethclient.EthLog, error)
 	BlockNumber() (uint64, error)
 	GetBlockByNumber(height string) (*ethclient.Block, error)
+	GetStorageAt(address crypto.Address, key binary.Word256, height string) ([]byte, error)
 	NetVersion() (string, error)
 	Web3ClientVersion() (string, error)
 	Syncing() (bool, error)
@@ -82,6 +83,11 @@ func (c *Chain) GetABI(ctx context.Context, address crypto.Address) (string, err
 	return "", nil
 }
 
+func (c *Chain) GetStorage(ctx context.Context, address crypto.Address, key binary.Word256,
+	height uint64) ([]byte, error) {
+	return c.client.GetStorageAt(address, key, web3hex.Encoder.Uint64(height))
+}
+
 func (c *Chain) GetVersion() string {
 	return c.version
 }
@@ -90,8 +96,9 @@ func (c *Chain) GetChainID() string {
 	return c.chainID
 }
 
-func (c *Chain) ConsumeBlocks(ctx context.Context, in *rpcevents.BlockRange, consumer func(chain.Block) error) error {
-	return Consume(c.client, c.filter, in, c.consumerConfig, c.logger, consumer)
+func (c *Chain) ConsumeBlocks(ctx context.Context, in *rpcevents.BlockRange, consumer func(chain.Block) error,
+	blockLog chain.BlockLog) error {
+	return Consume(c.client, c.filter, in, c.consumerConfig, blockLog, c.logger, consumer)
 }
 
 func (c *Chain) Connectivity() connectivity.State {
@@ -111,6 +118,7 @@ func (c *Chain) Close() error {
 type Block struct {
 	client       EthClient
 	Height       uint64
+	Hash         binary.HexBytes
 	Transactions []chain.Transaction
 }
 
@@ -118,6 +126,7 @@ func newBlock(client EthClient, log *Event) *Block {
 	return &Block{
 		client:       client,
 		Height:       log.Height,
+		Hash:         log.BlockHash,
 		Transactions: []chain.Transaction{NewEthereumTransaction(log)},
 	}
 }
@@ -128,6 +137,10 @@ func (b *Block) GetHeight() uint64 {
 	return b.Height
 }
 
+func (b *Block) GetHash() binary.HexBytes {
+	return b.Hash
+}
+
 func (b *Block) GetTxs() []chain.Transaction {
 	return b.Transactions
 }
@@ -191,6 +204,20 @@ func (tx *Transaction) GetEvents() []chain.Event {
 	return tx.Events
 }
 
+func (tx *Transaction) GetAddresses() []crypto.Address {
+	// Without tracing a transaction we only know of the contracts that emitted logs
+	var addresses []crypto.Address
+	for _, ev := range tx.Events {
+		addresses = append(addresses, ev.GetAddress())
+	}
+	return addresses
+}
+
+func (tx *Transaction) GetCalls() []*chain.Call {
+	// Calls are only available by tracing a transaction which is not part of the standard JSON-RPC interface
+	return nil
+}
+
 func (tx *Transaction) GetException() *errors.Exception {
 	// Ethereum does not retain an log from reverted transactions
 	return nil
@@ -220,6 +247,7 @@ type Event struct {
 	// Index of event in transaction
 	Index           uint64
 	TransactionHash binary.HexBytes
+	BlockHash       binary.HexBytes
 }
 
 var _ chain.Event = (*Event)(nil)
@@ -240,6 +268,7 @@ func newEvent(log *ethclient.EthLog) (*Event, error) {
 		Height:          d.Uint64(log.BlockNumber),
 		IndexInBlock:    d.Uint64(log.LogIndex),
 		TransactionHash: txHash,
+		BlockHash:       d.Bytes(log.BlockHash),
 	}, d.Err()
 }
 
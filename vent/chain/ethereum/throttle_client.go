@@ -3,6 +3,8 @@ package ethereum
 import (
 	"time"
 
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/logging"
 
 	"github.com/hyperledger/burrow/rpc/web3/ethclient"
@@ -42,6 +44,11 @@ func (t *throttleClient) GetBlockByNumber(height string) (*ethclient.Block, erro
 	return t.client.GetBlockByNumber(height)
 }
 
+func (t *throttleClient) GetStorageAt(address crypto.Address, key binary.Word256, height string) ([]byte, error) {
+	t.addNow()
+	return t.client.GetStorageAt(address, key, height)
+}
+
 func (t *throttleClient) NetVersion() (string, error) {
 	t.addNow()
 	return t.client.NetVersion()
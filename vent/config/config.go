@@ -27,17 +27,24 @@ type VentConfig struct {
 	SpecOpt        sqlsol.SpecOpt
 	// Announce status every AnnouncePeriod
 	AnnounceEvery time.Duration
+	// Append the rows projected from each block to this JSONL file
+	JSONLFile string
+	// POST the rows projected from each block to this URL
+	WebhookURL string
+	// File in which to checkpoint the last block POSTed to WebhookURL
+	WebhookCheckpointFile string
 }
 
 // DefaultFlags returns a configuration with default values
 func DefaultVentConfig() *VentConfig {
 	return &VentConfig{
-		DBAdapter:         types.PostgresDB,
-		DBURL:             DefaultPostgresDBURL,
-		DBSchema:          "vent",
-		ChainAddress:      "localhost:10997",
-		HTTPListenAddress: "0.0.0.0:8080",
-		SpecOpt:           sqlsol.None,
-		AnnounceEvery:     time.Second * 5,
+		DBAdapter:             types.PostgresDB,
+		DBURL:                 DefaultPostgresDBURL,
+		DBSchema:              "vent",
+		ChainAddress:          "localhost:10997",
+		HTTPListenAddress:     "0.0.0.0:8080",
+		SpecOpt:               sqlsol.None,
+		AnnounceEvery:         time.Second * 5,
+		WebhookCheckpointFile: "vent_webhook_checkpoint.json",
 	}
 }
@@ -12,6 +12,8 @@ import (
 
 type EventSpecGetter func(abi.EventID, crypto.Address) (*abi.EventSpec, error)
 
+type FunctionSpecGetter func(abi.FunctionID, crypto.Address) (*abi.FunctionSpec, error)
+
 // AbiProvider provides a method for loading ABIs from disk, and retrieving them from burrow on-demand
 type AbiProvider struct {
 	abiSpec *abi.Spec
@@ -43,28 +45,57 @@ func NewAbiProvider(paths []string, chain chain.Chain, logger *logging.Logger) (
 func (p *AbiProvider) GetEventAbi(eventID abi.EventID, address crypto.Address) (*abi.EventSpec, error) {
 	evAbi, ok := p.abiSpec.EventsByID[eventID]
 	if !ok {
-		metadata, err := p.chain.GetABI(context.Background(), address)
+		a, err := p.fetchAbi(address, "eventid", eventID.String())
 		if err != nil {
-			p.logger.InfoMsg("Error retrieving abi for event", "address", address.String(), "eventid", eventID.String(), "error", err)
 			return nil, err
 		}
-		if metadata == "" {
-			p.logger.InfoMsg("ABI not found for contract", "address", address.String(), "eventid", eventID.String())
-			return nil, fmt.Errorf("No ABI present for contract at address %v", address)
+		evAbi, ok = a.EventsByID[eventID]
+		if !ok {
+			p.logger.InfoMsg("Event missing from ABI spec for contract", "address", address.String(), "eventid", eventID.String())
+			return nil, fmt.Errorf("Event missing from ABI spec for contract")
 		}
-		a, err := abi.ReadSpec([]byte(metadata))
+	}
+
+	return evAbi, nil
+}
+
+// GetFunctionAbi gets the ABI for the function with selector functionID. If it is not known, it is retrieved from the
+// burrow node via the address for the contract
+func (p *AbiProvider) GetFunctionAbi(functionID abi.FunctionID, address crypto.Address) (*abi.FunctionSpec, error) {
+	fnAbi, err := p.abiSpec.GetFunctionAbi(functionID, address)
+	if err != nil {
+		a, err := p.fetchAbi(address, "functionid", functionID.String())
 		if err != nil {
-			p.logger.InfoMsg("Failed to parse abi", "address", address.String(), "eventid", eventID.String(), "abi", metadata)
 			return nil, err
 		}
-		evAbi, ok = a.EventsByID[eventID]
-		if !ok {
-			p.logger.InfoMsg("Event missing from ABI spec for contract", "address", address.String(), "eventid", eventID.String(), "abi", metadata)
-			return nil, fmt.Errorf("Event missing from ABI spec for contract")
+		fnAbi, err = a.GetFunctionAbi(functionID, address)
+		if err != nil {
+			p.logger.InfoMsg("Function missing from ABI spec for contract", "address", address.String(), "functionid", functionID.String())
+			return nil, fmt.Errorf("Function missing from ABI spec for contract")
 		}
+	}
 
-		p.abiSpec = abi.MergeSpec([]*abi.Spec{p.abiSpec, a})
+	return fnAbi, nil
+}
+
+// fetchAbi retrieves the ABI for the contract at address from the burrow node and merges it with those already known
+func (p *AbiProvider) fetchAbi(address crypto.Address, keyvals ...interface{}) (*abi.Spec, error) {
+	logger := p.logger.With(append([]interface{}{"address", address.String()}, keyvals...)...)
+	metadata, err := p.chain.GetABI(context.Background(), address)
+	if err != nil {
+		logger.InfoMsg("Error retrieving abi", "error", err)
+		return nil, err
+	}
+	if metadata == "" {
+		logger.InfoMsg("ABI not found for contract")
+		return nil, fmt.Errorf("No ABI present for contract at address %v", address)
+	}
+	a, err := abi.ReadSpec([]byte(metadata))
+	if err != nil {
+		logger.InfoMsg("Failed to parse abi", "abi", metadata)
+		return nil, err
 	}
 
-	return evAbi, nil
+	p.abiSpec = abi.MergeSpec([]*abi.Spec{p.abiSpec, a})
+	return a, nil
 }
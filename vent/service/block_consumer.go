@@ -1,9 +1,11 @@
 package service
 
 import (
+	"context"
 	"io"
 
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
@@ -14,9 +16,12 @@ import (
 )
 
 func NewBlockConsumer(chainID string, projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEventSpec EventSpecGetter,
-	eventCh chan<- types.EventData, doneCh chan struct{}, logger *logging.Logger) func(block chain.Block) error {
+	getFunctionSpec FunctionSpecGetter, getStorage StorageGetter, eventCh chan<- types.EventData,
+	doneCh chan struct{}, logger *logging.Logger) func(block chain.Block) error {
 
 	logger = logger.WithScope("makeBlockConsumer")
+	storageClasses := storageClasses(projection)
+	callClasses := callClasses(projection)
 
 	var blockHeight uint64
 
@@ -35,6 +40,9 @@ func NewBlockConsumer(chainID string, projection *sqlsol.Projection, opt sqlsol.
 
 		// create a fresh new structure to store block data at this height
 		blockData := sqlsol.NewBlockData(blockHeight)
+		blockData.Data.BlockHash = block.GetHash()
+		// storage to re-read once all transactions in the block have been seen
+		storageRefreshes := make(map[*storageClass]*storageRefresh)
 
 		if opt.Enabled(sqlsol.Block) {
 			blkRawData, err := buildBlkData(projection.Tables, block)
@@ -71,6 +79,61 @@ func NewBlockConsumer(chainID string, projection *sqlsol.Projection, opt sqlsol.
 					}
 				}
 
+				for _, call := range txe.GetCalls() {
+					if len(call.Input) < abi.FunctionIDSize {
+						continue
+					}
+					var functionID abi.FunctionID
+					copy(functionID[:], call.Input)
+					for _, cc := range callClasses {
+						if cc.address != call.Callee {
+							continue
+						}
+						fnAbi, err := getFunctionSpec(functionID, call.Callee)
+						if err != nil {
+							if cc.bySelector(functionID) {
+								return errors.Wrapf(err, "could not get ABI for function matching projection "+
+									"selector %s at address %v", cc.Call.Function, call.Callee)
+							}
+							logger.InfoMsg("could not get ABI for function",
+								structure.ErrorKey, err,
+								"function_id", functionID,
+								"address", call.Callee)
+							continue
+						}
+						if !cc.matches(fnAbi) {
+							continue
+						}
+
+						logger.InfoMsg("Matched call", "function_id", functionID, "function", fnAbi.Name)
+
+						callData, err := buildCallData(projection, cc.EventClass, call, txOrigin, txe.GetHash(), fnAbi,
+							logger)
+						if err != nil {
+							// Anyone can send a call with the right selector but malformed arguments (and some
+							// contracts return data their ABI does not describe) so we cannot let it halt Vent
+							logger.InfoMsg("could not decode matched call, skipping",
+								structure.ErrorKey, err,
+								"function", fnAbi.Name,
+								"address", call.Callee,
+								"tx_hash", txe.GetHash())
+							continue
+						}
+						blockData.AddRow(cc.TableName, callData)
+					}
+				}
+
+				for _, address := range txe.GetAddresses() {
+					for _, sc := range storageClasses {
+						if sc.address == address {
+							if storageRefreshes[sc] == nil {
+								storageRefreshes[sc] = newStorageRefresh(blockHeight)
+							}
+							storageRefreshes[sc].touch(txOrigin, txe.GetHash())
+						}
+					}
+				}
+
 				for _, event := range events {
 					var tagged query.Tagged = event
 					eventID := exec.SolidityEventID(event.GetTopics())
@@ -83,10 +146,27 @@ func NewBlockConsumer(chainID string, projection *sqlsol.Projection, opt sqlsol.
 					} else {
 						// Since we have the event ABI we will allow matching on ABI fields
 						tagged = query.TagsFor(event, query.TaggedPrefix("Event", eventSpec))
+
+						// collect the mapping keys to re-read for contracts projected from storage
+						for _, sc := range storageClasses {
+							refresh := storageRefreshes[sc]
+							if refresh == nil || sc.address != event.GetAddress() || len(sc.Storage.KeysFrom) == 0 {
+								continue
+							}
+							decodedData, err := decodeEvent(event, txOrigin, eventSpec)
+							if err != nil {
+								return errors.Wrapf(err, "Error decoding event for keys of storage mapping %s",
+									sc.Storage.Mapping)
+							}
+							sc.addEventKeys(refresh, decodedData)
+						}
 					}
 
 					// see which spec filter matches with the one in event data
 					for _, eventClass := range projection.Spec {
+						if !eventClass.MatchesEvents() {
+							continue
+						}
 						qry, err := eventClass.Query()
 
 						if err != nil {
@@ -117,6 +197,20 @@ func NewBlockConsumer(chainID string, projection *sqlsol.Projection, opt sqlsol.
 			}
 		}
 
+		for _, sc := range storageClasses {
+			refresh := storageRefreshes[sc]
+			if refresh == nil {
+				continue
+			}
+			rows, err := buildStorageRows(context.Background(), projection, sc, refresh, getStorage, logger)
+			if err != nil {
+				return errors.Wrapf(err, "Error building storage data")
+			}
+			for _, row := range rows {
+				blockData.AddRow(sc.TableName, row)
+			}
+		}
+
 		// upsert rows in specific SQL event tables and update block number
 		// store block data in SQL tables (if any)
 		for name, rows := range blockData.Data.Tables {
@@ -83,7 +83,7 @@ func TestBlockConsumer(t *testing.T) {
 			},
 		})
 		require.NoError(t, err)
-		blockConsumer := NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer := NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, nil, nil, eventCh, doneCh, logger)
 		tables, err := consumeBlock(blockConsumer, eventCh, log)
 		require.NoError(t, err)
 		rows := tables[tableName]
@@ -108,7 +108,7 @@ func TestBlockConsumer(t *testing.T) {
 			},
 		})
 		require.NoError(t, err)
-		blockConsumer := NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer := NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, nil, nil, eventCh, doneCh, logger)
 		_, err = consumeBlock(blockConsumer, eventCh, log)
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "could not find ABI")
@@ -131,7 +131,7 @@ func TestBlockConsumer(t *testing.T) {
 			},
 		})
 		require.NoError(t, err)
-		blockConsumer := NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer := NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, nil, nil, eventCh, doneCh, logger)
 		table, err := consumeBlock(blockConsumer, eventCh, log)
 		require.Len(t, table, 0, "should match no event")
 	})
@@ -155,7 +155,7 @@ func TestBlockConsumer(t *testing.T) {
 		spec, err := abi.ReadSpec(solidity.Abi_EventEmitter)
 		require.NoError(t, err)
 
-		blockConsumer := NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer := NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, nil, nil, eventCh, doneCh, logger)
 		table, err := consumeBlock(blockConsumer, eventCh, log)
 		// Check matches
 		require.NoError(t, err)
@@ -163,7 +163,7 @@ func TestBlockConsumer(t *testing.T) {
 		require.Len(t, table[tableName], 1)
 		// Now Remove the ABI - should not match the event
 		delete(spec.EventsByID, manyTypesEventSpec.ID)
-		blockConsumer = NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer = NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, nil, nil, eventCh, doneCh, logger)
 		table, err = consumeBlock(blockConsumer, eventCh, log)
 		require.NoError(t, err)
 		require.Len(t, table, 0, "should match no events")
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"io"
+
+	"github.com/hyperledger/burrow/vent/chain"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// blockLog gives a chain consumer access to the blocks that have been committed to the database. Since blocks are
+// committed asynchronously by the consumer's main loop, requests are run on that loop so that they see (and rewind)
+// every block consumed before them.
+type blockLog struct {
+	consumer *Consumer
+	tables   types.EventTables
+	requests chan func()
+}
+
+var _ chain.BlockLog = (*blockLog)(nil)
+
+func newBlockLog(consumer *Consumer, tables types.EventTables) *blockLog {
+	return &blockLog{
+		consumer: consumer,
+		tables:   tables,
+		requests: make(chan func()),
+	}
+}
+
+func (bl *blockLog) LastBlocks(limit uint64) ([]types.BlockRecord, error) {
+	var blocks []types.BlockRecord
+	err := bl.run(func() (err error) {
+		blocks, err = bl.consumer.DB.LastBlocks(bl.consumer.Chain.GetChainID(), limit)
+		return
+	})
+	return blocks, err
+}
+
+func (bl *blockLog) Rewind(height uint64) error {
+	return bl.run(func() error {
+		return bl.consumer.rewindBlocks(bl.tables, height)
+	})
+}
+
+func (bl *blockLog) run(request func() error) error {
+	errCh := make(chan error, 1)
+	select {
+	case bl.requests <- func() { errCh <- request() }:
+		return <-errCh
+	case <-bl.consumer.Done:
+		return io.EOF
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/vent/sqlsol"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// callClass is an EventClass projected from calls to a contract function
+type callClass struct {
+	*types.EventClass
+	address crypto.Address
+}
+
+func callClasses(projection *sqlsol.Projection) []*callClass {
+	var classes []*callClass
+	for _, eventClass := range projection.Spec {
+		if eventClass.Call != nil {
+			classes = append(classes, &callClass{
+				EventClass: eventClass,
+				// Validated by NewProjection
+				address: crypto.MustAddressFromHexString(eventClass.Call.Address),
+			})
+		}
+	}
+	return classes
+}
+
+// bySelector returns whether the function is given by its selector rather than its name
+func (cc *callClass) bySelector(functionID abi.FunctionID) bool {
+	return strings.EqualFold(strings.TrimPrefix(cc.Call.Function, "0x"), functionID.String())
+}
+
+func (cc *callClass) matches(fnAbi *abi.FunctionSpec) bool {
+	return cc.Call.Function == fnAbi.Name || cc.bySelector(fnAbi.FunctionID)
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/solidity"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/chain/burrow"
+	"github.com/hyperledger/burrow/vent/sqlsol"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestCallProjection(t *testing.T) {
+	spec, err := abi.ReadSpec(solidity.Abi_DelegateProxy)
+	require.NoError(t, err)
+	proxy := engine.AddressFromName("DelegateProxy")
+	other := engine.AddressFromName("Other")
+	user := engine.AddressFromName("user")
+	delegate := engine.AddressFromName("delegate")
+
+	projection, err := sqlsol.NewProjection(types.ProjectionSpec{
+		{
+			TableName: "Delegations",
+			Call: &types.CallSource{
+				Address:  proxy.String(),
+				Function: spec.Functions["setDelegate"].FunctionID.String(),
+			},
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: "_proxied", Type: "address", ColumnName: "delegate"},
+				{Field: types.CallerLabel, Type: "address", ColumnName: "caller"},
+				{Field: types.CallValueLabel, Type: "uint256", ColumnName: "value"},
+			},
+		},
+		{
+			TableName: "DelegateLookups",
+			Call: &types.CallSource{
+				Address:  proxy.String(),
+				Function: "getDelegate",
+			},
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: "output0", Type: "address", ColumnName: "delegate"},
+				{Field: types.CallerLabel, Type: "address", ColumnName: "caller"},
+				{Field: types.StackDepthLabel, Type: "uint64", ColumnName: "depth"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	setDelegate, _, err := spec.Pack("setDelegate", delegate)
+	require.NoError(t, err)
+	getDelegate, _, err := spec.Pack("getDelegate")
+	require.NoError(t, err)
+	getDelegateReturn, err := abi.Pack(spec.Functions["getDelegate"].Outputs, delegate)
+	require.NoError(t, err)
+
+	txe := &exec.TxExecution{TxHeader: &exec.TxHeader{}}
+	addCall := func(caller, callee crypto.Address, input, output []byte, depth uint64, exception *errors.Exception) {
+		require.NoError(t, txe.Call(&exec.CallEvent{
+			CallData: &exec.CallData{
+				Caller: caller,
+				Callee: callee,
+				Data:   input,
+				Value:  big.NewInt(3).Bytes(),
+			},
+			Return:     output,
+			StackDepth: depth,
+		}, exception))
+	}
+	addCall(user, proxy, setDelegate, nil, 0, nil)
+	// An internal call from another contract
+	addCall(other, proxy, getDelegate, getDelegateReturn, 1, nil)
+	// A call to another contract with the same selector
+	addCall(user, other, setDelegate, nil, 0, nil)
+	// A reverted call
+	addCall(user, proxy, setDelegate, nil, 0, errors.Errorf(errors.Codes.ExecutionReverted, "reverted"))
+
+	eventCh := make(chan types.EventData, 1)
+	blockConsumer := NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, spec.GetFunctionAbi, nil,
+		eventCh, make(chan struct{}), logging.NewNoopLogger())
+	block := &exec.BlockExecution{Height: 7, Header: &tmproto.Header{}}
+	block.AppendTxs(txe)
+	require.NoError(t, blockConsumer(burrow.NewBurrowBlock(block)))
+	tables := (<-eventCh).Tables
+
+	require.Len(t, tables["Delegations"], 1)
+	row := tables["Delegations"][0].RowData
+	assert.Equal(t, delegate.String(), row["delegate"])
+	assert.Equal(t, user.String(), row["caller"])
+	assert.Equal(t, "3", row["value"])
+	assert.Equal(t, "7", row[columns.Height])
+	assert.Equal(t, "0", row[columns.EventIndex])
+	assert.Equal(t, "setDelegate", row[columns.EventName])
+	assert.Equal(t, types.CallEventType, row[columns.EventType])
+
+	require.Len(t, tables["DelegateLookups"], 1)
+	row = tables["DelegateLookups"][0].RowData
+	assert.Equal(t, delegate.String(), row["delegate"])
+	assert.Equal(t, other.String(), row["caller"])
+	assert.Equal(t, "1", row["depth"])
+	assert.Equal(t, "1", row[columns.EventIndex])
+}
+
+func TestCallProjection_Undecodable(t *testing.T) {
+	spec, err := abi.ReadSpec([]byte(`[
+		{"type": "function", "name": "set", "inputs": [{"name": "", "type": "uint256"}], "outputs": []},
+		{"type": "function", "name": "get", "inputs": [], "outputs": [{"name": "", "type": "uint256"}]}
+	]`))
+	require.NoError(t, err)
+	callee := engine.AddressFromName("Callee")
+	user := engine.AddressFromName("user")
+
+	projection, err := sqlsol.NewProjection(types.ProjectionSpec{
+		{
+			TableName: "Sets",
+			Call:      &types.CallSource{Address: callee.String(), Function: "set"},
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: "input0", Type: "uint256", ColumnName: "value"},
+			},
+		},
+		{
+			TableName: "Gets",
+			Call:      &types.CallSource{Address: callee.String(), Function: "get"},
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: "output0", Type: "uint256", ColumnName: "value"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	set, _, err := spec.Pack("set", 42)
+	require.NoError(t, err)
+	get, _, err := spec.Pack("get")
+	require.NoError(t, err)
+
+	txe := &exec.TxExecution{TxHeader: &exec.TxHeader{}}
+	addCall := func(input, output []byte) {
+		require.NoError(t, txe.Call(&exec.CallEvent{
+			CallData: &exec.CallData{Caller: user, Callee: callee, Data: input},
+			Return:   output,
+		}, nil))
+	}
+	addCall(set, nil)
+	// Truncated arguments
+	addCall(set[:abi.FunctionIDSize+3], nil)
+	// Return data the ABI does not describe
+	addCall(get, []byte{1, 2, 3})
+
+	eventCh := make(chan types.EventData, 1)
+	blockConsumer := NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, spec.GetFunctionAbi, nil,
+		eventCh, make(chan struct{}), logging.NewNoopLogger())
+	block := &exec.BlockExecution{Height: 3, Header: &tmproto.Header{}}
+	block.AppendTxs(txe)
+	require.NoError(t, blockConsumer(burrow.NewBurrowBlock(block)))
+	tables := (<-eventCh).Tables
+
+	require.Len(t, tables["Sets"], 1)
+	assert.Equal(t, "42", tables["Sets"][0].RowData["value"])
+	assert.Empty(t, tables["Gets"])
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hyperledger/burrow/vent/chain/burrow"
 	"github.com/hyperledger/burrow/vent/chain/ethereum"
 	"github.com/hyperledger/burrow/vent/config"
+	"github.com/hyperledger/burrow/vent/sink"
 	"github.com/hyperledger/burrow/vent/sqldb"
 	"github.com/hyperledger/burrow/vent/sqlsol"
 	"github.com/hyperledger/burrow/vent/types"
@@ -30,11 +31,14 @@ type Consumer struct {
 	Logger *logging.Logger
 	DB     *sqldb.SQLDB
 	Chain  chain.Chain
+	// Sinks other than the database to which projected rows are committed, in addition to those configured
+	Sinks []sink.Sink
 	// external events channel used for when vent is leveraged as a library
 	EventsChannel       chan types.EventData
 	Done                chan struct{}
 	shutdownOnce        sync.Once
 	LastProcessedHeight uint64
+	sinks               []*committedSink
 }
 
 // NewConsumer constructs a new consumer configuration.
@@ -102,10 +106,30 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 		return errors.Wrap(err, "Error trying to synchronize database")
 	}
 
+	configuredSinks, err := c.openSinks()
+	if err != nil {
+		return err
+	}
+	for _, s := range configuredSinks {
+		defer s.Close()
+	}
+
+	c.Logger.InfoMsg("Getting last processed block number from SQL log table and sinks")
+
+	c.sinks = nil
+	for _, s := range append(append([]sink.Sink{c.DB}, c.Sinks...), configuredSinks...) {
+		cs, err := newCommittedSink(c.Chain.GetChainID(), s)
+		if err != nil {
+			return errors.Wrapf(err, "Error trying to get last processed block number")
+		}
+		c.sinks = append(c.sinks, cs)
+	}
+
 	// doneCh is used for sending a "done" signal from each goroutine to the main thread
 	// eventCh is used for sending received events to the main thread to be stored in the db
 	errCh := make(chan error, 1)
 	eventCh := make(chan types.EventData)
+	blockLog := newBlockLog(c, projection.Tables)
 
 	go func() {
 		defer func() {
@@ -113,15 +137,13 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 		}()
 		go c.announceEvery(c.Done)
 
-		c.Logger.InfoMsg("Getting last processed block number from SQL log table")
-
-		fromBlock, err := c.DB.LastBlockHeight(c.Chain.GetChainID())
-		if err != nil {
-			errCh <- errors.Wrapf(err, "Error trying to get last processed block number")
-			return
+		// Resume from the sink that is furthest behind
+		startingBlock := c.sinks[0].height
+		for _, cs := range c.sinks[1:] {
+			if cs.height < startingBlock {
+				startingBlock = cs.height
+			}
 		}
-
-		startingBlock := fromBlock
 		// Start the block after the last one successfully committed - apart from if this is the first block
 		// We include block 0 because it is where we currently place dump/restored transactions
 		if startingBlock > 0 {
@@ -149,9 +171,9 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 
 		// gets blocks in given range based on last processed block taken from database
 		consumer := NewBlockConsumer(c.Chain.GetChainID(), projection, c.Config.SpecOpt, abiProvider.GetEventAbi,
-			eventCh, c.Done, c.Logger)
+			abiProvider.GetFunctionAbi, c.Chain.GetStorage, eventCh, c.Done, c.Logger)
 
-		err = c.Chain.ConsumeBlocks(context.Background(), request.BlockRange, consumer)
+		err = c.Chain.ConsumeBlocks(context.Background(), request.BlockRange, consumer, blockLog)
 
 		if err != nil {
 			if err == io.EOF {
@@ -178,6 +200,10 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 				return err
 			}
 
+		// Access the block log in turn with block commits
+		case request := <-blockLog.requests:
+			request()
+
 		// Await completion
 		case <-c.Done:
 			select {
@@ -197,9 +223,14 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 }
 
 func (c *Consumer) commitBlock(projection *sqlsol.Projection, blockEvents types.EventData) error {
-	// upsert rows in specific SQL event tables and update block number
-	if err := c.DB.SetBlock(c.Chain.GetChainID(), projection.Tables, blockEvents); err != nil {
-		return fmt.Errorf("error upserting rows in database: %v", err)
+	// upsert rows in specific SQL event tables and update block number, then commit to any other sinks
+	for i, cs := range c.sinks {
+		if err := cs.setBlock(c.Chain.GetChainID(), projection.Tables, blockEvents); err != nil {
+			if i == 0 {
+				return fmt.Errorf("error upserting rows in database: %v", err)
+			}
+			return fmt.Errorf("error committing rows to sink: %v", err)
+		}
 	}
 
 	// send to the external events channel in a non-blocking manner
@@ -210,6 +241,15 @@ func (c *Consumer) commitBlock(projection *sqlsol.Projection, blockEvents types.
 	return nil
 }
 
+func (c *Consumer) rewindBlocks(eventTables types.EventTables, height uint64) error {
+	err := rewindSinks(c.Chain.GetChainID(), c.sinks, eventTables, height)
+	if err != nil {
+		return err
+	}
+	c.LastProcessedHeight = height
+	return nil
+}
+
 // Health returns the health status for the consumer
 func (c *Consumer) Health() error {
 	if finished(c.Done) {
@@ -118,3 +118,7 @@ func TestPostgresConsumer(t *testing.T) {
 		})
 	})
 }
+
+func TestPostgresQuery(t *testing.T) {
+	testQuery(t, test.PostgresVentConfig(""))
+}
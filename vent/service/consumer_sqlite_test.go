@@ -41,3 +41,7 @@ func TestSqliteConsumer(t *testing.T) {
 		})
 	})
 }
+
+func TestSqliteQuery(t *testing.T) {
+	testQuery(t, test.SqliteVentConfig(""))
+}
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"strconv"
 
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/exec"
@@ -36,17 +37,76 @@ func decodeEvent(log chain.Event, txOrigin *chain.Origin, evAbi *abi.EventSpec)
 
 	// for each decoded item value, stores it in given item name
 	for i, input := range evAbi.Inputs {
-		switch v := unpackedData[i].(type) {
-		case *crypto.Address:
-			data[input.Name] = v.String()
-		case *big.Int:
-			data[input.Name] = v.String()
-		case *string:
-			data[input.Name] = *v
-		default:
-			data[input.Name] = v
+		data[input.Name] = decodedValue(input.EVM, unpackedData[i])
+	}
+
+	return data, nil
+}
+
+// decodeCall decodes the inputs and outputs of a call to a function
+func decodeCall(call *chain.Call, txOrigin *chain.Origin, txHash binary.HexBytes,
+	fnAbi *abi.FunctionSpec) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+
+	data[types.EventNameLabel] = fnAbi.Name
+	data[types.ChainIDLabel] = txOrigin.ChainID
+	data[types.BlockHeightLabel] = strconv.FormatUint(txOrigin.Height, 10)
+	data[types.TxIndexLabel] = strconv.FormatUint(txOrigin.Index, 10)
+	data[types.EventIndexLabel] = strconv.FormatUint(call.Index, 10)
+	data[types.EventTypeLabel] = types.CallEventType
+	data[types.TxTxHashLabel] = txHash.String()
+	data[types.CallerLabel] = call.Caller.String()
+	data[types.CalleeLabel] = call.Callee.String()
+	data[types.CallValueLabel] = call.Value.String()
+	data[types.StackDepthLabel] = strconv.FormatUint(call.StackDepth, 10)
+
+	if len(fnAbi.Inputs) > 0 {
+		inputs := abi.GetPackingTypes(fnAbi.Inputs)
+		if err := abi.Unpack(fnAbi.Inputs, call.Input[abi.FunctionIDSize:], inputs...); err != nil {
+			return nil, errors.Wrap(err, "Could not unpack call inputs")
+		}
+		for i, input := range fnAbi.Inputs {
+			name := input.Name
+			if name == "" {
+				name = "input" + strconv.Itoa(i)
+			}
+			data[name] = decodedValue(input.EVM, inputs[i])
+		}
+	}
+
+	if len(fnAbi.Outputs) > 0 {
+		outputs := abi.GetPackingTypes(fnAbi.Outputs)
+		if err := abi.Unpack(fnAbi.Outputs, call.Return, outputs...); err != nil {
+			return nil, errors.Wrap(err, "Could not unpack call outputs")
+		}
+		for i, output := range fnAbi.Outputs {
+			name := output.Name
+			if name == "" {
+				name = "output" + strconv.Itoa(i)
+			}
+			data[name] = decodedValue(output.EVM, outputs[i])
 		}
 	}
 
 	return data, nil
 }
+
+// decodedValue converts a value unpacked by the ABI decoder into the form in which it is projected
+func decodedValue(evmType abi.EVMType, value interface{}) interface{} {
+	switch v := value.(type) {
+	case *crypto.Address:
+		return v.String()
+	case *big.Int:
+		return v.String()
+	case *big.Rat:
+		// Decimal with the number of places of the fixed-point type
+		if fixed, ok := evmType.(abi.EVMFixed); ok {
+			return v.FloatString(int(fixed.N))
+		}
+		return v.RatString()
+	case *string:
+		return *v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/vent/sqlsol"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+const (
+	// ProjectionsPath is the root of the read-only query API over the projection tables
+	ProjectionsPath = "/projections"
+	// DefaultQueryLimit is the number of rows returned when a query does not specify a limit
+	DefaultQueryLimit = 100
+	// MaxQueryLimit is the largest number of rows that may be requested in one query
+	MaxQueryLimit = 1000
+
+	queryOrderParam  = "order"
+	queryLimitParam  = "limit"
+	queryOffsetParam = "offset"
+)
+
+// TableDescription describes a projection table and the columns that may be used to filter and order it
+type TableDescription struct {
+	Name    string
+	Columns []ColumnDescription
+}
+
+// ColumnDescription describes a column of a projection table
+type ColumnDescription struct {
+	Name    string
+	Type    string
+	Primary bool `json:",omitempty"`
+}
+
+// projectionsHandler lists the projection tables
+func projectionsHandler(projection *sqlsol.Projection) func(resp http.ResponseWriter, req *http.Request) {
+	return func(resp http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(resp, "only GET is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		tables := make([]TableDescription, 0, len(projection.Tables))
+		for _, table := range projection.Tables {
+			tables = append(tables, describeTable(table))
+		}
+		sort.Slice(tables, func(i, j int) bool {
+			return tables[i].Name < tables[j].Name
+		})
+		writeJSON(resp, tables)
+	}
+}
+
+// tableHandler returns rows from a single projection table at /projections/<table>. Query parameters of the form
+// <column>=<value> or <column>.<operator>=<value> filter rows, 'order' sets the column to order by (prefixed with '-'
+// for descending order), and 'limit' and 'offset' page through the results.
+func tableHandler(consumer *Consumer, projection *sqlsol.Projection) func(resp http.ResponseWriter, req *http.Request) {
+	return func(resp http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(resp, "only GET is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		tableName := strings.TrimPrefix(req.URL.Path, ProjectionsPath+"/")
+		table, ok := projection.Tables[tableName]
+		if !ok {
+			http.Error(resp, fmt.Sprintf("no projection table '%s'", tableName), http.StatusNotFound)
+			return
+		}
+		query, err := parseTableQuery(table, req)
+		if err != nil {
+			http.Error(resp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		db := consumer.DB
+		if db == nil || finished(consumer.Done) {
+			http.Error(resp, "database unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		rows, err := db.QueryTable(table, query)
+		if err != nil {
+			consumer.Logger.InfoMsg("Error querying projection table", "table", tableName, "err", err)
+			http.Error(resp, "could not query projection table", http.StatusInternalServerError)
+			return
+		}
+		writeJSON(resp, rows)
+	}
+}
+
+func parseTableQuery(table *types.SQLTable, req *http.Request) (types.TableQuery, error) {
+	query := types.TableQuery{
+		Limit: DefaultQueryLimit,
+	}
+	for key, values := range req.URL.Query() {
+		for _, value := range values {
+			var err error
+			switch key {
+			case queryOrderParam:
+				query.OrderBy = strings.TrimPrefix(value, "-")
+				query.Descending = query.OrderBy != value
+				if table.GetColumn(query.OrderBy) == nil {
+					return query, fmt.Errorf("cannot order by unknown column '%s'", query.OrderBy)
+				}
+			case queryLimitParam:
+				query.Limit, err = strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					return query, fmt.Errorf("could not parse limit: %v", err)
+				}
+				if query.Limit > MaxQueryLimit {
+					return query, fmt.Errorf("limit %d exceeds maximum of %d", query.Limit, MaxQueryLimit)
+				}
+			case queryOffsetParam:
+				query.Offset, err = strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					return query, fmt.Errorf("could not parse offset: %v", err)
+				}
+			default:
+				filter, err := parseQueryFilter(table, key, value)
+				if err != nil {
+					return query, err
+				}
+				query.Filters = append(query.Filters, filter)
+			}
+		}
+	}
+	return query, nil
+}
+
+func parseQueryFilter(table *types.SQLTable, key, value string) (types.QueryFilter, error) {
+	filter := types.QueryFilter{
+		Column:   key,
+		Operator: types.QueryOperatorEqual,
+	}
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		filter.Column = key[:i]
+		filter.Operator = types.QueryOperator(key[i+1:])
+		if _, err := filter.Operator.SQL(); err != nil {
+			return filter, err
+		}
+	}
+	column := table.GetColumn(filter.Column)
+	if column == nil {
+		return filter, fmt.Errorf("cannot filter on unknown column '%s'", filter.Column)
+	}
+	var err error
+	switch column.Type {
+	case types.SQLColumnTypeInt, types.SQLColumnTypeBigInt, types.SQLColumnTypeSerial:
+		filter.Value, err = strconv.ParseInt(value, 10, 64)
+	case types.SQLColumnTypeBool:
+		filter.Value, err = strconv.ParseBool(value)
+	case types.SQLColumnTypeByteA:
+		filter.Value, err = hex.DecodeString(value)
+	default:
+		filter.Value = value
+	}
+	if err != nil {
+		return filter, fmt.Errorf("could not parse value for column '%s': %v", filter.Column, err)
+	}
+	return filter, nil
+}
+
+func describeTable(table *types.SQLTable) TableDescription {
+	description := TableDescription{
+		Name:    table.Name,
+		Columns: make([]ColumnDescription, len(table.Columns)),
+	}
+	for i, column := range table.Columns {
+		description.Columns[i] = ColumnDescription{
+			Name:    column.Name,
+			Type:    column.Type.String(),
+			Primary: column.Primary,
+		}
+	}
+	return description
+}
+
+func writeJSON(resp http.ResponseWriter, value interface{}) {
+	bs, err := json.Marshal(value)
+	if err != nil {
+		http.Error(resp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Write(bs)
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"unicode/utf8"
 
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/vent/chain"
@@ -19,9 +20,6 @@ import (
 func buildEventData(projection *sqlsol.Projection, eventClass *types.EventClass, event chain.Event,
 	txOrigin *chain.Origin, evAbi *abi.EventSpec, logger *logging.Logger) (types.EventDataRow, error) {
 
-	// a fresh new row to store column/value data
-	row := make(map[string]interface{})
-
 	// decode event data using the provided abi specification
 	decodedData, err := decodeEvent(event, txOrigin, evAbi)
 	if err != nil {
@@ -30,6 +28,30 @@ func buildEventData(projection *sqlsol.Projection, eventClass *types.EventClass,
 
 	logger.InfoMsg("Decoded event", decodedData)
 
+	return buildRow(projection, eventClass, decodedData, logger), nil
+}
+
+// buildCallData builds a row from a call to a function
+func buildCallData(projection *sqlsol.Projection, eventClass *types.EventClass, call *chain.Call,
+	txOrigin *chain.Origin, txHash binary.HexBytes, fnAbi *abi.FunctionSpec,
+	logger *logging.Logger) (types.EventDataRow, error) {
+
+	decodedData, err := decodeCall(call, txOrigin, txHash, fnAbi)
+	if err != nil {
+		return types.EventDataRow{}, errors.Wrapf(err, "Error decoding call to %s", fnAbi.Name)
+	}
+
+	logger.InfoMsg("Decoded call", decodedData)
+
+	return buildRow(projection, eventClass, decodedData, logger), nil
+}
+
+// buildRow maps decoded fields to the columns of the eventClass table
+func buildRow(projection *sqlsol.Projection, eventClass *types.EventClass, decodedData map[string]interface{},
+	logger *logging.Logger) types.EventDataRow {
+	// a fresh new row to store column/value data
+	row := make(map[string]interface{})
+
 	rowAction := types.ActionUpsert
 
 	// for each data element, maps to SQL columnName and gets its value
@@ -60,7 +82,7 @@ func buildEventData(projection *sqlsol.Projection, eventClass *types.EventClass,
 		}
 	}
 
-	return types.EventDataRow{Action: rowAction, RowData: row, EventClass: eventClass}, nil
+	return types.EventDataRow{Action: rowAction, RowData: row, EventClass: eventClass}
 }
 
 func buildBlkData(tbls types.EventTables, block chain.Block) (types.EventDataRow, error) {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/vent/config"
+	"github.com/hyperledger/burrow/vent/sqlsol"
 )
 
 // Server exposes HTTP endpoints for the service
@@ -18,12 +19,16 @@ type Server struct {
 	stopCh   chan bool
 }
 
-// NewServer returns a new HTTP server
-func NewServer(cfg *config.VentConfig, log *logging.Logger, consumer *Consumer) *Server {
+// NewServer returns a new HTTP server. If projection is non-nil its tables are served read-only under /projections
+func NewServer(cfg *config.VentConfig, log *logging.Logger, consumer *Consumer, projection *sqlsol.Projection) *Server {
 	// setup handlers
 	mux := http.NewServeMux()
 
 	mux.HandleFunc("/health", healthHandler(consumer))
+	if projection != nil {
+		mux.HandleFunc(ProjectionsPath, projectionsHandler(projection))
+		mux.HandleFunc(ProjectionsPath+"/", tableHandler(consumer, projection))
+	}
 
 	return &Server{
 		Config:   cfg,
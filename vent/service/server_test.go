@@ -3,6 +3,7 @@
 package service_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -19,6 +20,7 @@ import (
 	"github.com/hyperledger/burrow/vent/sqlsol"
 	"github.com/hyperledger/burrow/vent/test"
 	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -57,7 +59,7 @@ func TestServer(t *testing.T) {
 			time.Sleep(2 * time.Second)
 
 			// setup test server
-			server := service.NewServer(cfg, log, consumer)
+			server := service.NewServer(cfg, log, consumer, projection)
 
 			httpServer := httptest.NewServer(server)
 			defer httpServer.Close()
@@ -80,3 +82,79 @@ func TestServer(t *testing.T) {
 		})
 	})
 }
+
+func testQuery(t *testing.T, cfg *config.VentConfig) {
+	db, closeDB := test.NewTestDB(t, cfg)
+	defer closeDB()
+
+	projection, err := sqlsol.NewProjection(types.ProjectionSpec{
+		{
+			TableName: "Things",
+			Filter:    "EventType = 'LogEvent'",
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: "name", ColumnName: "name", Type: "string", Primary: true},
+				{Field: "size", ColumnName: "size", Type: "uint32"},
+				{Field: "shiny", ColumnName: "shiny", Type: "bool"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.SynchronizeDB(test.ChainID, projection.Tables))
+
+	thing := func(name string, size int, shiny bool) types.EventDataRow {
+		return types.EventDataRow{Action: types.ActionUpsert, RowData: map[string]interface{}{
+			"_chainid": test.ChainID, "_height": 1, "name": name, "size": size, "shiny": shiny}}
+	}
+	err = db.SetBlock(test.ChainID, projection.Tables, types.EventData{BlockHeight: 1,
+		Tables: map[string]types.EventDataTable{"Things": {
+			thing("apple", 3, true), thing("brick", 10, false), thing("coin", 1, true), thing("drum", 7, true),
+		}}})
+	require.NoError(t, err)
+
+	consumer := service.NewConsumer(cfg, logging.NewNoopLogger(), make(chan types.EventData))
+	consumer.DB = db
+	httpServer := httptest.NewServer(service.NewServer(cfg, logging.NewNoopLogger(), consumer, projection))
+	defer httpServer.Close()
+
+	get := func(path string, expectedStatus int, result interface{}) {
+		resp, err := http.Get(httpServer.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, expectedStatus, resp.StatusCode, path)
+		if result != nil {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(result))
+		}
+	}
+	names := func(path string) []string {
+		var rows []map[string]interface{}
+		get(path, http.StatusOK, &rows)
+		var names []string
+		for _, row := range rows {
+			names = append(names, row["name"].(string))
+		}
+		return names
+	}
+
+	var tables []service.TableDescription
+	get("/projections", http.StatusOK, &tables)
+	require.Len(t, tables, 1)
+	assert.Equal(t, "Things", tables[0].Name)
+
+	assert.Equal(t, []string{"apple", "brick", "coin", "drum"}, names("/projections/Things"))
+	assert.Equal(t, []string{"apple", "coin", "drum"}, names("/projections/Things?shiny=true"))
+	assert.Equal(t, []string{"drum", "apple"}, names("/projections/Things?shiny=true&size.gt=2&order=-size"))
+	assert.Equal(t, []string{"apple", "drum"}, names("/projections/Things?order=size&limit=2&offset=1"))
+
+	var rows []map[string]interface{}
+	get("/projections/Things?name=brick", http.StatusOK, &rows)
+	require.Len(t, rows, 1)
+	assert.Equal(t, float64(10), rows[0]["size"])
+	assert.Equal(t, false, rows[0]["shiny"])
+
+	get("/projections/Nothing", http.StatusNotFound, nil)
+	get("/projections/Things?colour=red", http.StatusBadRequest, nil)
+	get("/projections/Things?size.like=1", http.StatusBadRequest, nil)
+	get("/projections/Things?size=big", http.StatusBadRequest, nil)
+	get("/projections/Things?order=colour", http.StatusBadRequest, nil)
+	get("/projections/Things?limit=100000", http.StatusBadRequest, nil)
+}
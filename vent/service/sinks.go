@@ -0,0 +1,83 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/vent/sink"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// committedSink tracks the height committed to a sink. Blocks are consumed from the lowest height committed to any
+// sink so a sink that is ahead of the others must skip the blocks it already has.
+type committedSink struct {
+	sink.Sink
+	height uint64
+}
+
+func newCommittedSink(chainID string, s sink.Sink) (*committedSink, error) {
+	height, err := s.LastBlockHeight(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return &committedSink{Sink: s, height: height}, nil
+}
+
+func (cs *committedSink) setBlock(chainID string, eventTables types.EventTables, eventData types.EventData) error {
+	// As for the SQL database a height of 0 may mean nothing has been committed so we always commit block 0
+	if cs.height > 0 && eventData.BlockHeight <= cs.height {
+		return nil
+	}
+	err := cs.SetBlock(chainID, eventTables, eventData)
+	if err != nil {
+		return err
+	}
+	cs.height = eventData.BlockHeight
+	return nil
+}
+
+func (cs *committedSink) rewindBlocks(chainID string, eventTables types.EventTables, height uint64) error {
+	if cs.height <= height {
+		return nil
+	}
+	err := cs.RewindBlocks(chainID, eventTables, height)
+	if err != nil {
+		return err
+	}
+	cs.height = height
+	return nil
+}
+
+// rewindSinks rewinds the database (the first sink) only once every other sink has been rewound. Reorganisations are
+// detected against the database's block log so if any sink fails to rewind the database still records the blocks that
+// were replaced, and the reorganisation (and so the rewind) will be found again when we restart.
+func rewindSinks(chainID string, sinks []*committedSink, eventTables types.EventTables, height uint64) error {
+	for i := len(sinks) - 1; i >= 0; i-- {
+		if err := sinks[i].rewindBlocks(chainID, eventTables, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// openSinks opens the sinks other than the SQL database that are configured
+func (c *Consumer) openSinks() ([]sink.Sink, error) {
+	var sinks []sink.Sink
+	if c.Config.JSONLFile != "" {
+		jsonlSink, err := sink.NewJSONLSink(c.Config.JSONLFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not open JSONL sink: %v", err)
+		}
+		sinks = append(sinks, jsonlSink)
+	}
+	if c.Config.WebhookURL != "" {
+		webhookSink, err := sink.NewWebhookSink(c.Config.WebhookURL, c.Config.WebhookCheckpointFile, c.Logger)
+		if err != nil {
+			for _, s := range sinks {
+				s.Close()
+			}
+			return nil, fmt.Errorf("could not open webhook sink: %v", err)
+		}
+		sinks = append(sinks, webhookSink)
+	}
+	return sinks, nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSink struct {
+	height    uint64
+	calls     []string
+	rewindErr error
+}
+
+func (ts *testSink) LastBlockHeight(chainID string) (uint64, error) {
+	return ts.height, nil
+}
+
+func (ts *testSink) SetBlock(chainID string, eventTables types.EventTables, eventData types.EventData) error {
+	ts.calls = append(ts.calls, "set")
+	ts.height = eventData.BlockHeight
+	return nil
+}
+
+func (ts *testSink) RewindBlocks(chainID string, eventTables types.EventTables, height uint64) error {
+	if ts.rewindErr != nil {
+		return ts.rewindErr
+	}
+	ts.calls = append(ts.calls, "rewind")
+	ts.height = height
+	return nil
+}
+
+func (ts *testSink) Close() {
+}
+
+func TestCommittedSink(t *testing.T) {
+	behind := &testSink{height: 2}
+	ahead := &testSink{height: 4}
+	var sinks []*committedSink
+	for _, s := range []*testSink{behind, ahead} {
+		cs, err := newCommittedSink(chainID, s)
+		require.NoError(t, err)
+		sinks = append(sinks, cs)
+	}
+
+	for height := uint64(3); height <= 5; height++ {
+		for _, cs := range sinks {
+			require.NoError(t, cs.setBlock(chainID, nil, types.EventData{BlockHeight: height}))
+		}
+	}
+	assert.Equal(t, []string{"set", "set", "set"}, behind.calls)
+	assert.Equal(t, []string{"set"}, ahead.calls)
+
+	for _, cs := range sinks {
+		require.NoError(t, cs.rewindBlocks(chainID, nil, 5))
+		require.NoError(t, cs.rewindBlocks(chainID, nil, 3))
+	}
+	assert.Equal(t, uint64(3), behind.height)
+	assert.Equal(t, []string{"set", "set", "set", "rewind"}, behind.calls)
+	assert.Equal(t, []string{"set", "rewind"}, ahead.calls)
+}
+
+func TestRewindSinks(t *testing.T) {
+	db := &testSink{height: 5}
+	jsonl := &testSink{height: 5}
+	webhook := &testSink{height: 5, rewindErr: fmt.Errorf("retries exhausted")}
+	var sinks []*committedSink
+	for _, s := range []*testSink{db, jsonl, webhook} {
+		cs, err := newCommittedSink(chainID, s)
+		require.NoError(t, err)
+		sinks = append(sinks, cs)
+	}
+
+	// The database keeps the replaced blocks so that the reorganisation is detected again on restart
+	require.Error(t, rewindSinks(chainID, sinks, nil, 3))
+	assert.Equal(t, uint64(5), db.height)
+	assert.Empty(t, db.calls)
+
+	webhook.rewindErr = nil
+	require.NoError(t, rewindSinks(chainID, sinks, nil, 3))
+	assert.Equal(t, []string{"rewind"}, db.calls)
+	assert.Equal(t, []string{"rewind"}, jsonl.calls)
+	assert.Equal(t, []string{"rewind"}, webhook.calls)
+	assert.Equal(t, uint64(3), db.height)
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/chain"
+	"github.com/hyperledger/burrow/vent/sqlsol"
+	"github.com/hyperledger/burrow/vent/storage"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// StorageGetter returns the value stored at key in the storage of the contract at address as of height
+type StorageGetter func(ctx context.Context, address crypto.Address, key binary.Word256, height uint64) ([]byte, error)
+
+// storageClass is an EventClass projected from the storage of a contract
+type storageClass struct {
+	*types.EventClass
+	address crypto.Address
+	layout  *storage.Layout
+}
+
+// storageRefresh collects what must be re-read from the storage of a contract for a block
+type storageRefresh struct {
+	// Height of the block as of which to read storage
+	height uint64
+	// The last transaction in the block to touch the contract
+	origin *chain.Origin
+	txHash binary.HexBytes
+	keys   []interface{}
+	seen   map[string]bool
+}
+
+func storageClasses(projection *sqlsol.Projection) []*storageClass {
+	var classes []*storageClass
+	for _, eventClass := range projection.Spec {
+		if eventClass.Storage != nil {
+			classes = append(classes, &storageClass{
+				EventClass: eventClass,
+				// Validated by NewProjection
+				address: crypto.MustAddressFromHexString(eventClass.Storage.Address),
+				layout:  projection.Layouts[eventClass.Storage.LayoutFile],
+			})
+		}
+	}
+	return classes
+}
+
+func newStorageRefresh(height uint64) *storageRefresh {
+	return &storageRefresh{
+		height: height,
+		seen:   make(map[string]bool),
+	}
+}
+
+// touch records that the transaction touched the contract
+func (sr *storageRefresh) touch(origin *chain.Origin, txHash binary.HexBytes) {
+	sr.origin = origin
+	sr.txHash = txHash
+}
+
+func (sr *storageRefresh) addKey(key interface{}) {
+	id := fmt.Sprintf("%T:%v", key, key)
+	if bs, ok := key.(*[]byte); ok {
+		id = fmt.Sprintf("%T:%v", *bs, *bs)
+	}
+	if !sr.seen[id] {
+		sr.seen[id] = true
+		sr.keys = append(sr.keys, key)
+	}
+}
+
+// addEventKeys adds the values of the KeysFrom fields of an event emitted by the contract as keys to refresh
+func (sc *storageClass) addEventKeys(refresh *storageRefresh, decodedData map[string]interface{}) {
+	for _, field := range sc.Storage.KeysFrom {
+		if key, ok := decodedData[field]; ok {
+			refresh.addKey(key)
+		}
+	}
+}
+
+// buildStorageRows reads the rows of a storageClass from storage as of the block
+func buildStorageRows(ctx context.Context, projection *sqlsol.Projection, sc *storageClass, refresh *storageRefresh,
+	getStorage StorageGetter, logger *logging.Logger) ([]types.EventDataRow, error) {
+
+	reader := storage.NewReader(sc.layout, func(key binary.Word256) ([]byte, error) {
+		return getStorage(ctx, sc.address, key, refresh.height)
+	})
+
+	header := func() map[string]interface{} {
+		return map[string]interface{}{
+			types.ChainIDLabel:        refresh.origin.ChainID,
+			types.BlockHeightLabel:    strconv.FormatUint(refresh.origin.Height, 10),
+			types.TxIndexLabel:        strconv.FormatUint(refresh.origin.Index, 10),
+			types.TxTxHashLabel:       refresh.txHash.String(),
+			types.EventTypeLabel:      types.StorageEventType,
+			types.EventNameLabel:      sc.Storage.Mapping,
+			types.StorageAddressLabel: sc.address.String(),
+		}
+	}
+
+	if sc.Storage.Mapping == "" {
+		data := header()
+		for _, label := range sc.mappedVariables() {
+			value, err := reader.Read(label)
+			if err != nil {
+				return nil, fmt.Errorf("could not read state variable '%s' of %v: %w", label, sc.address, err)
+			}
+			setStorageValue(data, label, value)
+		}
+		return []types.EventDataRow{sc.buildRow(projection, data, logger)}, nil
+	}
+
+	rows := make([]types.EventDataRow, len(refresh.keys))
+	for i, key := range refresh.keys {
+		value, err := reader.ReadMapping(sc.Storage.Mapping, key)
+		if err != nil {
+			return nil, fmt.Errorf("could not read key %v of mapping '%s' of %v: %w", key, sc.Storage.Mapping,
+				sc.address, err)
+		}
+		data := header()
+		data[types.StorageKeyLabel] = key
+		if members, ok := value.(map[string]interface{}); ok {
+			for member, v := range members {
+				setStorageValue(data, member, v)
+			}
+		} else {
+			setStorageValue(data, types.StorageValueLabel, value)
+		}
+		rows[i] = sc.buildRow(projection, data, logger)
+	}
+	return rows, nil
+}
+
+// buildRow builds a row from the values read from storage. Since deleted storage is zeroed a row is deleted when
+// the value of the DeleteMarkerField is zero.
+func (sc *storageClass) buildRow(projection *sqlsol.Projection, data map[string]interface{},
+	logger *logging.Logger) types.EventDataRow {
+	deleted := false
+	if sc.DeleteMarkerField != "" {
+		deleted = isZero(data[sc.DeleteMarkerField])
+		delete(data, sc.DeleteMarkerField)
+	}
+	for field, value := range data {
+		data[field] = decodedValue(nil, value)
+	}
+	row := buildRow(projection, sc.EventClass, data, logger)
+	if deleted {
+		row.Action = types.ActionDelete
+	}
+	return row
+}
+
+// mappedVariables returns the labels of the state variables that have a field mapping, either directly or for one
+// of their members as <label>.<member>
+func (sc *storageClass) mappedVariables() []string {
+	var labels []string
+	for _, v := range sc.layout.Storage {
+		for _, fm := range sc.FieldMappings {
+			if fm.Field == v.Label || strings.HasPrefix(fm.Field, v.Label+".") {
+				labels = append(labels, v.Label)
+				break
+			}
+		}
+	}
+	return labels
+}
+
+// setStorageValue sets the field for a value read from storage, flattening the members of structs into fields
+// labelled <label>.<member>
+func setStorageValue(data map[string]interface{}, label string, value interface{}) {
+	if members, ok := value.(map[string]interface{}); ok {
+		for member, v := range members {
+			setStorageValue(data, label+"."+member, v)
+		}
+		return
+	}
+	data[label] = value
+}
+
+func isZero(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case *big.Int:
+		return v.Sign() == 0
+	case *[]byte:
+		for _, b := range *v {
+			if b != 0 {
+				return false
+			}
+		}
+		return true
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return true
+		}
+		rv = rv.Elem()
+	}
+	return rv.IsZero()
+}
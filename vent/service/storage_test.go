@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/chain/burrow"
+	"github.com/hyperledger/burrow/vent/sqlsol"
+	"github.com/hyperledger/burrow/vent/test"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestStorageProjection(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	vm := evm.New(engine.Options{Natives: native.MustDefaultNatives()})
+	caller := engine.AddressFromName("caller")
+	contract := engine.AddressFromName("EventsTest")
+	require.NoError(t, engine.CreateAccount(st, caller))
+	require.NoError(t, engine.CreateAccount(st, contract))
+	require.NoError(t, engine.InitEVMCode(st, contract, test.DeployedBytecode_EventsTest))
+
+	spec, err := abi.ReadSpec(test.Abi_EventsTest)
+	require.NoError(t, err)
+
+	projection, err := sqlsol.NewProjection(types.ProjectionSpec{
+		{
+			TableName: "Things",
+			Storage: &types.StorageSource{
+				Address:    contract.String(),
+				LayoutFile: "../test/EventsTest.storage.json",
+				Mapping:    "things",
+				KeysFrom:   []string{"name"},
+			},
+			DeleteMarkerField: "exists",
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: "key", Type: "bytes32", ColumnName: "name", BytesToString: true, Primary: true},
+				{Field: "description", Type: "string", ColumnName: "description"},
+			},
+		},
+		{
+			TableName: "ThingCount",
+			Storage: &types.StorageSource{
+				Address:    contract.String(),
+				LayoutFile: "../test/EventsTest.storage.json",
+			},
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: "address", Type: "address", ColumnName: "address", Primary: true},
+				{Field: "length", Type: "int256", ColumnName: "length"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	getStorage := func(ctx context.Context, address crypto.Address, key binary.Word256, height uint64) ([]byte, error) {
+		return st.GetStorage(address, key)
+	}
+	eventCh := make(chan types.EventData, 2)
+	blockConsumer := NewBlockConsumer(chainID, projection, sqlsol.None, spec.GetEventAbi, nil, getStorage, eventCh,
+		make(chan struct{}), logging.NewNoopLogger())
+
+	call := func(block *exec.BlockExecution, function string, args ...interface{}) {
+		input, _, err := spec.Pack(function, args...)
+		require.NoError(t, err)
+		txe := &exec.TxExecution{TxHeader: &exec.TxHeader{Index: uint64(len(block.TxExecutions))}}
+		params := engine.CallParams{
+			Caller: caller,
+			Callee: contract,
+			Input:  input,
+			Gas:    big.NewInt(1000000),
+		}
+		require.NoError(t, txe.Call(&exec.CallEvent{CallData: &exec.CallData{Caller: caller, Callee: contract}}, nil))
+		_, err = vm.Execute(st, new(engine.TestBlockchain), txe, params, test.DeployedBytecode_EventsTest)
+		require.NoError(t, err)
+		block.AppendTxs(txe)
+	}
+
+	block := &exec.BlockExecution{Height: 1, Header: &tmproto.Header{}}
+	call(block, "addThing", "foo", "first")
+	call(block, "addThing", "bar", "second")
+	call(block, "addThing", "foo", "third")
+	require.NoError(t, blockConsumer(burrow.NewBurrowBlock(block)))
+	tables := (<-eventCh).Tables
+
+	require.Len(t, tables["Things"], 2)
+	for i, expected := range []map[string]string{
+		{"name": "foo", "description": "third"},
+		{"name": "bar", "description": "second"},
+	} {
+		row := tables["Things"][i]
+		assert.Equal(t, types.ActionUpsert, row.Action)
+		assert.Equal(t, expected["name"], row.RowData["name"])
+		assert.Equal(t, expected["description"], row.RowData["description"])
+		assert.Equal(t, "1", row.RowData[columns.Height])
+		assert.Equal(t, "2", row.RowData[columns.TxIndex])
+	}
+	require.Len(t, tables["ThingCount"], 1)
+	assert.Equal(t, contract.String(), tables["ThingCount"][0].RowData["address"])
+	assert.Equal(t, "2", tables["ThingCount"][0].RowData["length"])
+
+	block = &exec.BlockExecution{Height: 2, Header: &tmproto.Header{}}
+	call(block, "removeThing", "foo")
+	require.NoError(t, blockConsumer(burrow.NewBurrowBlock(block)))
+	tables = (<-eventCh).Tables
+
+	require.Len(t, tables["Things"], 1)
+	assert.Equal(t, types.ActionDelete, tables["Things"][0].Action)
+	assert.Equal(t, "foo", tables["Things"][0].RowData["name"])
+	assert.Equal(t, "1", tables["ThingCount"][0].RowData["length"])
+}
@@ -0,0 +1,131 @@
+package sink
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+const jsonlReadChunkSize = 4096
+
+// JSONLSink appends one JSON Record per line to a file. The file is its own checkpoint: the last complete line gives the
+// last block committed and a partially written trailing line left by a crash is truncated when the file is opened.
+type JSONLSink struct {
+	file       *os.File
+	checkpoint Checkpoint
+}
+
+var _ Sink = (*JSONLSink)(nil)
+
+// NewJSONLSink opens or creates the JSONL file at path
+func NewJSONLSink(path string) (*JSONLSink, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	js := &JSONLSink{file: file}
+	line, end, err := lastLine(file)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("could not read last record from %s: %v", path, err)
+	}
+	// Drop anything after the last complete record and append from there
+	err = file.Truncate(end)
+	if err == nil {
+		_, err = file.Seek(end, io.SeekStart)
+	}
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if len(line) > 0 {
+		rec := new(Record)
+		err = json.Unmarshal(line, rec)
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("could not decode last record from %s: %v", path, err)
+		}
+		js.checkpoint.Update(rec)
+	}
+	return js, nil
+}
+
+func (js *JSONLSink) LastBlockHeight(chainID string) (uint64, error) {
+	return js.checkpoint.LastBlockHeight(chainID)
+}
+
+func (js *JSONLSink) SetBlock(chainID string, eventTables types.EventTables, eventData types.EventData) error {
+	if js.checkpoint.Skip(eventData.BlockHeight) {
+		return nil
+	}
+	return js.write(&Record{ChainID: chainID, Block: &eventData})
+}
+
+func (js *JSONLSink) RewindBlocks(chainID string, eventTables types.EventTables, height uint64) error {
+	return js.write(&Record{ChainID: chainID, RewindTo: &height})
+}
+
+func (js *JSONLSink) Close() {
+	js.file.Close()
+}
+
+func (js *JSONLSink) write(rec *Record) error {
+	bs, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	_, err = js.file.Write(append(bs, '\n'))
+	if err != nil {
+		return err
+	}
+	err = js.file.Sync()
+	if err != nil {
+		return err
+	}
+	js.checkpoint.Update(rec)
+	return nil
+}
+
+// lastLine returns the last newline-terminated line of file (without its newline) and the offset just after it
+func lastLine(file *os.File) ([]byte, int64, error) {
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, 0, err
+	}
+	var tail []byte
+	end := int64(-1)
+	for offset := size; offset > 0; {
+		n := int64(jsonlReadChunkSize)
+		if n > offset {
+			n = offset
+		}
+		offset -= n
+		chunk := make([]byte, n)
+		_, err = file.ReadAt(chunk, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		tail = append(chunk, tail...)
+		if end < 0 {
+			// Looking for the newline terminating the last complete line
+			i := bytes.LastIndexByte(tail, '\n')
+			if i < 0 {
+				continue
+			}
+			end = offset + int64(i) + 1
+			tail = tail[:i]
+		}
+		// Looking for the start of the last complete line
+		if i := bytes.LastIndexByte(tail, '\n'); i >= 0 {
+			return tail[i+1:], end, nil
+		}
+	}
+	if end < 0 {
+		return nil, 0, nil
+	}
+	return tail, end, nil
+}
@@ -0,0 +1,123 @@
+package sink
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const chainID = "TestChainID"
+
+func TestJSONLSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vent-jsonl")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "rows.jsonl")
+
+	js, err := NewJSONLSink(path)
+	require.NoError(t, err)
+	height, err := js.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), height)
+
+	for h := uint64(0); h <= 3; h++ {
+		require.NoError(t, js.SetBlock(chainID, nil, block(h)))
+	}
+	// Already committed
+	require.NoError(t, js.SetBlock(chainID, nil, block(2)))
+	require.NoError(t, js.RewindBlocks(chainID, nil, 2))
+	require.NoError(t, js.SetBlock(chainID, nil, block(3)))
+	js.Close()
+
+	// Simulate a crash part way through writing a record
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"ChainID":"TestChainID","Block":{"Bloc`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	js, err = NewJSONLSink(path)
+	require.NoError(t, err)
+	height, err = js.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), height)
+	require.NoError(t, js.SetBlock(chainID, nil, block(3)))
+	require.NoError(t, js.SetBlock(chainID, nil, block(4)))
+	js.Close()
+
+	_, err = js.LastBlockHeight("AnotherChain")
+	require.Error(t, err)
+
+	assert.Equal(t, []string{"0", "1", "2", "3", "rewind 2", "3", "4"}, readRecords(t, path))
+}
+
+func TestLastLine(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vent-jsonl")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	long := strings.Repeat("x", jsonlReadChunkSize*2+7)
+	for _, tc := range []struct {
+		contents string
+		line     string
+		end      int64
+	}{
+		{"", "", 0},
+		{"partial", "", 0},
+		{"one\n", "one", 4},
+		{"one\ntwo\npart", "two", 8},
+		{"one\n" + long + "\n", long, int64(len(long) + 5)},
+		{long + "\n" + long, long, int64(len(long) + 1)},
+	} {
+		path := filepath.Join(dir, "test.jsonl")
+		require.NoError(t, ioutil.WriteFile(path, []byte(tc.contents), 0644))
+		file, err := os.Open(path)
+		require.NoError(t, err)
+		line, end, err := lastLine(file)
+		require.NoError(t, err)
+		assert.Equal(t, tc.line, string(line))
+		assert.Equal(t, tc.end, end)
+		file.Close()
+	}
+}
+
+func block(height uint64) types.EventData {
+	return types.EventData{
+		BlockHeight: height,
+		Tables: map[string]types.EventDataTable{
+			"Things": {{Action: types.ActionUpsert, RowData: map[string]interface{}{"height": height}}},
+		},
+	}
+}
+
+func readRecords(t *testing.T, path string) []string {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var records []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		records = append(records, describe(t, scanner.Bytes()))
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func describe(t *testing.T, bs []byte) string {
+	rec := new(Record)
+	require.NoError(t, json.Unmarshal(bs, rec))
+	assert.Equal(t, chainID, rec.ChainID)
+	if rec.RewindTo != nil {
+		return "rewind " + strconv.FormatUint(*rec.RewindTo, 10)
+	}
+	return strconv.FormatUint(rec.Block.BlockHeight, 10)
+}
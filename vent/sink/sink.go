@@ -0,0 +1,104 @@
+// Package sink provides destinations other than the SQL database for the rows Vent projects from each block.
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/tendermint/tendermint/libs/tempfile"
+)
+
+// Sink receives the rows projected from each block. A block's rows must be committed atomically with its height so that
+// Vent can resume from LastBlockHeight and deliver each block exactly once. The SQL database is itself a Sink.
+type Sink interface {
+	// LastBlockHeight returns the height of the last block committed to the sink
+	LastBlockHeight(chainID string) (uint64, error)
+	// SetBlock commits the rows projected from a block
+	SetBlock(chainID string, eventTables types.EventTables, eventData types.EventData) error
+	// RewindBlocks discards (or signals downstream to discard) the blocks above height that have been replaced by a
+	// chain reorganisation
+	RewindBlocks(chainID string, eventTables types.EventTables, height uint64) error
+	Close()
+}
+
+// Record is the unit written to streaming sinks: either the rows of a block or a notice that the stream has been
+// rewound to a height, after which the blocks above it will be sent again from the new canonical chain
+type Record struct {
+	ChainID  string
+	Block    *types.EventData `json:",omitempty"`
+	RewindTo *uint64          `json:",omitempty"`
+}
+
+// Height returns the height of the last block reflected in the stream after this record
+func (rec *Record) Height() uint64 {
+	if rec.Block != nil {
+		return rec.Block.BlockHeight
+	}
+	if rec.RewindTo != nil {
+		return *rec.RewindTo
+	}
+	return 0
+}
+
+// Checkpoint records the last block committed to a sink
+type Checkpoint struct {
+	ChainID string
+	Height  uint64
+	// Distinguishes having committed block 0 from having committed nothing
+	Committed bool
+	// Counts the rewinds committed so that a block from the new canonical chain can be told apart from the one it replaces
+	Rewinds uint64 `json:",omitempty"`
+}
+
+// LastBlockHeight returns the checkpointed height provided it belongs to chainID
+func (cp *Checkpoint) LastBlockHeight(chainID string) (uint64, error) {
+	if cp.Committed && cp.ChainID != chainID {
+		return 0, fmt.Errorf("sink has committed blocks from chain '%s' so cannot receive blocks from chain '%s'",
+			cp.ChainID, chainID)
+	}
+	return cp.Height, nil
+}
+
+// Skip returns true if the block at height has already been committed
+func (cp *Checkpoint) Skip(height uint64) bool {
+	return cp.Committed && height <= cp.Height
+}
+
+// Update moves the checkpoint on to reflect rec
+func (cp *Checkpoint) Update(rec *Record) {
+	cp.ChainID = rec.ChainID
+	cp.Height = rec.Height()
+	cp.Committed = true
+	if rec.RewindTo != nil {
+		cp.Rewinds++
+	}
+}
+
+// LoadCheckpoint reads a checkpoint from file returning an empty checkpoint if the file does not exist
+func LoadCheckpoint(file string) (*Checkpoint, error) {
+	cp := new(Checkpoint)
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cp, nil
+		}
+		return nil, err
+	}
+	err = json.Unmarshal(bs, cp)
+	if err != nil {
+		return nil, fmt.Errorf("could not read sink checkpoint from %s: %v", file, err)
+	}
+	return cp, nil
+}
+
+// Save atomically writes the checkpoint to file
+func (cp *Checkpoint) Save(file string) error {
+	bs, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(file, bs, 0600)
+}
@@ -0,0 +1,106 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+const (
+	DefaultMaxRetries  = 5
+	DefaultBaseBackoff = time.Second
+)
+
+// Publisher delivers a message to a topic of a message queue, a webhook, or similar. The key is unique to each record
+// so that a receiver may discard a message delivered twice (if Vent stops after publishing but before checkpointing).
+// Keys have the form <chain ID>/<rewinds>/<height>, where rewinds counts the rewinds published so far, so the block
+// that replaces one discarded by a rewind is given a new key.
+type Publisher interface {
+	Publish(ctx context.Context, topic, key string, payload []byte) error
+}
+
+// StreamSink publishes each Record as JSON via a Publisher, retrying failed deliveries with exponential backoff, and
+// keeps its progress in a checkpoint file since it cannot ask the receiver what it has seen
+type StreamSink struct {
+	publisher      Publisher
+	topic          string
+	checkpointFile string
+	checkpoint     *Checkpoint
+	maxRetries     uint64
+	baseBackoff    time.Duration
+	logger         *logging.Logger
+}
+
+var _ Sink = (*StreamSink)(nil)
+
+// NewStreamSink creates a StreamSink resuming from the checkpoint in checkpointFile (if it exists)
+func NewStreamSink(publisher Publisher, topic, checkpointFile string, maxRetries uint64, baseBackoff time.Duration,
+	logger *logging.Logger) (*StreamSink, error) {
+	checkpoint, err := LoadCheckpoint(checkpointFile)
+	if err != nil {
+		return nil, err
+	}
+	return &StreamSink{
+		publisher:      publisher,
+		topic:          topic,
+		checkpointFile: checkpointFile,
+		checkpoint:     checkpoint,
+		maxRetries:     maxRetries,
+		baseBackoff:    baseBackoff,
+		logger:         logger.WithScope("StreamSink"),
+	}, nil
+}
+
+func (ss *StreamSink) LastBlockHeight(chainID string) (uint64, error) {
+	return ss.checkpoint.LastBlockHeight(chainID)
+}
+
+func (ss *StreamSink) SetBlock(chainID string, eventTables types.EventTables, eventData types.EventData) error {
+	if ss.checkpoint.Skip(eventData.BlockHeight) {
+		return nil
+	}
+	return ss.publish(&Record{ChainID: chainID, Block: &eventData})
+}
+
+func (ss *StreamSink) RewindBlocks(chainID string, eventTables types.EventTables, height uint64) error {
+	return ss.publish(&Record{ChainID: chainID, RewindTo: &height})
+}
+
+func (ss *StreamSink) Close() {
+}
+
+func (ss *StreamSink) publish(rec *Record) error {
+	payload, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	next := *ss.checkpoint
+	next.Update(rec)
+	key := fmt.Sprintf("%s/%d/%d", rec.ChainID, next.Rewinds, rec.Height())
+	if rec.RewindTo != nil {
+		key += "/rewind"
+	}
+	backoff := ss.baseBackoff
+	for attempt := uint64(0); ; attempt++ {
+		err = ss.publisher.Publish(context.Background(), ss.topic, key, payload)
+		if err == nil {
+			break
+		}
+		if attempt >= ss.maxRetries {
+			return fmt.Errorf("could not publish %s after %d attempts: %v", key, attempt+1, err)
+		}
+		ss.logger.InfoMsg("Failed to publish record, retrying", "key", key, "backoff", backoff, "error", err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+	err = next.Save(ss.checkpointFile)
+	if err != nil {
+		return fmt.Errorf("published %s but could not save checkpoint: %v", key, err)
+	}
+	ss.checkpoint = &next
+	return nil
+}
@@ -0,0 +1,121 @@
+package sink
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// broker stands in for a message queue, failing the next failures publishes
+type broker struct {
+	sync.Mutex
+	failures int
+	topics   map[string][]string
+	keys     map[string][]string
+}
+
+func newBroker() *broker {
+	return &broker{
+		topics: make(map[string][]string),
+		keys:   make(map[string][]string),
+	}
+}
+
+func (b *broker) Publish(ctx context.Context, topic, key string, payload []byte) error {
+	b.Lock()
+	defer b.Unlock()
+	if b.failures > 0 {
+		b.failures--
+		return errors.New("broker unavailable")
+	}
+	b.topics[topic] = append(b.topics[topic], string(payload))
+	b.keys[topic] = append(b.keys[topic], key)
+	return nil
+}
+
+func TestStreamSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vent-stream")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	checkpointFile := filepath.Join(dir, "checkpoint.json")
+	logger := logging.NewNoopLogger()
+
+	b := newBroker()
+	ss, err := NewStreamSink(b, "rows", checkpointFile, 2, time.Millisecond, logger)
+	require.NoError(t, err)
+
+	require.NoError(t, ss.SetBlock(chainID, nil, block(0)))
+	b.failures = 2
+	require.NoError(t, ss.SetBlock(chainID, nil, block(1)))
+	require.NoError(t, ss.SetBlock(chainID, nil, block(2)))
+	require.NoError(t, ss.RewindBlocks(chainID, nil, 1))
+
+	// Out of retries
+	b.failures = 3
+	require.Error(t, ss.SetBlock(chainID, nil, block(2)))
+	height, err := ss.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), height)
+
+	// Resume from the checkpoint
+	ss, err = NewStreamSink(b, "rows", checkpointFile, 2, time.Millisecond, logger)
+	require.NoError(t, err)
+	height, err = ss.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), height)
+	require.NoError(t, ss.SetBlock(chainID, nil, block(1)))
+	require.NoError(t, ss.SetBlock(chainID, nil, block(2)))
+
+	assert.Equal(t, []string{
+		"TestChainID/0/0",
+		"TestChainID/0/1",
+		"TestChainID/0/2",
+		"TestChainID/1/1/rewind",
+		"TestChainID/1/2",
+	}, b.keys["rows"])
+	var records []string
+	for _, payload := range b.topics["rows"] {
+		records = append(records, describe(t, []byte(payload)))
+	}
+	assert.Equal(t, []string{"0", "1", "2", "rewind 1", "2"}, records)
+}
+
+func TestWebhookSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vent-webhook")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var keys []string
+	failures := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		bs, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		keys = append(keys, r.Header.Get(WebhookKeyHeader))
+		describe(t, bs)
+	}))
+	defer server.Close()
+
+	ss, err := NewStreamSink(NewWebhookPublisher(server.URL, time.Second), "", filepath.Join(dir, "checkpoint.json"),
+		1, time.Millisecond, logging.NewNoopLogger())
+	require.NoError(t, err)
+	require.NoError(t, ss.SetBlock(chainID, nil, block(7)))
+	require.NoError(t, ss.SetBlock(chainID, nil, block(8)))
+	assert.Equal(t, []string{"TestChainID/0/7", "TestChainID/0/8"}, keys)
+}
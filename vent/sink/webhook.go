@@ -0,0 +1,66 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+)
+
+const (
+	// WebhookTopicHeader carries the topic of a record POSTed to a webhook
+	WebhookTopicHeader = "X-Vent-Topic"
+	// WebhookKeyHeader carries the unique key of a record POSTed to a webhook (for de-duplication)
+	WebhookKeyHeader = "X-Vent-Key"
+
+	DefaultWebhookTimeout = 30 * time.Second
+)
+
+// WebhookPublisher POSTs each message to a URL, any response other than 2xx is considered a failure
+type WebhookPublisher struct {
+	url    string
+	client *http.Client
+}
+
+var _ Publisher = (*WebhookPublisher)(nil)
+
+func NewWebhookPublisher(url string, timeout time.Duration) *WebhookPublisher {
+	return &WebhookPublisher{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// NewWebhookSink returns a StreamSink that POSTs each record to url
+func NewWebhookSink(url, checkpointFile string, logger *logging.Logger) (*StreamSink, error) {
+	return NewStreamSink(NewWebhookPublisher(url, DefaultWebhookTimeout), "", checkpointFile, DefaultMaxRetries,
+		DefaultBaseBackoff, logger)
+}
+
+func (wp *WebhookPublisher) Publish(ctx context.Context, topic, key string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wp.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if topic != "" {
+		req.Header.Set(WebhookTopicHeader, topic)
+	}
+	req.Header.Set(WebhookKeyHeader, key)
+	resp, err := wp.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Drain the body so the connection can be reused
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s responded with %s", wp.url, resp.Status)
+	}
+	return nil
+}
@@ -448,19 +448,19 @@ func (pa *PostgresAdapter) CleanDBQueries() types.SQLCleanDBQuery {
 		SELECT DISTINCT %s 
 		FROM %s.%s 
  		WHERE %s
-		NOT IN ('%s','%s','%s');`,
+		NOT IN ('%s','%s','%s','%s');`,
 		pa.Columns.TableName,
 		pa.Schema, pa.Tables.Dictionary,
 		pa.Columns.TableName,
-		pa.Tables.Log, pa.Tables.Dictionary, pa.Tables.ChainInfo)
+		pa.Tables.Log, pa.Tables.Dictionary, pa.Tables.ChainInfo, pa.Tables.BlockLog)
 
 	deleteDictionaryQry := Cleanf(`
 		DELETE FROM %s.%s 
 		WHERE %s 
-		NOT IN ('%s','%s','%s');`,
+		NOT IN ('%s','%s','%s','%s');`,
 		pa.Schema, pa.Tables.Dictionary,
 		pa.Columns.TableName,
-		pa.Tables.Log, pa.Tables.Dictionary, pa.Tables.ChainInfo)
+		pa.Tables.Log, pa.Tables.Dictionary, pa.Tables.ChainInfo, pa.Tables.BlockLog)
 
 	// log
 	deleteLogQry := Cleanf(`
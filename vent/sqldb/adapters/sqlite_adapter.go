@@ -421,19 +421,19 @@ func (sla *SQLiteAdapter) CleanDBQueries() types.SQLCleanDBQuery {
 		SELECT DISTINCT %s 
 		FROM %s 
  		WHERE %s
-		NOT IN ('%s','%s','%s');`,
+		NOT IN ('%s','%s','%s','%s');`,
 		sla.Columns.TableName,
 		sla.Tables.Dictionary,
 		sla.Columns.TableName,
-		sla.Tables.Log, sla.Tables.Dictionary, sla.Tables.ChainInfo)
+		sla.Tables.Log, sla.Tables.Dictionary, sla.Tables.ChainInfo, sla.Tables.BlockLog)
 
 	deleteDictionaryQry := Cleanf(`
 		DELETE FROM %s 
 		WHERE %s 
-		NOT IN ('%s','%s','%s');`,
+		NOT IN ('%s','%s','%s','%s');`,
 		sla.Tables.Dictionary,
 		sla.Columns.TableName,
-		sla.Tables.Log, sla.Tables.Dictionary, sla.Tables.ChainInfo)
+		sla.Tables.Log, sla.Tables.Dictionary, sla.Tables.ChainInfo, sla.Tables.BlockLog)
 
 	// log
 	deleteLogQry := Cleanf(`
@@ -7,4 +7,9 @@ import (
 type Queries struct {
 	LastBlockHeight *sqlx.NamedStmt
 	SetBlockHeight  string
+	LastBlocks      *sqlx.NamedStmt
+	InsertBlockLog  string
+	DeleteBlockLog  string
+	SelectLogUpTo   string
+	DeleteLogAbove  string
 }
@@ -2,6 +2,7 @@ package sqldb
 
 import (
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/vent/sqldb/adapters"
 	"github.com/hyperledger/burrow/vent/types"
@@ -97,6 +99,14 @@ func (db *SQLDB) Init(chainID, burrowVersion string) error {
 		}
 	}
 
+	// IMPORTANT: DO NOT CHANGE TABLE CREATION ORDER (4)
+	if err := db.createTable(chainID, sysTables[db.Tables.BlockLog], true); err != nil {
+		if !db.DBAdapter.ErrorEquals(err, types.SQLErrorTypeDuplicatedTable) {
+			db.Log.InfoMsg("Error creating Block Log table", "err", err)
+			return err
+		}
+	}
+
 	chainIDChanged, err := db.InitChain(chainID, burrowVersion)
 	if err != nil {
 		return fmt.Errorf("could not initialise chain in database: %v", err)
@@ -133,6 +143,33 @@ func (db *SQLDB) prepareQueries() (Queries, error) {
 			db.Columns.Height,  // set
 			db.Columns.ChainID, // where
 		),
+		LastBlocks: db.prepare(err, fmt.Sprintf("SELECT %s, %s FROM %s WHERE %s = :chainid ORDER BY %s DESC LIMIT :limit",
+			db.Columns.Height, db.Columns.BlockHash, // select
+			db.DBAdapter.SchemaName(db.Tables.BlockLog), // from
+			db.DBAdapter.SecureName(db.Columns.ChainID), // where
+			db.Columns.Height, // order by
+		)),
+		InsertBlockLog: fmt.Sprintf("INSERT INTO %s (%s, %s, %s) VALUES (:chainid, :height, :blockhash)",
+			db.DBAdapter.SchemaName(db.Tables.BlockLog),                 // insert
+			db.Columns.ChainID, db.Columns.Height, db.Columns.BlockHash, // columns
+		),
+		DeleteBlockLog: fmt.Sprintf("DELETE FROM %s WHERE %s = :chainid AND %s >= :height",
+			db.DBAdapter.SchemaName(db.Tables.BlockLog), // delete
+			db.Columns.ChainID, db.Columns.Height,       // where
+		),
+		// Heights are stored as varchar in the log
+		SelectLogUpTo: fmt.Sprintf("SELECT %s, %s, %s, %s, %s FROM %s "+
+			"WHERE %s = :chainid AND CAST(%s AS NUMERIC) <= :height ORDER BY %s",
+			db.Columns.Id, db.Columns.TableName, db.Columns.Action, // select
+			db.Columns.SqlStmt, db.Columns.SqlValues, // select
+			db.DBAdapter.SchemaName(db.Tables.Log), // from
+			db.Columns.ChainID, db.Columns.Height,  // where
+			db.Columns.Id, // order by
+		),
+		DeleteLogAbove: fmt.Sprintf("DELETE FROM %s WHERE %s = :chainid AND CAST(%s AS NUMERIC) > :height",
+			db.DBAdapter.SchemaName(db.Tables.Log), // delete
+			db.Columns.ChainID, db.Columns.Height,  // where
+		),
 	}, *err
 }
 
@@ -234,6 +271,13 @@ func (db *SQLDB) CleanTables(chainID, burrowVersion string) error {
 		db.Log.InfoMsg("Error deleting log", "err", err, "query", query)
 		return err
 	}
+
+	// Delete Block Log
+	query = fmt.Sprintf("DELETE FROM %s;", db.DBAdapter.SchemaName(db.Tables.BlockLog))
+	if _, err = tx.Exec(query); err != nil {
+		db.Log.InfoMsg("Error deleting block log", "err", err, "query", query)
+		return err
+	}
 	// Drop database tables
 	for _, tableName = range tables {
 		query = db.DBAdapter.DropTableQuery(tableName)
@@ -450,6 +494,14 @@ loop:
 		return err
 	}
 
+	if len(eventData.BlockHash) > 0 {
+		err = db.logBlock(tx, chainID, eventData.BlockHeight, eventData.BlockHash)
+		if err != nil {
+			db.Log.InfoMsg("Could not log block hash", "err", err)
+			return err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		db.Log.InfoMsg("Error on commit", "err", err)
@@ -536,6 +588,89 @@ func (db *SQLDB) GetBlock(chainID string, height uint64) (types.EventData, error
 	return data, nil
 }
 
+// QueryTable returns the rows of an event table matching query. Only columns belonging to table may be filtered or
+// ordered on. Numeric columns are returned as decimal strings to preserve their precision and binary columns as hex.
+func (db *SQLDB) QueryTable(table *types.SQLTable, query types.TableQuery) ([]map[string]interface{}, error) {
+	const errHeader = "QueryTable()"
+
+	fields := make([]string, len(table.Columns))
+	for i, column := range table.Columns {
+		fields[i] = db.DBAdapter.SecureName(column.Name)
+	}
+
+	var conditions []string
+	var args []interface{}
+	for _, filter := range query.Filters {
+		if table.GetColumn(filter.Column) == nil {
+			return nil, fmt.Errorf("%s: table '%s' has no column '%s'", errHeader, table.Name, filter.Column)
+		}
+		operator, err := filter.Operator.SQL()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", errHeader, err)
+		}
+		conditions = append(conditions, fmt.Sprintf("%s %s ?", db.DBAdapter.SecureName(filter.Column), operator))
+		args = append(args, filter.Value)
+	}
+
+	var orderBy []string
+	direction := "ASC"
+	if query.Descending {
+		direction = "DESC"
+	}
+	if query.OrderBy != "" {
+		if table.GetColumn(query.OrderBy) == nil {
+			return nil, fmt.Errorf("%s: table '%s' has no column '%s'", errHeader, table.Name, query.OrderBy)
+		}
+		orderBy = append(orderBy, db.DBAdapter.SecureName(query.OrderBy)+" "+direction)
+	}
+	// Break ties on the primary key so that pages are stable
+	for _, column := range table.Columns {
+		if column.Primary && column.Name != query.OrderBy {
+			orderBy = append(orderBy, db.DBAdapter.SecureName(column.Name)+" "+direction)
+		}
+	}
+
+	sqlQuery := fmt.Sprintf("SELECT %s FROM %s", strings.Join(fields, ", "),
+		db.DBAdapter.SchemaName(safe(table.Name)))
+	if len(conditions) > 0 {
+		sqlQuery += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	if len(orderBy) > 0 {
+		sqlQuery += " ORDER BY " + strings.Join(orderBy, ", ")
+	}
+	sqlQuery += " LIMIT ? OFFSET ?"
+	args = append(args, query.Limit, query.Offset)
+
+	db.Log.InfoMsg("Query table", "query", sqlQuery)
+	rows, err := db.DB.Query(db.DB.Rebind(sqlQuery), args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could not query table '%s': %v", errHeader, table.Name, err)
+	}
+	defer rows.Close()
+
+	pointers := make([]interface{}, len(table.Columns))
+	containers := make([]interface{}, len(table.Columns))
+	for i := range pointers {
+		pointers[i] = &containers[i]
+	}
+
+	results := []map[string]interface{}{}
+	for rows.Next() {
+		if err = rows.Scan(pointers...); err != nil {
+			return nil, fmt.Errorf("%s: could not scan row: %v", errHeader, err)
+		}
+		row := make(map[string]interface{}, len(table.Columns))
+		for i, column := range table.Columns {
+			row[column.Name] = queryValue(column, containers[i])
+		}
+		results = append(results, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: error during rows iteration: %v", errHeader, err)
+	}
+	return results, nil
+}
+
 func (db *SQLDB) LastBlockHeight(chainID string) (uint64, error) {
 	const errHeader = "LastBlockHeight()"
 	type arg struct {
@@ -570,6 +705,130 @@ func (db *SQLDB) SetBlockHeight(tx sqlx.Ext, chainID string, height uint64) erro
 	return nil
 }
 
+// LastBlocks returns up to limit of the most recently logged blocks (those with a hash) in descending order of height
+func (db *SQLDB) LastBlocks(chainID string, limit uint64) ([]types.BlockRecord, error) {
+	const errHeader = "LastBlocks()"
+	type arg struct {
+		ChainID string
+		Limit   uint64
+	}
+	var rows []struct {
+		Height    uint64 `db:"_height"`
+		BlockHash string `db:"_blockhash"`
+	}
+	err := db.Queries.LastBlocks.Select(&rows, arg{ChainID: chainID, Limit: limit})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	blocks := make([]types.BlockRecord, len(rows))
+	for i, row := range rows {
+		blocks[i].Height = row.Height
+		blocks[i].Hash, err = hex.DecodeString(row.BlockHash)
+		if err != nil {
+			return nil, fmt.Errorf("%s: could not decode hash of block at height %d: %v", errHeader, row.Height, err)
+		}
+	}
+	return blocks, nil
+}
+
+func (db *SQLDB) logBlock(tx sqlx.Ext, chainID string, height uint64, hash binary.HexBytes) error {
+	type arg struct {
+		ChainID   string
+		Height    uint64
+		BlockHash string
+	}
+	// We may see the same height more than once if we are re-consuming after a rewind
+	_, err := sqlx.NamedExec(tx, db.Queries.DeleteBlockLog, arg{ChainID: chainID, Height: height})
+	if err != nil {
+		return err
+	}
+	_, err = sqlx.NamedExec(tx, db.Queries.InsertBlockLog, arg{ChainID: chainID, Height: height, BlockHash: hash.String()})
+	return err
+}
+
+// RewindBlocks discards everything projected from blocks above height, for instance because those blocks have been
+// replaced by a chain reorganisation. The event tables are rebuilt by replaying the log up to and including height.
+func (db *SQLDB) RewindBlocks(chainID string, eventTables types.EventTables, height uint64) error {
+	type arg struct {
+		ChainID string
+		Height  uint64
+	}
+	rewindArg := arg{ChainID: chainID, Height: height}
+
+	db.Log.InfoMsg("REWINDING DB", "chain_id", chainID, "height", height)
+
+	// As with RestoreDB we read the log outside of the transaction in which we replay it
+	rows, err := db.DB.NamedQuery(db.Queries.SelectLogUpTo, rewindArg)
+	if err != nil {
+		db.Log.InfoMsg("error querying log", "err", err)
+		return err
+	}
+	defer rows.Close()
+
+	tx, err := db.DB.Beginx()
+	if err != nil {
+		db.Log.InfoMsg("could not open transaction for rewind", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	tableNames := make(map[string]bool, len(eventTables))
+	for _, table := range eventTables {
+		tableName := safe(table.Name)
+		tableNames[tableName] = true
+		query := fmt.Sprintf("DELETE FROM %s;", db.DBAdapter.SchemaName(tableName))
+		db.Log.InfoMsg("SQL COMMAND", "sql", query)
+		if _, err = tx.Exec(query); err != nil {
+			db.Log.InfoMsg("Error clearing table for rewind", "err", err, "value", tableName)
+			return err
+		}
+	}
+
+	for rows.Next() {
+		var id int64
+		var tableName, sqlStmt, sqlValues string
+		var action types.DBAction
+
+		err = rows.Scan(&id, &tableName, &action, &sqlStmt, &sqlValues)
+		if err != nil {
+			db.Log.InfoMsg("error scanning log", "err", err)
+			return err
+		}
+		// Only tables we have cleared
+		if !tableNames[tableName] {
+			continue
+		}
+		err = db.replayRow(tx.Tx, id, action, tableName, tableName, sqlStmt, sqlValues)
+		if err != nil {
+			return err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		db.Log.InfoMsg("error iterating log", "err", err)
+		return err
+	}
+
+	if _, err = sqlx.NamedExec(tx, db.Queries.DeleteLogAbove, rewindArg); err != nil {
+		db.Log.InfoMsg("could not delete rewound log entries", "err", err)
+		return err
+	}
+	if _, err = sqlx.NamedExec(tx, db.Queries.DeleteBlockLog, arg{ChainID: chainID, Height: height + 1}); err != nil {
+		db.Log.InfoMsg("could not delete rewound block log entries", "err", err)
+		return err
+	}
+	if err = db.SetBlockHeight(tx, chainID, height); err != nil {
+		db.Log.InfoMsg("could not set rewound block height", "err", err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		db.Log.InfoMsg("could not commit rewind tx", "err", err)
+		return err
+	}
+	return nil
+}
+
 // RestoreDB restores the DB to a given moment in time. If prefix is provided restores the table state to a new set of
 // tables as <prefix>_<table name>. Drops destination tables before recreating them. If zero time passed restores
 // all values
@@ -577,8 +836,6 @@ func (db *SQLDB) RestoreDB(restoreTime time.Time, prefix string) error {
 	const year = time.Hour * 24 * 365
 	const yymmddhhmmss = "2006-01-02 15:04:05"
 
-	var pointers []interface{}
-
 	// Get Restore DB query
 	query := db.DBAdapter.RestoreDBQuery()
 	if restoreTime.IsZero() {
@@ -630,22 +887,8 @@ func (db *SQLDB) RestoreDB(restoreTime time.Time, prefix string) error {
 
 		switch action {
 		case types.ActionUpsert, types.ActionDelete:
-			// get row values
-			if pointers, err = getValuesFromJSON(sqlValues); err != nil {
-				db.Log.InfoMsg("error unmarshaling json", "err", err, "value", sqlValues)
-				return err
-			}
-
-			// Prepare Upsert/delete
-			query = sqlSmt
-			if prefix != "" {
-				// TODO: [Silas] ugh this is a little fragile
-				query = strings.Replace(sqlSmt, tableName, restoreTable, -1)
-			}
-
-			db.Log.InfoMsg("SQL COMMAND", "sql", query, "log_id", id)
-			if _, err = tx.Exec(query, pointers...); err != nil {
-				db.Log.InfoMsg("Error executing upsert/delete ", "err", err, "value", sqlSmt, "data", sqlValues)
+			err = db.replayRow(tx, id, action, tableName, restoreTable, sqlSmt, sqlValues)
+			if err != nil {
 				return err
 			}
 
@@ -680,6 +923,34 @@ func (db *SQLDB) RestoreDB(restoreTime time.Time, prefix string) error {
 	return nil
 }
 
+// replayRow re-executes a logged upsert or delete against restoreTable
+func (db *SQLDB) replayRow(tx *sql.Tx, id int64, action types.DBAction, tableName, restoreTable, sqlStmt,
+	sqlValues string) error {
+	if action != types.ActionUpsert && action != types.ActionDelete {
+		return fmt.Errorf("cannot replay row action %s", action)
+	}
+	// get row values
+	pointers, err := getValuesFromJSON(sqlValues)
+	if err != nil {
+		db.Log.InfoMsg("error unmarshaling json", "err", err, "value", sqlValues)
+		return err
+	}
+
+	// Prepare Upsert/delete
+	query := sqlStmt
+	if restoreTable != tableName {
+		// TODO: [Silas] ugh this is a little fragile
+		query = strings.Replace(sqlStmt, tableName, restoreTable, -1)
+	}
+
+	db.Log.InfoMsg("SQL COMMAND", "sql", query, "log_id", id)
+	if _, err = tx.Exec(query, pointers...); err != nil {
+		db.Log.InfoMsg("Error executing upsert/delete ", "err", err, "value", sqlStmt, "data", sqlValues)
+		return err
+	}
+	return nil
+}
+
 func (db *SQLDB) prepare(perr *error, query string) *sqlx.NamedStmt {
 	stmt, err := db.DB.PrepareNamed(query)
 	if err != nil && *perr == nil {
@@ -1000,7 +1271,7 @@ func safe(parameter string) string {
 	return replacer.Replace(parameter)
 }
 
-//getJSON returns marshaled json from JSON single column
+// getJSON returns marshaled json from JSON single column
 func getJSON(JSON interface{}) ([]byte, error) {
 	if JSON != nil {
 		return json.Marshal(JSON)
@@ -1008,7 +1279,7 @@ func getJSON(JSON interface{}) ([]byte, error) {
 	return json.Marshal("")
 }
 
-//getJSONFromValues returns marshaled json from query values
+// getJSONFromValues returns marshaled json from query values
 func getJSONFromValues(values []interface{}) ([]byte, error) {
 	if values != nil {
 		return json.Marshal(values)
@@ -1016,7 +1287,7 @@ func getJSONFromValues(values []interface{}) ([]byte, error) {
 	return json.Marshal("")
 }
 
-//getValuesFromJSON returns query values from unmarshaled JSON column
+// getValuesFromJSON returns query values from unmarshaled JSON column
 func getValuesFromJSON(JSON string) ([]interface{}, error) {
 	pointers := make([]interface{}, 0)
 	bytes := []byte(JSON)
@@ -1037,6 +1308,28 @@ func getValuesFromJSON(JSON string) ([]interface{}, error) {
 	return pointers, nil
 }
 
+// queryValue normalises a scanned value across database drivers so it can be returned to clients
+func queryValue(column *types.SQLTableColumn, value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		if column.Type == types.SQLColumnTypeByteA {
+			return binary.HexBytes(v)
+		}
+		return string(v)
+	case int64:
+		if column.Type == types.SQLColumnTypeNumeric {
+			return strconv.FormatInt(v, 10)
+		}
+	case float64:
+		if column.Type == types.SQLColumnTypeNumeric {
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+	return value
+}
+
 func digits(x uint64) int {
 	if x == 0 {
 		return 1
@@ -98,6 +98,65 @@ func testRestore(t *testing.T, cfg *config.VentConfig) {
 		})
 }
 
+func testRewind(t *testing.T, cfg *config.VentConfig) {
+	t.Run(fmt.Sprintf("%s: can rewind blocks replaced by a reorganisation", cfg.DBAdapter),
+		func(t *testing.T) {
+			db, closeDB := test.NewTestDB(t, cfg)
+			defer closeDB()
+
+			table := &types.SQLTable{
+				Name: "test_rewind",
+				Columns: []*types.SQLTableColumn{
+					{Name: "id", Type: types.SQLColumnTypeInt, Primary: true},
+					{Name: "val", Type: types.SQLColumnTypeVarchar, Length: 100},
+					{Name: "_height", Type: types.SQLColumnTypeVarchar, Length: 100},
+				},
+			}
+			eventTables := types.EventTables{table.Name: table}
+			row := func(action types.DBAction, id int, val string, height uint64) types.EventDataRow {
+				return types.EventDataRow{Action: action,
+					RowData: map[string]interface{}{"id": id, "val": val, "_height": height}}
+			}
+
+			block1 := types.EventData{BlockHeight: 1, BlockHash: []byte{1}, Tables: map[string]types.EventDataTable{
+				table.Name: {row(types.ActionUpsert, 1, "one", 1), row(types.ActionUpsert, 2, "two", 1)},
+			}}
+			require.NoError(t, db.SetBlock(test.ChainID, eventTables, block1))
+			_, expected := selectAll(t, db, table.Name)
+
+			block2 := types.EventData{BlockHeight: 2, BlockHash: []byte{2}, Tables: map[string]types.EventDataTable{
+				table.Name: {row(types.ActionUpsert, 1, "uno", 2), row(types.ActionDelete, 2, "", 2),
+					row(types.ActionUpsert, 3, "three", 2)},
+			}}
+			require.NoError(t, db.SetBlock(test.ChainID, eventTables, block2))
+			_, rows := selectAll(t, db, table.Name)
+			require.NotEqual(t, expected, rows)
+
+			blocks, err := db.LastBlocks(test.ChainID, 10)
+			require.NoError(t, err)
+			assert.Equal(t, []types.BlockRecord{{Height: 2, Hash: []byte{2}}, {Height: 1, Hash: []byte{1}}}, blocks)
+
+			err = db.RewindBlocks(test.ChainID, eventTables, 1)
+			require.NoError(t, err)
+
+			_, rows = selectAll(t, db, table.Name)
+			assert.Equal(t, expected, rows)
+			height, err := db.LastBlockHeight(test.ChainID)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(1), height)
+			blocks, err = db.LastBlocks(test.ChainID, 10)
+			require.NoError(t, err)
+			assert.Equal(t, []types.BlockRecord{{Height: 1, Hash: []byte{1}}}, blocks)
+
+			// And we can carry on from the new fork
+			block2.BlockHash = []byte{3}
+			require.NoError(t, db.SetBlock(test.ChainID, eventTables, block2))
+			blocks, err = db.LastBlocks(test.ChainID, 1)
+			require.NoError(t, err)
+			assert.Equal(t, []types.BlockRecord{{Height: 2, Hash: []byte{3}}}, blocks)
+		})
+}
+
 func testSetBlock(t *testing.T, cfg *config.VentConfig) {
 	t.Run(fmt.Sprintf("%s: successfully inserts a block", cfg.DBAdapter),
 		func(t *testing.T) {
@@ -35,6 +35,10 @@ func TestRestore(t *testing.T) {
 	testRestore(t, test.PostgresVentConfig(""))
 }
 
+func TestPostgresRewind(t *testing.T) {
+	testRewind(t, test.PostgresVentConfig(""))
+}
+
 func TestPostgresBlockNotification(t *testing.T) {
 	cfg := test.PostgresVentConfig("")
 	db, closeDB := test.NewTestDB(t, cfg)
@@ -5,7 +5,7 @@ import (
 	"github.com/hyperledger/burrow/vent/types"
 )
 
-// getSysTablesDefinition returns log, chain info & dictionary structures
+// getSysTablesDefinition returns log, chain info, block log & dictionary structures
 func (db *SQLDB) systemTablesDefinition() types.EventTables {
 	return types.EventTables{
 		tables.Log: {
@@ -118,5 +118,25 @@ func (db *SQLDB) systemTablesDefinition() types.EventTables {
 			},
 			NotifyChannels: map[string][]string{types.BlockHeightLabel: {columns.Height}},
 		},
+		tables.BlockLog: {
+			Name: tables.BlockLog,
+			Columns: []*types.SQLTableColumn{
+				{
+					Name:    columns.ChainID,
+					Type:    types.SQLColumnTypeVarchar,
+					Primary: true,
+				},
+				{
+					Name:    columns.Height,
+					Type:    types.SQLColumnTypeNumeric,
+					Primary: true,
+					Length:  digits(maxUint64),
+				},
+				{
+					Name: columns.BlockHash,
+					Type: types.SQLColumnTypeVarchar,
+				},
+			},
+		},
 	}
 }
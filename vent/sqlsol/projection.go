@@ -11,6 +11,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/vent/storage"
 	"github.com/hyperledger/burrow/vent/types"
 	"github.com/pkg/errors"
 	"github.com/xeipuuv/gojsonschema"
@@ -20,6 +22,8 @@ import (
 type Projection struct {
 	Tables types.EventTables
 	Spec   types.ProjectionSpec
+	// Storage layouts of contracts projected from storage by LayoutFile
+	Layouts map[string]*storage.Layout
 }
 
 // NewProjectionFromBytes creates a Projection from a stream of bytes
@@ -87,6 +91,7 @@ func NewProjectionFromFolder(specFileOrDirs ...string) (*Projection, error) {
 func NewProjection(spec types.ProjectionSpec) (*Projection, error) {
 	// builds abi information from specification
 	tables := make(types.EventTables)
+	layouts := make(map[string]*storage.Layout)
 
 	for _, eventClass := range spec {
 		// validate json structure
@@ -107,6 +112,29 @@ func NewProjection(spec types.ProjectionSpec) (*Projection, error) {
 			}
 		}
 
+		if eventClass.Storage != nil {
+			if _, err := crypto.AddressFromHexString(eventClass.Storage.Address); err != nil {
+				return nil, fmt.Errorf("invalid storage address on %v: %v", eventClass, err)
+			}
+			// Rows are refreshed from storage so must have a key on which to upsert them
+			if !primary {
+				return nil, fmt.Errorf("a primary key is required when projecting from storage on %v", eventClass)
+			}
+			if _, ok := layouts[eventClass.Storage.LayoutFile]; !ok {
+				layout, err := storage.LoadLayout(eventClass.Storage.LayoutFile)
+				if err != nil {
+					return nil, err
+				}
+				layouts[eventClass.Storage.LayoutFile] = layout
+			}
+		}
+
+		if eventClass.Call != nil {
+			if _, err := crypto.AddressFromHexString(eventClass.Call.Address); err != nil {
+				return nil, fmt.Errorf("invalid call address on %v: %v", eventClass, err)
+			}
+		}
+
 		if !primary && eventClass.DeleteMarkerField != "" {
 			return nil, fmt.Errorf("no DeleteMarkerField allowed if no primary key on %v", eventClass)
 		}
@@ -173,8 +201,9 @@ func NewProjection(spec types.ProjectionSpec) (*Projection, error) {
 	}
 
 	return &Projection{
-		Tables: tables,
-		Spec:   spec,
+		Tables:  tables,
+		Spec:    spec,
+		Layouts: layouts,
 	}, nil
 }
 
@@ -268,6 +297,11 @@ func getSQLType(evmSignature string, bytesMapping BytesMapping) (types.SQLColumn
 
 	case strings.HasPrefix(evmSignature, types.EventFieldTypeUInt):
 		return evmIntegerSizeToSqlType(typeSize, false), 0, nil
+
+		// solidity fixed point => sql numeric (which is exact)
+	case strings.HasPrefix(evmSignature, types.EventFieldTypeFixed),
+		strings.HasPrefix(evmSignature, types.EventFieldTypeUFixed):
+		return types.SQLColumnTypeNumeric, 0, nil
 	default:
 		return -1, 0, fmt.Errorf("do not know how to map evmSignature: %s ", evmSignature)
 	}
@@ -0,0 +1,127 @@
+// Package storage decodes Solidity state variables from contract storage using the storage layout output by solc
+// (see https://docs.soliditylang.org/en/latest/internals/layout_in_storage.html).
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strconv"
+)
+
+// Encodings of types in storage
+const (
+	EncodingInplace      = "inplace"
+	EncodingMapping      = "mapping"
+	EncodingBytes        = "bytes"
+	EncodingDynamicArray = "dynamic_array"
+)
+
+// Layout is the storageLayout of a contract as output by solc with --storage-layout (or the storageLayout output
+// selection of standard JSON)
+type Layout struct {
+	Storage []*Variable
+	Types   map[string]*Type
+}
+
+// Variable is a state variable (or a member of a struct) at an offset within a slot
+type Variable struct {
+	Label  string
+	Offset int
+	Slot   string
+	Type   string
+}
+
+// Type describes how a type is encoded in storage
+type Type struct {
+	Encoding      string
+	Label         string
+	NumberOfBytes string
+	// For mappings
+	Key   string `json:",omitempty"`
+	Value string `json:",omitempty"`
+	// For arrays
+	Base string `json:",omitempty"`
+	// For structs
+	Members []*Variable `json:",omitempty"`
+}
+
+// LoadLayout reads a Layout from file, which may hold the layout itself or a contract's output from solc containing
+// the layout under storageLayout
+func LoadLayout(file string) (*Layout, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	layout, err := ParseLayout(bs)
+	if err != nil {
+		return nil, fmt.Errorf("could not read storage layout from %s: %w", file, err)
+	}
+	return layout, nil
+}
+
+func ParseLayout(bs []byte) (*Layout, error) {
+	wrapper := new(struct {
+		StorageLayout *Layout
+		*Layout
+	})
+	wrapper.Layout = new(Layout)
+	err := json.Unmarshal(bs, wrapper)
+	if err != nil {
+		return nil, err
+	}
+	layout := wrapper.Layout
+	if wrapper.StorageLayout != nil {
+		layout = wrapper.StorageLayout
+	}
+	if len(layout.Storage) == 0 {
+		return nil, fmt.Errorf("storage layout has no state variables")
+	}
+	return layout, nil
+}
+
+// Variable returns the state variable with label
+func (l *Layout) Variable(label string) (*Variable, error) {
+	for _, v := range l.Storage {
+		if v.Label == label {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("storage layout has no state variable '%s'", label)
+}
+
+// Type returns the type with id
+func (l *Layout) Type(id string) (*Type, error) {
+	t, ok := l.Types[id]
+	if !ok {
+		return nil, fmt.Errorf("storage layout has no type '%s'", id)
+	}
+	return t, nil
+}
+
+// Member returns the member of struct type t with label
+func (t *Type) Member(label string) (*Variable, error) {
+	for _, m := range t.Members {
+		if m.Label == label {
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("struct '%s' has no member '%s'", t.Label, label)
+}
+
+func (t *Type) Size() (int, error) {
+	size, err := strconv.Atoi(t.NumberOfBytes)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse size of type '%s': %w", t.Label, err)
+	}
+	return size, nil
+}
+
+func (v *Variable) slot() (*big.Int, error) {
+	slot, ok := new(big.Int).SetString(v.Slot, 10)
+	if !ok {
+		return nil, fmt.Errorf("could not parse slot '%s' of variable '%s'", v.Slot, v.Label)
+	}
+	return slot, nil
+}
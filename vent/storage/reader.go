@@ -0,0 +1,258 @@
+package storage
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+)
+
+// Reader decodes state variables of a contract from its storage. Values are returned as the same types the ABI
+// decoder unpacks into (e.g. *big.Int, *crypto.Address, *string, *[]byte), except for structs which are returned as
+// a map from member label to value.
+type Reader struct {
+	layout *Layout
+	get    func(key binary.Word256) ([]byte, error)
+}
+
+// NewReader returns a Reader for the contract with layout whose storage is read by get
+func NewReader(layout *Layout, get func(key binary.Word256) ([]byte, error)) *Reader {
+	return &Reader{
+		layout: layout,
+		get:    get,
+	}
+}
+
+// Read returns the value of the (non-mapping) state variable with label
+func (r *Reader) Read(label string) (interface{}, error) {
+	v, err := r.layout.Variable(label)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := v.slot()
+	if err != nil {
+		return nil, err
+	}
+	typ, err := r.layout.Type(v.Type)
+	if err != nil {
+		return nil, err
+	}
+	if typ.Encoding == EncodingMapping {
+		return nil, fmt.Errorf("state variable '%s' is a mapping so must be read with a key", label)
+	}
+	return r.decode(slot, v.Offset, typ)
+}
+
+// ReadMapping returns the value stored against key in the mapping state variable with label
+func (r *Reader) ReadMapping(label string, key interface{}) (interface{}, error) {
+	v, err := r.layout.Variable(label)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := v.slot()
+	if err != nil {
+		return nil, err
+	}
+	typ, err := r.layout.Type(v.Type)
+	if err != nil {
+		return nil, err
+	}
+	if typ.Encoding != EncodingMapping {
+		return nil, fmt.Errorf("state variable '%s' is not a mapping", label)
+	}
+	keyType, err := r.layout.Type(typ.Key)
+	if err != nil {
+		return nil, err
+	}
+	valueType, err := r.layout.Type(typ.Value)
+	if err != nil {
+		return nil, err
+	}
+	encodedKey, err := encodeKey(keyType, key)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode key for mapping '%s': %w", label, err)
+	}
+	word := binary.BigIntToWord256(slot)
+	valueSlot := new(big.Int).SetBytes(crypto.Keccak256(append(encodedKey, word[:]...)))
+	return r.decode(valueSlot, 0, valueType)
+}
+
+func (r *Reader) decode(slot *big.Int, offset int, typ *Type) (interface{}, error) {
+	switch typ.Encoding {
+	case EncodingInplace:
+		if len(typ.Members) > 0 {
+			return r.decodeStruct(slot, typ)
+		}
+		if strings.HasSuffix(typ.Label, "]") {
+			return nil, fmt.Errorf("decoding arrays from storage is not supported (type '%s')", typ.Label)
+		}
+		return r.decodeValue(slot, offset, typ)
+	case EncodingBytes:
+		return r.decodeBytes(slot, typ)
+	case EncodingMapping:
+		return nil, fmt.Errorf("cannot decode nested mapping type '%s'", typ.Label)
+	default:
+		return nil, fmt.Errorf("decoding %s encoded type '%s' from storage is not supported", typ.Encoding,
+			typ.Label)
+	}
+}
+
+func (r *Reader) decodeStruct(slot *big.Int, typ *Type) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(typ.Members))
+	for _, m := range typ.Members {
+		memberSlot, err := m.slot()
+		if err != nil {
+			return nil, err
+		}
+		memberType, err := r.layout.Type(m.Type)
+		if err != nil {
+			return nil, err
+		}
+		values[m.Label], err = r.decode(memberSlot.Add(memberSlot, slot), m.Offset, memberType)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode member '%s' of struct '%s': %w", m.Label, typ.Label, err)
+		}
+	}
+	return values, nil
+}
+
+// Value types are packed into slots right to left starting at offset bytes from the right
+func (r *Reader) decodeValue(slot *big.Int, offset int, typ *Type) (interface{}, error) {
+	size, err := typ.Size()
+	if err != nil {
+		return nil, err
+	}
+	if offset+size > binary.Word256Bytes {
+		return nil, fmt.Errorf("type '%s' of %d bytes at offset %d overflows its slot", typ.Label, size, offset)
+	}
+	word, err := r.word(slot)
+	if err != nil {
+		return nil, err
+	}
+	bs := word[binary.Word256Bytes-offset-size : binary.Word256Bytes-offset]
+	abiType := abiTypeOf(typ, size)
+	// Reconstitute the ABI encoding of the value so it can be unpacked by the ABI decoder
+	var encoded binary.Word256
+	switch {
+	case strings.HasPrefix(abiType, "bytes"):
+		copy(encoded[:], bs)
+	case strings.HasPrefix(abiType, "int") && bs[0]&0x80 != 0:
+		for i := range encoded {
+			encoded[i] = 0xff
+		}
+		fallthrough
+	default:
+		copy(encoded[binary.Word256Bytes-size:], bs)
+	}
+	arg, err := abi.NewArgument(typ.Label, abiType)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode type '%s': %w", typ.Label, err)
+	}
+	args := []abi.Argument{arg}
+	values := abi.GetPackingTypes(args)
+	err = abi.Unpack(args, encoded[:], values...)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode type '%s': %w", typ.Label, err)
+	}
+	return values[0], nil
+}
+
+// Short bytes and strings (under 32 bytes) are stored left-aligned in their slot with twice their length in the
+// lowest byte, otherwise the slot holds twice the length plus one and the data is stored from keccak256(slot)
+func (r *Reader) decodeBytes(slot *big.Int, typ *Type) (interface{}, error) {
+	word, err := r.word(slot)
+	if err != nil {
+		return nil, err
+	}
+	var bs []byte
+	if word[binary.Word256Bytes-1]&1 == 0 {
+		length := int(word[binary.Word256Bytes-1] / 2)
+		bs = make([]byte, length)
+		copy(bs, word[:length])
+	} else {
+		length := new(big.Int).SetBytes(word[:])
+		length.Rsh(length, 1)
+		if !length.IsInt64() || length.Int64() > maxBytesLength {
+			return nil, fmt.Errorf("length %v of type '%s' stored at slot %v is too large", length, typ.Label,
+				slot)
+		}
+		n := int(length.Int64())
+		slotWord := binary.BigIntToWord256(slot)
+		dataSlot := new(big.Int).SetBytes(crypto.Keccak256(slotWord[:]))
+		bs = make([]byte, 0, n+binary.Word256Bytes)
+		for len(bs) < n {
+			data, err := r.word(dataSlot)
+			if err != nil {
+				return nil, err
+			}
+			bs = append(bs, data[:]...)
+			dataSlot.Add(dataSlot, big.NewInt(1))
+		}
+		bs = bs[:n]
+	}
+	if typ.Label == "string" {
+		str := string(bs)
+		return &str, nil
+	}
+	return &bs, nil
+}
+
+func (r *Reader) word(slot *big.Int) (binary.Word256, error) {
+	bs, err := r.get(binary.BigIntToWord256(slot))
+	if err != nil {
+		return binary.Zero256, fmt.Errorf("could not get storage slot %v: %w", slot, err)
+	}
+	if len(bs) > binary.Word256Bytes {
+		return binary.Zero256, fmt.Errorf("storage slot %v holds %d bytes", slot, len(bs))
+	}
+	return binary.LeftPadWord256(bs), nil
+}
+
+// Keys of value types are ABI encoded into a word, string and bytes keys are used unpadded
+func encodeKey(keyType *Type, key interface{}) ([]byte, error) {
+	if keyType.Encoding == EncodingBytes {
+		switch k := key.(type) {
+		case string:
+			return []byte(k), nil
+		case *string:
+			return []byte(*k), nil
+		case []byte:
+			if keyType.Label == "string" {
+				// Strings from fixed-size byte arrays carry their zero padding
+				return []byte(strings.TrimRight(string(k), "\x00")), nil
+			}
+			return k, nil
+		case *[]byte:
+			return encodeKey(keyType, *k)
+		default:
+			return nil, fmt.Errorf("cannot use %v of type %T as key of type '%s'", key, key, keyType.Label)
+		}
+	}
+	size, err := keyType.Size()
+	if err != nil {
+		return nil, err
+	}
+	arg, err := abi.NewArgument("key", abiTypeOf(keyType, size))
+	if err != nil {
+		return nil, err
+	}
+	return abi.Pack([]abi.Argument{arg}, key)
+}
+
+// The ABI type that a storage type is encoded as
+func abiTypeOf(typ *Type, size int) string {
+	switch {
+	case typ.Label == "address payable" || strings.HasPrefix(typ.Label, "contract "):
+		return "address"
+	case strings.HasPrefix(typ.Label, "enum "):
+		return fmt.Sprintf("uint%d", size*8)
+	default:
+		return typ.Label
+	}
+}
+
+// Guard against allocating for corrupt lengths
+const maxBytesLength = 1 << 24
@@ -0,0 +1,151 @@
+package storage_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/vent/storage"
+	"github.com/hyperledger/burrow/vent/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	vm := evm.New(engine.Options{Natives: native.MustDefaultNatives()})
+	caller := engine.AddressFromName("caller")
+	contract := engine.AddressFromName("EventsTest")
+	require.NoError(t, engine.CreateAccount(st, caller))
+	require.NoError(t, engine.CreateAccount(st, contract))
+	require.NoError(t, engine.InitEVMCode(st, contract, test.DeployedBytecode_EventsTest))
+
+	spec, err := abi.ReadSpec(test.Abi_EventsTest)
+	require.NoError(t, err)
+	longDescription := strings.Repeat("A description that spans slots. ", 3)
+	for _, thing := range [][2]string{{"foo", "bar"}, {"baz", longDescription}} {
+		input, _, err := spec.Pack("addThing", thing[0], thing[1])
+		require.NoError(t, err)
+		_, err = vm.Execute(st, new(engine.TestBlockchain), exec.NewNoopEventSink(), engine.CallParams{
+			Caller: caller,
+			Callee: contract,
+			Input:  input,
+			Gas:    big.NewInt(1000000),
+		}, test.DeployedBytecode_EventsTest)
+		require.NoError(t, err)
+	}
+
+	layout, err := storage.LoadLayout("../test/EventsTest.storage.json")
+	require.NoError(t, err)
+	reader := storage.NewReader(layout, func(key binary.Word256) ([]byte, error) {
+		return st.GetStorage(contract, key)
+	})
+
+	length, err := reader.Read("length")
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(2), length)
+
+	thing, err := reader.ReadMapping("things", "foo")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":        str("foo"),
+		"description": str("bar"),
+		"exists":      boolean(true),
+	}, thing)
+
+	// Keys taken from bytes32 event fields carry zero padding
+	thing, err = reader.ReadMapping("things", binary.RightPadBytes([]byte("baz"), 32))
+	require.NoError(t, err)
+	assert.Equal(t, str(longDescription), thing.(map[string]interface{})["description"])
+
+	thing, err = reader.ReadMapping("things", "missing")
+	require.NoError(t, err)
+	assert.Equal(t, boolean(false), thing.(map[string]interface{})["exists"])
+
+	_, err = reader.Read("things")
+	require.Error(t, err)
+	_, err = reader.ReadMapping("length", "foo")
+	require.Error(t, err)
+}
+
+func TestReaderPackedValues(t *testing.T) {
+	layout, err := storage.ParseLayout([]byte(`{"storageLayout": {
+		"storage": [
+			{"label": "small", "offset": 0, "slot": "0", "type": "t_uint8"},
+			{"label": "negative", "offset": 1, "slot": "0", "type": "t_int16"},
+			{"label": "owner", "offset": 3, "slot": "0", "type": "t_address_payable"},
+			{"label": "tag", "offset": 23, "slot": "0", "type": "t_bytes4"},
+			{"label": "state", "offset": 27, "slot": "0", "type": "t_enum(State)1"},
+			{"label": "balances", "offset": 0, "slot": "1", "type": "t_mapping(t_address,t_uint256)"}
+		],
+		"types": {
+			"t_uint8": {"encoding": "inplace", "label": "uint8", "numberOfBytes": "1"},
+			"t_int16": {"encoding": "inplace", "label": "int16", "numberOfBytes": "2"},
+			"t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+			"t_address_payable": {"encoding": "inplace", "label": "address payable", "numberOfBytes": "20"},
+			"t_bytes4": {"encoding": "inplace", "label": "bytes4", "numberOfBytes": "4"},
+			"t_enum(State)1": {"encoding": "inplace", "label": "enum Test.State", "numberOfBytes": "1"},
+			"t_uint256": {"encoding": "inplace", "label": "uint256", "numberOfBytes": "32"},
+			"t_mapping(t_address,t_uint256)": {"encoding": "mapping", "key": "t_address",
+				"label": "mapping(address => uint256)", "numberOfBytes": "32", "value": "t_uint256"}
+		}
+	}}`))
+	require.NoError(t, err)
+
+	owner := crypto.Address{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
+	var packed binary.Word256
+	packed[31] = 7
+	packed[29], packed[30] = 0xff, 0xfe
+	copy(packed[9:29], owner[:])
+	copy(packed[5:9], "tags")
+	packed[4] = 2
+	balanceSlot := binary.LeftPadWord256(crypto.Keccak256(append(owner.Word256().Bytes(),
+		binary.Int64ToWord256(1).Bytes()...)))
+	slots := map[binary.Word256][]byte{
+		binary.Zero256: packed[:],
+		balanceSlot:    {0x01, 0x00},
+	}
+	reader := storage.NewReader(layout, func(key binary.Word256) ([]byte, error) {
+		return slots[key], nil
+	})
+
+	for label, expected := range map[string]interface{}{
+		"small":    uint8Ptr(7),
+		"negative": int16Ptr(-2),
+		"owner":    &owner,
+		"tag":      &[]byte{'t', 'a', 'g', 's'},
+		"state":    uint8Ptr(2),
+	} {
+		value, err := reader.Read(label)
+		require.NoError(t, err)
+		assert.Equal(t, expected, value, label)
+	}
+
+	balance, err := reader.ReadMapping("balances", owner)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(256), balance)
+}
+
+func str(s string) *string {
+	return &s
+}
+
+func boolean(b bool) *bool {
+	return &b
+}
+
+func uint8Ptr(i uint8) *uint8 {
+	return &i
+}
+
+func int16Ptr(i int16) *int16 {
+	return &i
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/alecthomas/jsonschema"
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/hyperledger/burrow/event/query"
@@ -17,8 +19,12 @@ func ProjectionSpecSchema() *jsonschema.Schema {
 type EventClass struct {
 	// Destination table in DB
 	TableName string
-	// Burrow event filter query in query peg grammar
-	Filter string
+	// Burrow event filter query in query peg grammar, required unless projecting from Storage or Call
+	Filter string `json:",omitempty"`
+	// Project the state variables of a contract rather than events
+	Storage *StorageSource `json:",omitempty"`
+	// Project calls to a contract function rather than events
+	Call *CallSource `json:",omitempty"`
 	// The name of a solidity event field that when present indicates that the rest of the event should be interpreted
 	// as requesting a row deletion (rather than upsert) in the projection table.
 	DeleteMarkerField string `json:",omitempty"`
@@ -31,13 +37,27 @@ type EventClass struct {
 
 // Validate checks the structure of an EventClass
 func (ec *EventClass) Validate() error {
+	var filterRules []validation.Rule
+	if ec.Storage == nil && ec.Call == nil {
+		filterRules = append(filterRules, validation.Required)
+	}
+	if ec.Storage != nil && ec.Call != nil {
+		return fmt.Errorf("cannot project from both Storage and Call")
+	}
 	return validation.ValidateStruct(ec,
 		validation.Field(&ec.TableName, validation.Required, validation.Length(1, 60)),
-		validation.Field(&ec.Filter, validation.Required),
+		validation.Field(&ec.Filter, filterRules...),
+		validation.Field(&ec.Storage),
+		validation.Field(&ec.Call),
 		validation.Field(&ec.FieldMappings, validation.Required, validation.Length(1, 0)),
 	)
 }
 
+// MatchesEvents returns whether rows are projected from events matching Filter (rather than from Storage or Call)
+func (ec *EventClass) MatchesEvents() bool {
+	return ec.Storage == nil && ec.Call == nil
+}
+
 // Get a (memoised) Query from the EventClass Filter string
 func (ec *EventClass) Query() (query.Query, error) {
 	if ec.query == nil {
@@ -67,6 +87,54 @@ func (ec *EventClass) GetFilter() string {
 	return ec.Filter
 }
 
+// StorageSource describes the contract storage from which to project rows. The table is refreshed from storage as of
+// each block containing a transaction that calls or creates the contract. Fields are mapped from the labels of state
+// variables or, when projecting a mapping, from the members of its struct values (or StorageValueLabel for a
+// non-struct value) with the mapping key under StorageKeyLabel. StorageAddressLabel holds the contract address.
+type StorageSource struct {
+	// Address of the contract as hex
+	Address string
+	// Path to the storage layout output by solc for the contract (--storage-layout or the storageLayout output
+	// selection of standard JSON)
+	LayoutFile string
+	// Label of a mapping state variable to project a row per key, if empty a single row of the contract's state
+	// variables is projected
+	Mapping string `json:",omitempty"`
+	// Names of the fields of events emitted by the contract whose values are keys of Mapping to refresh
+	KeysFrom []string `json:",omitempty"`
+}
+
+// Validate checks the structure of a StorageSource
+func (ss StorageSource) Validate() error {
+	var keysFromRules []validation.Rule
+	if ss.Mapping != "" {
+		keysFromRules = append(keysFromRules, validation.Required)
+	}
+	return validation.ValidateStruct(&ss,
+		validation.Field(&ss.Address, validation.Required),
+		validation.Field(&ss.LayoutFile, validation.Required),
+		validation.Field(&ss.KeysFrom, keysFromRules...),
+	)
+}
+
+// CallSource describes the calls to a contract function from which to project rows, one per successful call including
+// internal calls from other contracts. Fields are mapped from the names of the function's inputs and outputs (unnamed
+// outputs are named output0, output1, ...) along with CallerLabel, CalleeLabel, CallValueLabel, and StackDepthLabel.
+type CallSource struct {
+	// Address of the contract as hex
+	Address string
+	// Name of the function or its selector as hex
+	Function string
+}
+
+// Validate checks the structure of a CallSource
+func (cs CallSource) Validate() error {
+	return validation.ValidateStruct(&cs,
+		validation.Field(&cs.Address, validation.Required),
+		validation.Field(&cs.Function, validation.Required),
+	)
+}
+
 // EventFieldMapping struct (table column definition)
 type EventFieldMapping struct {
 	// EVM event field name to process
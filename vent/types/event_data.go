@@ -1,5 +1,7 @@
 package types
 
+import "github.com/hyperledger/burrow/binary"
+
 // DBAction generic type
 type DBAction string
 
@@ -16,7 +18,15 @@ const (
 // Tables map key is the table name
 type EventData struct {
 	BlockHeight uint64
-	Tables      map[string]EventDataTable
+	// The hash of the block (if the chain may reorganise) recorded so that a reorganisation can be detected
+	BlockHash binary.HexBytes `json:",omitempty"`
+	Tables    map[string]EventDataTable
+}
+
+// BlockRecord identifies a block already consumed by vent
+type BlockRecord struct {
+	Height uint64
+	Hash   binary.HexBytes
 }
 
 // EventDataTable is an array of rows
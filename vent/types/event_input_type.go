@@ -9,4 +9,6 @@ const (
 	EventFieldTypeBytes   = "bytes"
 	EventFieldTypeBool    = "bool"
 	EventFieldTypeString  = "string"
+	EventFieldTypeFixed   = "fixed"
+	EventFieldTypeUFixed  = "ufixed"
 )
@@ -75,6 +75,7 @@ type SQLTableNames struct {
 	Block      string
 	Tx         string
 	ChainInfo  string
+	BlockLog   string
 }
 
 var DefaultSQLTableNames = SQLTableNames{
@@ -83,6 +84,7 @@ var DefaultSQLTableNames = SQLTableNames{
 	Block:      "_vent_block",
 	Tx:         "_vent_tx",
 	ChainInfo:  "_vent_chain",
+	BlockLog:   "_vent_block_log",
 }
 
 type SQLColumnNames struct {
@@ -107,6 +109,8 @@ type SQLColumnNames struct {
 	// chain info
 	BurrowVersion string
 	ChainID       string
+	// block log
+	BlockHash string
 	// context
 	TxIndex     string
 	EventIndex  string
@@ -143,6 +147,8 @@ var DefaultSQLColumnNames = SQLColumnNames{
 	// chain info,
 	BurrowVersion: "_burrowversion",
 	ChainID:       "_chainid",
+	// block log
+	BlockHash: "_blockhash",
 	// context,
 	TxIndex:     "_txindex",
 	EventIndex:  "_eventindex",
@@ -171,4 +177,21 @@ const (
 
 	// transaction related
 	TxTxHashLabel = "txHash"
+
+	// storage related
+	StorageAddressLabel = "address"
+	StorageKeyLabel     = "key"
+	StorageValueLabel   = "value"
+
+	// call related
+	CallerLabel     = "caller"
+	CalleeLabel     = "callee"
+	CallValueLabel  = "callValue"
+	StackDepthLabel = "stackDepth"
+)
+
+// EventTypeLabel values for rows projected from contract storage or calls rather than a log event
+const (
+	StorageEventType = "Storage"
+	CallEventType    = "Call"
 )
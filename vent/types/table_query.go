@@ -0,0 +1,53 @@
+package types
+
+import "fmt"
+
+// QueryOperator is a comparison that may be used to filter the rows of a projection table
+type QueryOperator string
+
+const (
+	QueryOperatorEqual              QueryOperator = "eq"
+	QueryOperatorNotEqual           QueryOperator = "ne"
+	QueryOperatorLessThan           QueryOperator = "lt"
+	QueryOperatorLessThanOrEqual    QueryOperator = "lte"
+	QueryOperatorGreaterThan        QueryOperator = "gt"
+	QueryOperatorGreaterThanOrEqual QueryOperator = "gte"
+)
+
+// SQL returns the SQL comparison operator
+func (op QueryOperator) SQL() (string, error) {
+	switch op {
+	case QueryOperatorEqual:
+		return "=", nil
+	case QueryOperatorNotEqual:
+		return "<>", nil
+	case QueryOperatorLessThan:
+		return "<", nil
+	case QueryOperatorLessThanOrEqual:
+		return "<=", nil
+	case QueryOperatorGreaterThan:
+		return ">", nil
+	case QueryOperatorGreaterThanOrEqual:
+		return ">=", nil
+	default:
+		return "", fmt.Errorf("unknown query operator '%s'", op)
+	}
+}
+
+// QueryFilter restricts the rows returned to those where Column compares with Value according to Operator
+type QueryFilter struct {
+	Column   string
+	Operator QueryOperator
+	Value    interface{}
+}
+
+// TableQuery describes a read-only query against a single projection table
+type TableQuery struct {
+	// Filters are combined conjunctively
+	Filters []QueryFilter
+	// Column to order by, defaults to the table's primary key
+	OrderBy    string
+	Descending bool
+	Limit      uint64
+	Offset     uint64
+}